@@ -13,9 +13,11 @@ import (
 	"net/netip"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/nais/device/internal/apiserver/agentversion"
 	"github.com/nais/device/internal/apiserver/api"
 	apiauth "github.com/nais/device/internal/apiserver/auth"
 	"github.com/nais/device/internal/apiserver/bucket"
@@ -23,12 +25,16 @@ import (
 	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/internal/apiserver/enroller"
 	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
+	"github.com/nais/device/internal/apiserver/graph"
 	"github.com/nais/device/internal/apiserver/ip"
 	"github.com/nais/device/internal/apiserver/kolide"
+	"github.com/nais/device/internal/apiserver/leader"
 	"github.com/nais/device/internal/apiserver/metrics"
+	"github.com/nais/device/internal/apiserver/posture"
 	"github.com/nais/device/internal/logger"
 	"github.com/nais/device/internal/otel"
 	"github.com/nais/device/internal/program"
+	"github.com/nais/device/internal/tlsconfig"
 	"github.com/nais/device/internal/token"
 	"github.com/nais/device/internal/token/azure"
 	"github.com/nais/device/internal/token/google"
@@ -38,14 +44,18 @@ import (
 	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 )
 
 const (
-	intervalWireGuardSync      = 20 * time.Second
-	intervalGatewayConfigSync  = 1 * time.Minute
-	intervalKolideCacheRefresh = 1 * time.Minute
-	intervalKolideFullSync     = 1 * time.Minute
+	intervalWireGuardSync        = 20 * time.Second
+	intervalGatewayConfigSync    = 1 * time.Minute
+	intervalKolideCacheRefresh   = 1 * time.Minute
+	intervalKolideFullSync       = 1 * time.Minute
+	intervalKolideWebhookRestart = 10 * time.Second
+	intervalSessionReload        = 10 * time.Second
+	intervalPosturePolicySync    = 30 * time.Second
 )
 
 func main() {
@@ -82,7 +92,7 @@ func main() {
 
 func run(log *logrus.Entry, cfg config.Config) error {
 	var authenticator apiauth.Authenticator
-	var adminAuthenticator apiauth.UsernamePasswordAuthenticator
+	var adminAuthenticator apiauth.AdminAuthenticator
 	var gatewayAuthenticator apiauth.UsernamePasswordAuthenticator
 	var prometheusAuthenticator apiauth.UsernamePasswordAuthenticator
 
@@ -111,11 +121,46 @@ func run(log *logrus.Entry, cfg config.Config) error {
 
 	v4Allocator := ip.NewV4Allocator(wireguardPrefix, []string{cfg.WireGuardIPv4Prefix.Addr().String()})
 	v6Allocator := ip.NewV6Allocator(cfg.WireGuardIPv6Prefix)
-	db, err := database.New(cfg.DBPath, v4Allocator, v6Allocator, cfg.KolideEventHandlerEnabled, log.WithField("component", "database"))
+	var dbOpts []database.Option
+
+	agentVersions, err := agentversion.ParsePolicy(cfg.AgentMinimumVersions, cfg.AgentLatestVersion, cfg.AgentVersionGracePeriod)
+	if err != nil {
+		return fmt.Errorf("parse agent version policy: %w", err)
+	}
+	if len(cfg.AgentMinimumVersions) > 0 {
+		dbOpts = append(dbOpts, database.WithPostureProviders(agentVersions))
+	}
+
+	var posturePolicy *posture.PolicyFile
+	if cfg.PosturePolicyPath != "" {
+		posturePolicy = posture.NewPolicyFile(cfg.PosturePolicyPath, log.WithField("component", "posture-policy"))
+		if _, err := posturePolicy.Sync(ctx); err != nil {
+			return err
+		}
+		dbOpts = append(dbOpts, database.WithPosturePolicy(posturePolicy))
+	}
+
+	var postureFeed *posture.Feed
+	if cfg.PostureFeedSource != "" {
+		postureFeed = posture.NewFeed(cfg.PostureFeedSource, cfg.PostureFeedToken, posturePolicy, log.WithField("component", "posture-feed"))
+		dbOpts = append(dbOpts, database.WithPostureProviders(postureFeed))
+	}
+
+	db, err := openDatabase(cfg, v4Allocator, v6Allocator, log.WithField("component", "database"), dbOpts...)
 	if err != nil {
 		return fmt.Errorf("initialize database: %w", err)
 	}
 
+	// leaderTasks run on the active apiserver instance only, see runLeaderTasks
+	var leaderTasks []func(context.Context)
+
+	if cfg.DBSnapshotDir != "" {
+		snapshotter := database.NewSnapshotter(db, cfg.DBSnapshotDir, cfg.DBSnapshotRetention, log.WithField("component", "database-snapshot"))
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, cfg.DBSnapshotInterval, snapshotter.Snapshot, log.WithField("component", "database-snapshot"))
+		})
+	}
+
 	err = readd(ctx, db)
 	if err != nil {
 		log.WithError(err).Error("upsert IPv6")
@@ -151,6 +196,8 @@ func run(log *logrus.Entry, cfg config.Config) error {
 		log.Warn("to enable device authentication, specify auth provider with --device-authentication-provider=azure|google")
 	}
 
+	var wgSync func(context.Context) error
+	var wgClear func() error
 	if cfg.WireGuardEnabled {
 		log.Info("setting up WireGuard integration...")
 
@@ -170,8 +217,10 @@ func run(log *logrus.Entry, cfg config.Config) error {
 			return fmt.Errorf("setup interface: %w", err)
 		}
 
-		wgSync := syncWireGuardConfig(db, netConf, cfg.StaticPeers())
-		go untilContextDone(ctx, intervalWireGuardSync, wgSync, log.WithField("component", "wireguard"))
+		wgSync = syncWireGuardConfig(db, netConf, cfg.StaticPeers())
+		wgClear = func() error {
+			return netConf.ApplyWireGuardConfig(wg.CastPeerList(cfg.StaticPeers()))
+		}
 		log.Info("WireGuard successfully configured")
 	} else {
 		log.Warn("WireGuard integration DISABLED! Do not run this configuration in production!")
@@ -184,7 +233,7 @@ func run(log *logrus.Entry, cfg config.Config) error {
 			return fmt.Errorf("kolide-event-handler-address not configured")
 		}
 
-		go func() {
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
 			log.WithField("event_handler_address", cfg.KolideEventHandlerAddress).Info("Kolide event handler stream starting")
 			err := kolide.DeviceEventStreamer(ctx,
 				log.WithField("component", "kolide-event-handler"),
@@ -193,11 +242,29 @@ func run(log *logrus.Entry, cfg config.Config) error {
 				cfg.KolideEventHandlerSecure,
 				deviceUpdates,
 			)
-			if err != nil {
+			if err != nil && ctx.Err() == nil {
+				// device health is still kept up to date by the periodic full sync
 				log.WithError(err).Error("Kolide event streamer finished")
 			}
-			cancel()
-		}()
+		})
+	}
+
+	if cfg.KolideWebhookEnabled {
+		if !cfg.KolideIntegrationEnabled {
+			return fmt.Errorf("kolide webhooks require the kolide integration to be enabled")
+		}
+		if cfg.KolideWebhookSigningSecret == "" {
+			return fmt.Errorf("kolide webhooks enabled but no kolide-webhook-signing-secret provided")
+		}
+
+		log := log.WithField("component", "kolide-webhook")
+		webhooks := kolide.NewWebhookHandler(cfg.KolideWebhookSigningSecret, cfg.KolideWebhookMaxAge, deviceUpdates, log)
+		// only the active instance receives webhooks, like it would receive the event stream
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, intervalKolideWebhookRestart, func(ctx context.Context) error {
+				return kolide.ServeWebhooks(ctx, cfg.BindAddress, webhooks, log)
+			}, log)
+		})
 	}
 
 	var kolideClient kolide.Client
@@ -210,16 +277,33 @@ func run(log *logrus.Entry, cfg config.Config) error {
 		kolideClient = kolide.New(cfg.KolideAPIToken, log)
 	}
 
+	var groupMembership graph.Client
+	if cfg.GroupRefreshEnabled {
+		if cfg.DeviceAuthenticationProvider != "azure" {
+			return fmt.Errorf("group refresh is only supported with the azure device authentication provider")
+		}
+		if cfg.GraphTenantID == "" || cfg.GraphClientSecret == "" {
+			return fmt.Errorf("group refresh enabled but no graph-tenant-id or graph-client-secret provided")
+		}
+
+		clientID := cfg.GraphClientID
+		if clientID == "" {
+			clientID = cfg.Azure.ClientID
+		}
+
+		groupMembership = graph.New(ctx, cfg.GraphTenantID, clientID, cfg.GraphClientSecret, log.WithField("component", "graph-client"))
+	}
+
 	if cfg.AutoEnrollEnabled {
 		if cfg.AutoEnrollmentsURL != "" {
 			e := enroller.NewLocalEnroll(db, cfg.AutoEnrollmentsURL)
-			go func() {
+			leaderTasks = append(leaderTasks, func(ctx context.Context) {
 				err := e.Run(ctx)
-				if err != nil {
+				if err != nil && ctx.Err() == nil {
 					log.WithError(err).Error("run AutoEnroll failed")
 					cancel()
 				}
-			}()
+			})
 			log.Info("auto-enrollment enabled using local enroller")
 		} else {
 			log.Info("auto-enrollment enabled using peer-to-peer enroller")
@@ -228,38 +312,47 @@ func run(log *logrus.Entry, cfg config.Config) error {
 			if err != nil {
 				return err
 			}
-			go func() {
+			leaderTasks = append(leaderTasks, func(ctx context.Context) {
 				err := e.Run(ctx)
-				if err != nil {
+				if err != nil && ctx.Err() == nil {
 					log.WithError(err).Error("run AutoEnroll failed")
 					cancel()
 				}
-			}()
+			})
 		}
 	}
 
-	switch cfg.GatewayConfigurer {
-	case "bucket":
-		buck := bucket.NewClient(cfg.GatewayConfigBucketName, cfg.GatewayConfigBucketObjectName)
-		log := log.WithField("component", "gatewayconfigurer").WithField("source", buck)
-		updater := gatewayconfigurer.NewGatewayConfigurer(log, db, buck)
-		go untilContextDone(ctx, intervalGatewayConfigSync, updater.SyncConfig, log)
-	case "metadata":
-		log := log.WithField("component", "gatewayconfigurer").WithField("source", "metadata")
-		updater := gatewayconfigurer.NewGoogleMetadata(db, log)
-		go untilContextDone(ctx, intervalGatewayConfigSync, updater.SyncConfig, log)
-	default:
-		log.Warn("no valid gateway configurer set, gateways won't be updated")
-	}
-
 	if cfg.ControlPlaneAuthenticationEnabled {
-		apiKeys, err := config.Credentials(cfg.AdminCredentialEntries)
+		adminKeys, err := apiauth.ParseAdminKeys(cfg.AdminCredentialEntries)
 		if err != nil {
 			return fmt.Errorf("parse admin credentials: %w", err)
 		}
 
-		if len(apiKeys) == 0 {
-			return fmt.Errorf("control plane basic authentication enabled, but no admin credentials provided (try --admin-credential-entries)")
+		adminGroupRoles, err := apiauth.ParseGroupRoles(cfg.AdminGroupRoles)
+		if err != nil {
+			return fmt.Errorf("parse admin group roles: %w", err)
+		}
+
+		adminCertificateRoles, err := apiauth.ParseCertificateRoles(cfg.AdminCertificateRoles)
+		if err != nil {
+			return fmt.Errorf("parse admin certificate roles: %w", err)
+		}
+
+		if len(adminCertificateRoles) > 0 && cfg.GRPCTLSClientCAFile == "" {
+			return fmt.Errorf("admin certificate roles configured, but no client CA to verify certificates with (try --grpc-tls-client-ca-file)")
+		}
+
+		// admins log in with the same identity provider as devices
+		var adminTokenParsers []token.Parser
+		if len(adminGroupRoles) > 0 {
+			if tokenParser == nil {
+				return fmt.Errorf("admin group roles configured, but device authentication is disabled")
+			}
+			adminTokenParsers = append(adminTokenParsers, tokenParser)
+		}
+
+		if len(adminKeys) == 0 && len(adminTokenParsers) == 0 && len(adminCertificateRoles) == 0 {
+			return fmt.Errorf("control plane authentication enabled, but no admin credentials or admin group roles provided (try --admin-credential-entries or --admin-group-roles)")
 		}
 
 		promauth, err := config.Credentials(cfg.PrometheusCredentialEntries)
@@ -271,13 +364,13 @@ func run(log *logrus.Entry, cfg config.Config) error {
 			return fmt.Errorf("control plane basic authentication enabled, but no prometheus credentials provided (try --prometheus-credential-entries)")
 		}
 
-		adminAuthenticator = apiauth.NewAPIKeyAuthenticator(apiKeys)
+		adminAuthenticator = apiauth.NewAdminAuthenticator(adminKeys, adminGroupRoles, adminCertificateRoles, adminTokenParsers...)
 		gatewayAuthenticator = apiauth.NewGatewayAuthenticator(db)
 		prometheusAuthenticator = apiauth.NewAPIKeyAuthenticator(promauth)
 
 		log.Info("controlplane authentication enabled")
 	} else {
-		adminAuthenticator = apiauth.NewMockAPIKeyAuthenticator()
+		adminAuthenticator = apiauth.NewMockAdminAuthenticator()
 		gatewayAuthenticator = apiauth.NewMockAPIKeyAuthenticator()
 		prometheusAuthenticator = apiauth.NewMockAPIKeyAuthenticator()
 
@@ -294,14 +387,161 @@ func run(log *logrus.Entry, cfg config.Config) error {
 		prometheusAuthenticator,
 		sessions,
 		kolideClient,
-		cfg.KolideEventHandlerEnabled,
+		cfg.KolideEventsEnabled(),
+		api.WithJITAApproverGroups(cfg.JITAApproverGroups),
+		api.WithAgentVersionPolicy(agentVersions),
+		api.WithPosturePolicy(posturePolicy),
+		api.WithGroupMembership(groupMembership),
+		api.WithWireGuardPrefixes(cfg.WireGuardPrefixes()),
 	)
 
+	leaderTasks = append(leaderTasks, grpcHandler.RunJitaExpiryScheduler, grpcHandler.RunSessionDeadlineScheduler)
+
+	switch cfg.GatewayConfigurer {
+	case "bucket":
+		buck := bucket.NewClient(cfg.GatewayConfigBucketName, cfg.GatewayConfigBucketObjectName)
+		log := log.WithField("component", "gatewayconfigurer").WithField("source", buck)
+		updater := gatewayconfigurer.NewGatewayConfigurer(log, db, buck, cfg.WireGuardPrefixes())
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, intervalGatewayConfigSync, updater.SyncConfig, log)
+		})
+	case "metadata":
+		log := log.WithField("component", "gatewayconfigurer").WithField("source", "metadata")
+		updater := gatewayconfigurer.NewGoogleMetadata(db, log, cfg.WireGuardPrefixes())
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, intervalGatewayConfigSync, updater.SyncConfig, log)
+		})
+	case "file":
+		log := log.WithField("component", "gatewayconfigurer").WithField("source", cfg.GatewayConfigFilePath)
+		updater := gatewayconfigurer.NewFile(log, db, cfg.GatewayConfigFilePath, cfg.WireGuardPrefixes(), grpcHandler.SendAllGatewayConfigurations)
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			err := updater.Run(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.WithError(err).Error("watching gateway config file failed")
+				cancel()
+			}
+		})
+	default:
+		log.Warn("no valid gateway configurer set, gateways won't be updated")
+	}
+
+	if wgSync != nil {
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDoneOrTriggered(ctx, intervalWireGuardSync, grpcHandler.PeersChanged(), wgSync, log.WithField("component", "wireguard"))
+		})
+	}
+
+	if cfg.KolideIntegrationEnabled {
+		// sync all devices continuously
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, intervalKolideFullSync, grpcHandler.UpdateAllDevices, log.WithField("component", "kolide-device-sync"))
+		}, func(ctx context.Context) {
+			untilContextDone(ctx, intervalKolideCacheRefresh, grpcHandler.UpdateKolideChecks, log.WithField("component", "kolide-checks-sync"))
+		})
+	}
+
+	if groupMembership != nil {
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			untilContextDone(ctx, cfg.GroupRefreshInterval, grpcHandler.RefreshSessionGroups, log.WithField("component", "session-groups"))
+		})
+	}
+
+	if posturePolicy != nil {
+		go untilContextDone(ctx, intervalPosturePolicySync, grpcHandler.SyncPosturePolicy, log.WithField("component", "posture-policy"))
+	}
+
+	if postureFeed != nil {
+		// every instance keeps its own copy of the feed, so that a standby is ready to take over
+		go untilContextDone(ctx, cfg.PostureFeedInterval, func(ctx context.Context) error {
+			changed, err := postureFeed.Sync(ctx)
+			if changed {
+				return errors.Join(err, grpcHandler.RefreshDevicePosture(ctx))
+			}
+			return err
+		}, log.WithField("component", "posture-feed"))
+	}
+
+	if cfg.AgentLatestVersion == "" && cfg.AgentReleasesURL != "" {
+		// every instance looks up releases itself, so that a standby is ready to take over
+		releases := agentversion.NewReleases(cfg.AgentReleasesURL, agentVersions, log.WithField("component", "agent-releases"))
+		go untilContextDone(ctx, cfg.AgentReleaseCheckInterval, func(ctx context.Context) error {
+			changed, err := releases.Sync(ctx)
+			if changed {
+				grpcHandler.SendAllDeviceConfigurations()
+			}
+			return err
+		}, log.WithField("component", "agent-releases"))
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{MinTime: 9 * time.Second}),
 		grpc.StatsHandler(otel.NewGRPCClientHandler(pb.APIServer_GetDeviceConfiguration_FullMethodName, pb.APIServer_GetGatewayConfiguration_FullMethodName)),
 	}
 
+	if cfg.GRPCTLSCertFile != "" {
+		certificates, err := tlsconfig.NewReloader(cfg.GRPCTLSCertFile, cfg.GRPCTLSKeyFile, cfg.GRPCTLSClientCAFile)
+		if err != nil {
+			return fmt.Errorf("set up gRPC TLS: %w", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(certificates.ServerConfig())))
+
+		// certificates are renewed in place, e.g. by cert-manager, so pick up new files without restarting
+		log := log.WithField("component", "grpc-tls")
+		go untilContextDone(ctx, cfg.GRPCTLSReloadInterval, func(context.Context) error {
+			reloaded, err := certificates.Reload()
+			if reloaded {
+				log.Info("reloaded gRPC TLS certificate")
+			}
+			return err
+		}, log)
+
+		log.WithField("client_certificates", cfg.GRPCTLSClientCAFile != "").Info("gRPC TLS enabled")
+	} else if cfg.GRPCTLSClientCAFile != "" {
+		return fmt.Errorf("gRPC client CA configured, but TLS is not enabled (try --grpc-tls-cert-file and --grpc-tls-key-file)")
+	}
+
+	if cfg.HAEnabled {
+		log := log.WithField("component", "leader-election").WithField("instance", cfg.HAInstanceID)
+		elector := leader.NewElector(db, cfg.HAInstanceID, cfg.HALeaseDuration, log)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(elector.UnaryServerInterceptor),
+			grpc.ChainStreamInterceptor(elector.StreamServerInterceptor),
+		)
+
+		go elector.Run(ctx, func(ctx context.Context) {
+			// pick up logins and revocations made since the last reload
+			if err := sessions.Reload(ctx); err != nil {
+				log.WithError(err).Error("reload sessions on takeover")
+			}
+
+			runLeaderTasks(ctx, leaderTasks)
+
+			// another instance may already be active, so hand over its clients and stop routing their traffic
+			grpcHandler.EndStreams()
+			if wgClear != nil {
+				if err := wgClear(); err != nil {
+					log.WithError(err).Error("remove WireGuard peers on step down")
+				}
+			}
+		})
+
+		// keep the session cache warm while on standby, so that a takeover does not start out cold
+		go untilContextDone(ctx, intervalSessionReload, func(ctx context.Context) error {
+			if elector.IsLeader() {
+				return nil
+			}
+			return sessions.Reload(ctx)
+		}, log.WithField("component", "session-reload"))
+
+		log.Info("high availability enabled, waiting for leadership")
+	} else {
+		metrics.SetLeader(true)
+		go runLeaderTasks(ctx, leaderTasks)
+	}
+
+	// authenticate after the leader check, so that a standby does not authenticate requests it can not serve
+	opts = append(opts, grpcHandler.ServerOptions()...)
+
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterAPIServerServer(grpcServer, grpcHandler)
 
@@ -328,12 +568,6 @@ func run(log *logrus.Entry, cfg config.Config) error {
 		cancel()
 	}()
 
-	if cfg.KolideIntegrationEnabled {
-		// sync all devices continuously
-		go untilContextDone(ctx, intervalKolideFullSync, grpcHandler.UpdateAllDevices, log.WithField("component", "kolide-device-sync"))
-		go untilContextDone(ctx, intervalKolideCacheRefresh, grpcHandler.UpdateKolideChecks, log.WithField("component", "kolide-checks-sync"))
-	}
-
 	// initialize gateway metrics
 	gateways, err := db.ReadGateways(ctx)
 	if err != nil {
@@ -345,58 +579,54 @@ func run(log *logrus.Entry, cfg config.Config) error {
 
 	defer grpcServer.Stop()
 
-	go func() {
-		updateDevice := func(event *kolidepb.DeviceEvent) error {
-			device, err := db.ReadDeviceByExternalID(ctx, event.GetExternalID())
-			if err != nil {
-				return fmt.Errorf("read device with external_id=%v: %w", event.GetExternalID(), err)
-			}
-
-			kolideDevice, err := kolideClient.GetDevice(ctx, device.ExternalID)
-			if err != nil {
-				return fmt.Errorf("get kolide device %v: %w", device.ExternalID, err)
-			}
-
-			if !strings.EqualFold(kolideDevice.Owner.Email, device.Username) {
-				log.WithFields(logrus.Fields{
-					"device_serial":      device.Serial,
-					"device_platform":    device.Platform,
-					"device_username":    device.Username,
-					"kolide_owner_email": kolideDevice.Owner.Email,
-					"kolide_owner_id":    kolideDevice.OwnerRef.Identifier,
-				}).Warn("kolide device owner email does not match enrolled username")
-			}
+	updateDevice := func(ctx context.Context, event *kolidepb.DeviceEvent) error {
+		device, err := db.ReadDeviceByExternalID(ctx, event.GetExternalID())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read device with external_id=%v: %w", event.GetExternalID(), err)
+		}
 
-			failures, err := kolideClient.GetDeviceIssues(ctx, device.ExternalID)
-			if err != nil {
-				return err
-			}
+		kolideDevice, err := kolideClient.GetDevice(ctx, device.ExternalID)
+		if err != nil {
+			return fmt.Errorf("get kolide device %v: %w", device.ExternalID, err)
+		}
 
-			sessions.RefreshDevice(device)
+		if !strings.EqualFold(kolideDevice.Owner.Email, device.Username) {
+			log.WithFields(logrus.Fields{
+				"device_serial":      device.Serial,
+				"device_platform":    device.Platform,
+				"device_username":    device.Username,
+				"kolide_owner_email": kolideDevice.Owner.Email,
+				"kolide_owner_id":    kolideDevice.OwnerRef.Identifier,
+			}).Warn("kolide device owner email does not match enrolled username")
+		}
 
-			err = db.LinkKolideDevice(ctx, device.ExternalID, device.Serial, device.Platform)
-			if err != nil {
-				return err
-			}
+		failures, err := kolideClient.GetDeviceIssues(ctx, device.ExternalID)
+		if err != nil {
+			return err
+		}
 
-			err = db.UpdateKolideIssuesForDevice(ctx, device.ExternalID, failures)
-			if err != nil {
-				return err
-			}
+		sessions.RefreshDevice(device)
 
-			grpcHandler.SendDeviceConfiguration(device)
-			grpcHandler.SendAllGatewayConfigurations()
-			return nil
+		err = db.LinkKolideDevice(ctx, device.ExternalID, device.Serial, device.Platform)
+		if err != nil {
+			return err
 		}
 
-		for event := range deviceUpdates {
-			if err := updateDevice(event); err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.WithError(err).Error("update device health")
-				}
-			}
+		err = db.UpdateKolideIssuesForDevice(ctx, device.ExternalID, failures)
+		if err != nil {
+			return err
 		}
-	}()
+
+		grpcHandler.SendDeviceConfiguration(device)
+		grpcHandler.SendAllGatewayConfigurations()
+		return nil
+	}
+
+	eventQueue := kolide.NewEventQueue(cfg.KolideEventCoalesceWindow, cfg.KolideEventWorkers, updateDevice, log.WithField("component", "kolide-events"))
+	go eventQueue.Run(ctx, deviceUpdates)
 
 	<-ctx.Done()
 
@@ -404,6 +634,32 @@ func run(log *logrus.Entry, cfg config.Config) error {
 	return nil
 }
 
+func openDatabase(cfg config.Config, v4Allocator, v6Allocator ip.Allocator, log logrus.FieldLogger, opts ...database.Option) (database.Database, error) {
+	switch cfg.DBDriver {
+	case "sqlite":
+		log.WithField("path", cfg.DBPath).Info("using SQLite database")
+		return database.New(cfg.DBPath, v4Allocator, v6Allocator, cfg.KolideEventsEnabled(), log, opts...)
+	case "postgres":
+		log.Info("using PostgreSQL database")
+		return database.NewPostgres(cfg.DBURL, v4Allocator, v6Allocator, cfg.KolideEventsEnabled(), log, opts...)
+	default:
+		return nil, fmt.Errorf("unknown database driver %q, expected sqlite or postgres", cfg.DBDriver)
+	}
+}
+
+// runLeaderTasks runs the tasks that must only run on the active apiserver instance, and waits for them to return after ctx is done.
+func runLeaderTasks(ctx context.Context, tasks []func(context.Context)) {
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
 func readd(ctx context.Context, db database.Database) error {
 	gateways, err := db.ReadGateways(ctx)
 	if err != nil {
@@ -435,6 +691,11 @@ func readd(ctx context.Context, db database.Database) error {
 }
 
 func untilContextDone(ctx context.Context, interval time.Duration, f func(context.Context) error, log logrus.FieldLogger) {
+	untilContextDoneOrTriggered(ctx, interval, nil, f, log)
+}
+
+// untilContextDoneOrTriggered runs f on every interval, and additionally whenever trigger fires.
+func untilContextDoneOrTriggered(ctx context.Context, interval time.Duration, trigger <-chan struct{}, f func(context.Context) error, log logrus.FieldLogger) {
 	log.WithField("interval", interval.String()).Info("running until context done")
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
@@ -447,6 +708,8 @@ func untilContextDone(ctx context.Context, interval time.Duration, f func(contex
 		select {
 		case <-ticker.C:
 			log.Debug("tick")
+		case <-trigger:
+			log.Debug("triggered")
 		case <-ctx.Done():
 			log.Info("context done; stopping")
 			return
@@ -11,7 +11,6 @@ import (
 	"github.com/nais/device/internal/ioconvenience"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 
@@ -157,6 +156,11 @@ func run(log *logrus.Entry, cfg config.Config) error {
 		return fmt.Errorf("apply wireguard config: %w", err)
 	}
 
+	transportCredentials, err := cfg.APIServerTLS.TransportCredentials()
+	if err != nil {
+		return fmt.Errorf("apiserver TLS: %w", err)
+	}
+
 	log.WithField("url", cfg.APIServerURL).Info("attempting gRPC connection to apiserver")
 	apiserver, err := grpc.NewClient(
 		cfg.APIServerURL,
@@ -165,7 +169,7 @@ func run(log *logrus.Entry, cfg config.Config) error {
 			Timeout:             2 * time.Second,
 			PermitWithoutStream: false,
 		}),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(transportCredentials),
 	)
 	if err != nil {
 		return fmt.Errorf("unable to connect to api server: %w", err)
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -30,14 +29,12 @@ import (
 	"github.com/sirupsen/logrus"
 	flag "github.com/spf13/pflag"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
-	healthCheckInterval  = 20 * time.Second // how often to healthcheck gateways
-	versionCheckInterval = 1 * time.Hour    // how often to check for a new version of naisdevice
+	healthCheckInterval = 20 * time.Second // how often to healthcheck gateways
 )
 
 func main() {
@@ -55,6 +52,8 @@ func main() {
 	flag.StringVar(&cfg.GoogleAuthServerAddress, "google-auth-server-address", cfg.GoogleAuthServerAddress, "Google auth-server address")
 	flag.BoolVar(&cfg.LocalAPIServer, "local-apiserver", false, "Connect to a local apiserver on 127.0.0.1:8099 using mock authentication")
 	flag.StringVar(&cfg.CustomEnrollURL, "custom-enroll-url", "", "Connect to a custom enroller")
+	flag.BoolVar(&cfg.APIServerTLS, "apiserver-tls", false, "Connect to the apiserver with TLS")
+	flag.StringVar(&cfg.APIServerCAFile, "apiserver-ca-file", "", "CA bundle for verifying the apiserver, implies -apiserver-tls")
 	flag.Parse()
 
 	cfg.SetDefaults()
@@ -205,17 +204,17 @@ func run(ctx context.Context, log *logrus.Entry, cfg *config.Config, notifier no
 	das := deviceagent.NewServer(ctx, log.WithField("component", "device-agent-server"), cfg, rc, notifier, stateMachine.SendEvent, cancel, acceptableUseHandler, jitaHandler, authHandler)
 	pb.RegisterDeviceAgentServer(grpcServer, das)
 
-	newVersionChannel := make(chan bool, 1)
-	go versionChecker(ctx, newVersionChannel, notifier, log, rc)
-
 	go func() {
 		// This routine forwards status updates from the state machine to the device agent server
-		newVersionAvailable := false
+		notifiedNewVersion := false
 		for ctx.Err() == nil {
 			select {
-			case newVersionAvailable = <-newVersionChannel:
 			case s := <-statusChannel:
-				s.NewVersionAvailable = newVersionAvailable
+				// the apiserver tells us about new versions, so only notify the first time
+				if s.NewVersionAvailable && !notifiedNewVersion {
+					notifyNewVersion(notifier, rc)
+					notifiedNewVersion = true
+				}
 				s.Tenants = rc.Tenants()
 				das.UpdateAgentStatus(s)
 			case <-ctx.Done():
@@ -239,33 +238,13 @@ func run(ctx context.Context, log *logrus.Entry, cfg *config.Config, notifier no
 	return nil
 }
 
-func versionChecker(ctx context.Context, newVersionChannel chan<- bool, notifier notify.Notifier, log logrus.FieldLogger, rc runtimeconfig.RuntimeConfig) {
-	versionCheckTimer := time.NewTimer(versionCheckInterval)
-	for ctx.Err() == nil {
-		select {
-		case <-ctx.Done():
-			return
-		case <-versionCheckTimer.C:
-			newVersionAvailable, err := checkNewVersionAvailable(ctx)
-			if err != nil {
-				log.WithError(err).Info("check for new version")
-				break
-			}
-
-			newVersionChannel <- newVersionAvailable
-			if newVersionAvailable {
-				url := "https://docs.nais.io/how-to-guides/naisdevice/update"
-				domain := rc.GetDomainFromToken()
-				if domain != "default" { // if parsing fail we get default
-					url = fmt.Sprintf("https://docs.%s.cloud.nais.io/how-to-guides/naisdevice/update", domain)
-				}
-				notifier.Infof("New version of device agent available: %s", url)
-				versionCheckTimer.Stop()
-			} else {
-				versionCheckTimer.Reset(versionCheckInterval)
-			}
-		}
+func notifyNewVersion(notifier notify.Notifier, rc runtimeconfig.RuntimeConfig) {
+	url := "https://docs.nais.io/how-to-guides/naisdevice/update"
+	domain := rc.GetDomainFromToken()
+	if domain != "default" { // if parsing fail we get default
+		url = fmt.Sprintf("https://docs.%s.cloud.nais.io/how-to-guides/naisdevice/update", domain)
 	}
+	notifier.Infof("New version of device agent available: %s", url)
 }
 
 func helperHealthCheck(ctx context.Context, client pb.DeviceHelperClient) error {
@@ -277,45 +256,3 @@ func helperHealthCheck(ctx context.Context, client pb.DeviceHelperClient) error
 	}
 	return nil
 }
-
-func checkNewVersionAvailable(ctx context.Context) (bool, error) {
-	type response struct {
-		Tag string `json:"tag_name"`
-	}
-
-	ctx, span := otel.Start(ctx, "CheckNewVersionAvailable")
-	defer span.End()
-
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.github.com/repos/nais/device/releases/latest", nil)
-	if err != nil {
-		span.RecordError(err)
-		return false, err
-	}
-
-	client := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
-	resp, err := client.Do(req)
-	if err != nil {
-		span.RecordError(err)
-		return false, fmt.Errorf("retrieve current release version: %s", err)
-	}
-
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		err := fmt.Errorf("unexpected status code from GitHub API when checking for new version: %d", resp.StatusCode)
-		span.RecordError(err)
-		return false, err
-	}
-
-	res := &response{}
-	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
-		span.RecordError(err)
-		return false, fmt.Errorf("unmarshal response: %s", err)
-	}
-
-	if res.Tag != "" && version.Version != res.Tag {
-		return true, nil
-	}
-
-	return false, nil
-}
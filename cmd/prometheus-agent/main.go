@@ -10,13 +10,13 @@ import (
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/nais/device/internal/basicauth"
 	"github.com/nais/device/internal/program"
 	"github.com/nais/device/pkg/pb"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/nais/device/internal/logger"
 	prometheusagent "github.com/nais/device/internal/prometheus-agent"
@@ -107,7 +107,12 @@ func run(log logrus.FieldLogger, cfg config.Config) error {
 		return fmt.Errorf("apply initial WireGuard config: %w", err)
 	}
 
-	grpcClient, err := grpc.NewClient(cfg.APIServerURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transportCredentials, err := cfg.APIServerTLS.TransportCredentials()
+	if err != nil {
+		return fmt.Errorf("apiserver TLS: %w", err)
+	}
+
+	grpcClient, err := grpc.NewClient(cfg.APIServerURL, grpc.WithTransportCredentials(transportCredentials))
 	if err != nil {
 		return fmt.Errorf("grpc dial: %w", err)
 	}
@@ -150,10 +155,8 @@ func run(log logrus.FieldLogger, cfg config.Config) error {
 func listGateways(ctx context.Context, cfg config.Config, client pb.APIServerClient) ([]*pb.Gateway, error) {
 	const listCap = 128
 
-	stream, err := client.ListGateways(ctx, &pb.ListGatewayRequest{
-		Username: cfg.APIServerUsername,
-		Password: cfg.APIServerPassword,
-	})
+	credentials := basicauth.Credentials{Username: cfg.APIServerUsername, Password: cfg.APIServerPassword}
+	stream, err := client.ListGateways(ctx, &pb.ListGatewayRequest{}, grpc.PerRPCCredentials(credentials))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,185 @@
+package agentversion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/posture"
+	"github.com/nais/device/pkg/pb"
+	"golang.org/x/mod/semver"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Policy holds the minimum naisdevice agent versions that devices must run, and the latest version available.
+// A nil policy enforces nothing.
+type Policy struct {
+	minimum     string
+	perPlatform map[string]string
+	gracePeriod time.Duration
+
+	lock   sync.RWMutex
+	latest string
+}
+
+var _ posture.Provider = &Policy{}
+
+// ParsePolicy parses minimum version entries on the format [platform:]version, e.g. v1.4.0 or windows:v1.4.2.
+// An entry for a specific platform takes precedence over an entry without platform.
+// latest may be empty if the latest version is unknown, and can be set later with SetLatest.
+func ParsePolicy(minimumVersions []string, latest string, gracePeriod time.Duration) (*Policy, error) {
+	p := &Policy{
+		perPlatform: make(map[string]string),
+		gracePeriod: gracePeriod,
+	}
+
+	for _, entry := range minimumVersions {
+		platform, version, found := strings.Cut(entry, ":")
+		if !found {
+			platform, version = "", entry
+		}
+
+		version = canonical(version)
+		if version == "" {
+			return nil, fmt.Errorf("invalid minimum agent version %q, expected [platform:]version, e.g. windows:v1.4.2", entry)
+		}
+
+		switch platform {
+		case "":
+			p.minimum = version
+		case "darwin", "linux", "windows":
+			p.perPlatform[platform] = version
+		default:
+			return nil, fmt.Errorf("invalid platform %q in minimum agent version %q, expected darwin, linux or windows", platform, entry)
+		}
+	}
+
+	if latest != "" {
+		p.latest = canonical(latest)
+		if p.latest == "" {
+			return nil, fmt.Errorf("invalid latest agent version %q", latest)
+		}
+	}
+
+	return p, nil
+}
+
+// Minimum returns the minimum version required on platform, or an empty string if there is none.
+func (p *Policy) Minimum(platform string) string {
+	if p == nil {
+		return ""
+	}
+
+	if version, ok := p.perPlatform[platform]; ok {
+		return version
+	}
+
+	return p.minimum
+}
+
+// GracePeriod is how long a device may keep connecting after it is first seen running an outdated version.
+func (p *Policy) GracePeriod() time.Duration {
+	if p == nil {
+		return 0
+	}
+
+	return p.gracePeriod
+}
+
+// Outdated reports whether version is below the minimum for platform.
+// Versions that can not be parsed, such as development builds, are never outdated.
+func (p *Policy) Outdated(version, platform string) bool {
+	minimum := p.Minimum(platform)
+	version = canonical(version)
+	if minimum == "" || version == "" {
+		return false
+	}
+
+	return semver.Compare(version, minimum) < 0
+}
+
+// NewVersionAvailable reports whether the device should be told to update,
+// either because version is outdated or because it is below the latest version.
+func (p *Policy) NewVersionAvailable(version, platform string) bool {
+	if p.Outdated(version, platform) {
+		return true
+	}
+
+	latest := p.Latest()
+	version = canonical(version)
+	if latest == "" || version == "" {
+		return false
+	}
+
+	return semver.Compare(version, latest) < 0
+}
+
+// Latest returns the latest version available, or an empty string if it is unknown.
+func (p *Policy) Latest() string {
+	if p == nil {
+		return ""
+	}
+
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.latest
+}
+
+// SetLatest sets the latest version available, and reports whether it changed.
+func (p *Policy) SetLatest(latest string) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+
+	version := canonical(latest)
+	if version == "" {
+		return false, fmt.Errorf("invalid latest agent version %q", latest)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.latest == version {
+		return false, nil
+	}
+	p.latest = version
+
+	return true, nil
+}
+
+func (p *Policy) Name() string {
+	return "agentversion"
+}
+
+// DeviceIssues returns an issue if the device runs an agent version below the minimum, with a deadline a grace period
+// after the device was first seen running it. The device becomes unhealthy once the deadline has passed.
+// Devices not yet seen running an outdated version have no issue.
+func (p *Policy) DeviceIssues(_ context.Context, device *pb.Device) ([]*pb.DeviceIssue, error) {
+	if device.GetAgentVersionOutdatedSince() == nil || !p.Outdated(device.GetAgentVersion(), device.GetPlatform()) {
+		return nil, nil
+	}
+
+	since := device.GetAgentVersionOutdatedSince().AsTime()
+	deadline := since.Add(p.GracePeriod())
+
+	return []*pb.DeviceIssue{{
+		Title:         pb.IssueTitleAgentOutdated,
+		Message:       fmt.Sprintf("naisdevice %s is no longer supported, update naisdevice to %s or newer before %s to keep your access.", device.GetAgentVersion(), p.Minimum(device.GetPlatform()), deadline.Local().Format(time.DateTime)),
+		Severity:      pb.Severity_Warning,
+		DetectedAt:    timestamppb.New(since),
+		LastUpdated:   timestamppb.New(since),
+		ResolveBefore: timestamppb.New(deadline),
+	}}, nil
+}
+
+// canonical returns version as a semantic version with a leading v, or an empty string if it is not valid.
+func canonical(version string) string {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	return semver.Canonical(version)
+}
@@ -0,0 +1,92 @@
+package agentversion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("platform entry takes precedence", func(t *testing.T) {
+		p, err := ParsePolicy([]string{"v1.4.0", "windows:1.4.2"}, "", time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "v1.4.0", p.Minimum("darwin"))
+		assert.Equal(t, "v1.4.2", p.Minimum("windows"))
+		assert.Equal(t, time.Hour, p.GracePeriod())
+	})
+
+	t.Run("invalid entries are rejected", func(t *testing.T) {
+		_, err := ParsePolicy([]string{"latest"}, "", time.Hour)
+		assert.Error(t, err)
+
+		_, err = ParsePolicy([]string{"freebsd:v1.0.0"}, "", time.Hour)
+		assert.Error(t, err)
+
+		_, err = ParsePolicy(nil, "unknown", time.Hour)
+		assert.Error(t, err)
+	})
+}
+
+func TestOutdated(t *testing.T) {
+	p, err := ParsePolicy([]string{"v1.4.0", "windows:v1.4.2"}, "v1.5.0", time.Hour)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		version          string
+		platform         string
+		outdated         bool
+		newVersionExists bool
+	}{
+		{"v1.3.9", "darwin", true, true},
+		{"1.3.9", "linux", true, true},
+		{"v1.4.0", "linux", false, true},
+		{"v1.4.1", "windows", true, true},
+		{"v1.4.2", "windows", false, true},
+		{"v1.5.0", "windows", false, false},
+		{"v1.10.0", "darwin", false, false},
+		{"local", "darwin", false, false},
+		{"", "darwin", false, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.outdated, p.Outdated(tt.version, tt.platform), "%s on %s", tt.version, tt.platform)
+		assert.Equal(t, tt.newVersionExists, p.NewVersionAvailable(tt.version, tt.platform), "%s on %s", tt.version, tt.platform)
+	}
+
+	var nilPolicy *Policy
+	assert.False(t, nilPolicy.Outdated("v0.0.1", "darwin"))
+	assert.False(t, nilPolicy.NewVersionAvailable("v0.0.1", "darwin"))
+}
+
+func TestDeviceIssues(t *testing.T) {
+	p, err := ParsePolicy([]string{"v1.4.0"}, "", 24*time.Hour)
+	assert.NoError(t, err)
+
+	since := time.Now().Add(-time.Hour)
+	device := &pb.Device{AgentVersion: "v1.3.0", Platform: "linux", AgentVersionOutdatedSince: timestamppb.New(since)}
+
+	issues, err := p.DeviceIssues(context.Background(), device)
+	assert.NoError(t, err)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, pb.IssueTitleAgentOutdated, issues[0].GetTitle())
+		assert.Equal(t, since.Add(24*time.Hour).Unix(), issues[0].GetResolveBefore().AsTime().Unix())
+		assert.Contains(t, issues[0].GetMessage(), "v1.4.0")
+	}
+
+	// not yet seen running an outdated version
+	device.AgentVersionOutdatedSince = nil
+	issues, err = p.DeviceIssues(context.Background(), device)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// updated, but the outdated timestamp is not cleared yet
+	device.AgentVersion = "v1.4.0"
+	device.AgentVersionOutdatedSince = timestamppb.New(since)
+	issues, err = p.DeviceIssues(context.Background(), device)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
@@ -0,0 +1,70 @@
+package agentversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// GitHubLatestRelease is the GitHub API endpoint for the latest naisdevice release.
+const GitHubLatestRelease = "https://api.github.com/repos/nais/device/releases/latest"
+
+// Releases looks up the latest naisdevice release, and keeps the latest version of a policy up to date.
+type Releases struct {
+	url    string
+	policy *Policy
+	client *http.Client
+	log    logrus.FieldLogger
+}
+
+// NewReleases creates a release lookup reading the latest release from url, in the format of the GitHub releases API.
+func NewReleases(url string, policy *Policy, log logrus.FieldLogger) *Releases {
+	return &Releases{
+		url:    url,
+		policy: policy,
+		client: &http.Client{Timeout: 30 * time.Second},
+		log:    log,
+	}
+}
+
+// Sync looks up the latest release, and reports whether the latest version of the policy changed.
+// If the lookup fails, the latest version is kept.
+func (r *Releases) Sync(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("get latest release: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("get latest release: unexpected status code %d", resp.StatusCode)
+	}
+
+	var release struct {
+		Tag string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return false, fmt.Errorf("unmarshal latest release: %w", err)
+	}
+
+	changed, err := r.policy.SetLatest(release.Tag)
+	if err != nil {
+		return false, err
+	}
+
+	if changed {
+		r.log.WithField("version", r.policy.Latest()).Info("new naisdevice release")
+	}
+
+	return changed, nil
+}
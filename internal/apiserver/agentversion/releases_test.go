@@ -0,0 +1,48 @@
+package agentversion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesSync(t *testing.T) {
+	status, tag := http.StatusOK, "v1.5.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"tag_name":"` + tag + `"}`))
+	}))
+	defer server.Close()
+
+	p, err := ParsePolicy([]string{"v1.4.0"}, "", time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, p.NewVersionAvailable("v1.4.0", "linux"))
+
+	releases := NewReleases(server.URL, p, logrus.New())
+
+	changed, err := releases.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, p.NewVersionAvailable("v1.4.0", "linux"))
+	assert.False(t, p.NewVersionAvailable("v1.5.0", "linux"))
+
+	changed, err = releases.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// failed lookups keep the latest version
+	status = http.StatusInternalServerError
+	_, err = releases.Sync(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "v1.5.0", p.Latest())
+
+	status, tag = http.StatusOK, "not a version"
+	_, err = releases.Sync(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "v1.5.0", p.Latest())
+}
@@ -2,29 +2,37 @@ package api
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/pkg/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-func (s *grpcServer) addOrUpdateGateway(ctx context.Context, r *pb.ModifyGatewayRequest, callback func(context.Context, *pb.Gateway) error) (*pb.ModifyGatewayResponse, error) {
-	err := s.adminAuth.Authenticate(ctx, r.GetUsername(), r.GetPassword())
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticate: %v", err)
-	}
+func (s *grpcServer) addOrUpdateGateway(ctx context.Context, r *pb.ModifyGatewayRequest, action string, callback func(context.Context, *pb.Gateway) error) (*pb.ModifyGatewayResponse, error) {
+	admin := auth.AdminFromContext(ctx)
 
 	gw := r.GetGateway()
 	if gw == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "get gateway: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "gateway not specified")
 	}
 
-	err = callback(ctx, gw)
+	err := callback(ctx, gw)
 	if err != nil {
 		return nil, status.Errorf(codes.DataLoss, "callback: %v", err)
 	}
 
+	s.audit(ctx, admin.Name, action, gatewayTarget(gw.Name), "")
+
 	gw, err = s.db.ReadGateway(ctx, gw.Name)
 	if err != nil {
 		return nil, status.Errorf(codes.Aborted, "gateway has been added, but reading back from database returned error: %v", err)
@@ -36,28 +44,53 @@ func (s *grpcServer) addOrUpdateGateway(ctx context.Context, r *pb.ModifyGateway
 }
 
 func (s *grpcServer) EnrollGateway(ctx context.Context, r *pb.ModifyGatewayRequest) (*pb.ModifyGatewayResponse, error) {
-	return s.addOrUpdateGateway(ctx, r, s.db.AddGateway)
+	return s.addOrUpdateGateway(ctx, r, database.AuditActionGatewayEnroll, s.db.AddGateway)
 }
 
 func (s *grpcServer) UpdateGateway(ctx context.Context, r *pb.ModifyGatewayRequest) (*pb.ModifyGatewayResponse, error) {
-	return s.addOrUpdateGateway(ctx, r, s.db.UpdateGateway)
+	return s.addOrUpdateGateway(ctx, r, database.AuditActionGatewayUpdate, s.db.UpdateGateway)
 }
 
-func (s *grpcServer) GetGateway(ctx context.Context, r *pb.ModifyGatewayRequest) (*pb.Gateway, error) {
-	err := s.adminAuth.Authenticate(ctx, r.GetUsername(), r.GetPassword())
-	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticate: %v", err)
+func (s *grpcServer) DeleteGateway(ctx context.Context, r *pb.ModifyGatewayRequest) (*pb.DeleteGatewayResponse, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	name := r.GetGateway().GetName()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "gateway name not specified")
+	}
+
+	err := s.db.DeleteGateway(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "gateway %q not found", name)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete gateway: %v", err)
 	}
 
+	s.log.WithField("gateway", name).Info("gateway deleted")
+	s.audit(ctx, admin.Name, database.AuditActionGatewayDelete, gatewayTarget(name), "")
+
+	// closing the trigger ends any open configuration stream for this gateway
+	s.gateways.Remove(name)
+	s.SendAllDeviceConfigurations()
+	s.notifyPeersChanged()
+
+	return &pb.DeleteGatewayResponse{}, nil
+}
+
+func (s *grpcServer) GetGateway(ctx context.Context, r *pb.ModifyGatewayRequest) (*pb.Gateway, error) {
 	return s.db.ReadGateway(ctx, r.GetGateway().GetName())
 }
 
-func (s *grpcServer) ListGateways(request *pb.ListGatewayRequest, stream pb.APIServer_ListGatewaysServer) error {
-	err := authenticateAny(stream.Context(), request.GetUsername(), request.GetPassword(), s.adminAuth, s.prometheusAuth)
-	if err != nil {
-		return status.Error(codes.Unauthenticated, err.Error())
+func (s *grpcServer) GetWireGuardPrefixes(ctx context.Context, r *pb.GetWireGuardPrefixesRequest) (*pb.GetWireGuardPrefixesResponse, error) {
+	resp := &pb.GetWireGuardPrefixesResponse{}
+	for _, prefix := range s.wireguardPrefixes {
+		resp.Prefixes = append(resp.Prefixes, prefix.String())
 	}
 
+	return resp, nil
+}
+
+func (s *grpcServer) ListGateways(request *pb.ListGatewayRequest, stream pb.APIServer_ListGatewaysServer) error {
 	gateways, err := s.db.ReadGateways(stream.Context())
 	if err != nil {
 		return status.Error(codes.Unavailable, err.Error())
@@ -72,23 +105,381 @@ func (s *grpcServer) ListGateways(request *pb.ListGatewayRequest, stream pb.APIS
 	return nil
 }
 
-func (s *grpcServer) GetSessions(ctx context.Context, r *pb.GetSessionsRequest) (*pb.GetSessionsResponse, error) {
-	err := s.adminAuth.Authenticate(ctx, r.GetUsername(), r.GetPassword())
+func (s *grpcServer) ListDevices(ctx context.Context, r *pb.ListDevicesRequest) (*pb.ListDevicesResponse, error) {
+	devices, err := s.db.ReadDevices(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "read devices: %v", err)
+	}
+
+	var filters []func(*pb.Device) bool
+	if r.GetDeviceUsername() != "" {
+		filters = append(filters, deviceHasUsername(r.GetDeviceUsername()))
+	}
+
+	if r.GetPlatform() != "" {
+		filters = append(filters, deviceIsPlatform(r.GetPlatform()))
+	}
+
+	switch r.GetHealth() {
+	case pb.DeviceHealthFilter_DeviceHealthHealthy:
+		filters = append(filters, deviceIsHealthy)
+	case pb.DeviceHealthFilter_DeviceHealthUnhealthy:
+		filters = append(filters, not(deviceIsHealthy))
+	}
+
+	return &pb.ListDevicesResponse{
+		Devices: filterList(devices, filters...),
+	}, nil
+}
+
+func (s *grpcServer) GetDevice(ctx context.Context, r *pb.GetDeviceRequest) (*pb.Device, error) {
+	device, err := s.db.ReadDeviceByID(ctx, r.GetDeviceID())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "device %d not found", r.GetDeviceID())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "read device: %v", err)
+	}
+
+	return device, nil
+}
+
+func (s *grpcServer) DeleteDevice(ctx context.Context, r *pb.DeleteDeviceRequest) (*pb.DeleteDeviceResponse, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	err := s.db.DeleteDevice(ctx, r.GetDeviceID())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "device %d not found", r.GetDeviceID())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete device: %v", err)
+	}
+
+	s.log.WithField("deviceId", r.GetDeviceID()).Info("device deleted")
+	s.audit(ctx, admin.Name, database.AuditActionDeviceDelete, deviceTarget(r.GetDeviceID()), "")
+
+	s.dropDevice(r.GetDeviceID())
+	s.notifyPeersChanged()
+
+	return &pb.DeleteDeviceResponse{}, nil
+}
+
+func (s *grpcServer) ReassignDevice(ctx context.Context, r *pb.ReassignDeviceRequest) (*pb.ReassignDeviceResponse, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	if r.GetNewDeviceUsername() == "" {
+		return nil, status.Error(codes.InvalidArgument, "new device username not specified")
+	}
+
+	err := s.db.ReassignDevice(ctx, r.GetDeviceID(), r.GetNewDeviceUsername())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "device %d not found", r.GetDeviceID())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "reassign device: %v", err)
+	}
+
+	s.log.WithField("deviceId", r.GetDeviceID()).WithField("username", r.GetNewDeviceUsername()).Info("device reassigned")
+	s.audit(ctx, admin.Name, database.AuditActionDeviceReassign, deviceTarget(r.GetDeviceID()), "reassigned to "+r.GetNewDeviceUsername())
+
+	// the previous owner's session is no longer valid
+	s.dropDevice(r.GetDeviceID())
+	s.notifyPeersChanged()
+
+	device, err := s.db.ReadDeviceByID(ctx, r.GetDeviceID())
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticate: %v", err)
+		return nil, status.Errorf(codes.Aborted, "device has been reassigned, but reading back from database returned error: %v", err)
 	}
 
+	return &pb.ReassignDeviceResponse{
+		Device: device,
+	}, nil
+}
+
+// dropDevice evicts the cached session of a device, ends its configuration stream and removes it from all gateways.
+func (s *grpcServer) dropDevice(deviceID int64) {
+	s.sessionStore.RemoveDevice(deviceID)
+	s.devices.Remove(deviceID)
+	s.SendAllGatewayConfigurations()
+}
+
+func (s *grpcServer) GetSessions(ctx context.Context, r *pb.GetSessionsRequest) (*pb.GetSessionsResponse, error) {
 	return &pb.GetSessionsResponse{
 		Sessions: s.sessionStore.All(),
 	}, nil
 }
 
-func (s *grpcServer) GetKolideCache(ctx context.Context, r *pb.GetKolideCacheRequest) (*pb.GetKolideCacheResponse, error) {
-	err := s.adminAuth.Authenticate(ctx, r.GetUsername(), r.GetPassword())
+func (s *grpcServer) RevokeSessions(ctx context.Context, r *pb.RevokeSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	var revoked []*pb.Session
+	var err error
+	switch target := r.GetTarget().(type) {
+	case *pb.RevokeSessionsRequest_SessionKey:
+		if target.SessionKey == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty session key")
+		}
+		revoked, err = s.sessionStore.RevokeByKey(ctx, target.SessionKey)
+	case *pb.RevokeSessionsRequest_DeviceID:
+		revoked, err = s.sessionStore.RevokeByDeviceID(ctx, target.DeviceID)
+	case *pb.RevokeSessionsRequest_ObjectID:
+		if target.ObjectID == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty object id")
+		}
+		revoked, err = s.sessionStore.RevokeByObjectID(ctx, target.ObjectID)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "no session key, device or user specified")
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "authenticate: %v", err)
+		return nil, status.Errorf(codes.Internal, "revoke sessions: %v", err)
+	}
+
+	for _, session := range revoked {
+		s.log.WithField("deviceId", session.GetDevice().GetId()).WithField("user", session.GetDevice().GetUsername()).Info("session revoked")
+		s.audit(ctx, admin.Name, database.AuditActionSessionRevoke, deviceTarget(session.GetDevice().GetId()), "session owned by "+session.GetDevice().GetUsername())
+		// closing the trigger ends the configuration stream with an invalid session status
+		s.devices.Remove(session.GetDevice().GetId())
+	}
+
+	if len(revoked) > 0 {
+		s.SendAllGatewayConfigurations()
 	}
 
+	return &pb.RevokeSessionsResponse{
+		Sessions: revoked,
+	}, nil
+}
+
+const defaultAuditEventPageSize = 100
+
+func (s *grpcServer) ListAuditEvents(ctx context.Context, r *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	pageSize := int(r.GetPageSize())
+	if pageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative page size")
+	} else if pageSize == 0 {
+		pageSize = defaultAuditEventPageSize
+	}
+
+	filter := database.AuditEventFilter{
+		Actor:    r.GetActor(),
+		Action:   r.GetAction(),
+		Target:   r.GetTarget(),
+		BeforeID: r.GetPageToken(),
+		PageSize: pageSize,
+	}
+	if r.GetSince() != nil {
+		filter.Since = r.GetSince().AsTime()
+	}
+	if r.GetUntil() != nil {
+		filter.Until = r.GetUntil().AsTime()
+	}
+
+	events, err := s.db.ReadAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read audit events: %v", err)
+	}
+
+	resp := &pb.ListAuditEventsResponse{
+		Events: events,
+	}
+	// a full page means there may be more events
+	if len(events) == pageSize {
+		resp.NextPageToken = events[len(events)-1].GetId()
+	}
+
+	return resp, nil
+}
+
+func (s *grpcServer) ListGatewayJitaGrants(ctx context.Context, r *pb.ListGatewayJitaGrantsRequest) (*pb.ListGatewayJitaGrantsResponse, error) {
+	filter := database.GatewayJitaGrantFilter{
+		Gateway:    r.GetGateway(),
+		UserID:     r.GetUserID(),
+		ActiveOnly: r.GetActiveOnly(),
+	}
+	if r.GetSince() != nil {
+		filter.Since = r.GetSince().AsTime()
+	}
+	if r.GetUntil() != nil {
+		filter.Until = r.GetUntil().AsTime()
+	}
+
+	grants, err := s.db.ReadGatewayJitaGrants(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read gateway jita grants: %v", err)
+	}
+
+	s.resolveGrantUsernames(grants)
+
+	return &pb.ListGatewayJitaGrantsResponse{
+		GatewayJitaGrants: grants,
+	}, nil
+}
+
+func (s *grpcServer) RevokeGatewayJitaGrant(ctx context.Context, r *pb.RevokeGatewayJitaGrantRequest) (*pb.RevokeGatewayJitaGrantResponse, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	grant, err := s.db.RevokeGatewayJitaGrant(ctx, r.GetId())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "grant %d not found", r.GetId())
+	} else if errors.Is(err, database.ErrGrantNotActive) {
+		return nil, status.Errorf(codes.FailedPrecondition, "grant %d is already revoked, denied or expired", r.GetId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke gateway jita grant: %v", err)
+	}
+
+	s.log.WithField("grantId", grant.GetId()).WithField("gateway", grant.GetGateway()).WithField("userId", grant.GetUserID()).Info("gateway jita grant revoked")
+	s.audit(ctx, admin.Name, database.AuditActionJitaRevoke, jitaGrantTarget(grant.GetId()), fmt.Sprintf("gateway %s for user %s: %s", grant.GetGateway(), grant.GetUserID(), r.GetReason()))
+
+	s.gateways.Trigger(grant.GetGateway())
+	s.rescheduleJitaExpiry()
+
+	return &pb.RevokeGatewayJitaGrantResponse{
+		GatewayJitaGrant: grant,
+	}, nil
+}
+
+func (s *grpcServer) CreateIssueExemption(ctx context.Context, r *pb.CreateIssueExemptionRequest) (*pb.IssueExemption, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	if r.GetDeviceID() == 0 && r.GetUser() == "" && r.GetCheckID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, database.ErrExemptionWithoutScope.Error())
+	}
+	if r.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "an exemption needs a reason")
+	}
+	if !r.GetExpires().AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "an exemption needs an expiry in the future")
+	}
+
+	exemption, err := s.db.CreateIssueExemption(ctx, &pb.IssueExemption{
+		DeviceID:  r.GetDeviceID(),
+		Username:  r.GetUser(),
+		CheckID:   r.GetCheckID(),
+		Reason:    r.GetReason(),
+		CreatedBy: admin.Name,
+		Expires:   r.GetExpires(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create issue exemption: %v", err)
+	}
+
+	s.log.WithField("exemptionId", exemption.GetId()).WithField("deviceId", exemption.GetDeviceID()).WithField("user", exemption.GetUsername()).WithField("checkId", exemption.GetCheckID()).Info("issue exemption created")
+	s.audit(ctx, admin.Name, database.AuditActionExemptionCreate, exemptionTarget(exemption.GetId()), r.GetReason())
+	s.refreshExemptedDevices(ctx, exemption)
+
+	return exemption, nil
+}
+
+func (s *grpcServer) ListIssueExemptions(ctx context.Context, r *pb.ListIssueExemptionsRequest) (*pb.ListIssueExemptionsResponse, error) {
+	exemptions, err := s.db.ReadIssueExemptions(ctx, r.GetActiveOnly())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read issue exemptions: %v", err)
+	}
+
+	return &pb.ListIssueExemptionsResponse{
+		Exemptions: exemptions,
+	}, nil
+}
+
+func (s *grpcServer) RevokeIssueExemption(ctx context.Context, r *pb.RevokeIssueExemptionRequest) (*pb.IssueExemption, error) {
+	admin := auth.AdminFromContext(ctx)
+
+	exemption, err := s.db.RevokeIssueExemption(ctx, r.GetId())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "exemption %d not found", r.GetId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke issue exemption: %v", err)
+	}
+
+	s.log.WithField("exemptionId", exemption.GetId()).Info("issue exemption revoked")
+	s.audit(ctx, admin.Name, database.AuditActionExemptionRevoke, exemptionTarget(exemption.GetId()), r.GetReason())
+	s.refreshExemptedDevices(ctx, exemption)
+
+	return exemption, nil
+}
+
+// refreshExemptedDevices re-reads the devices covered by an exemption into the session cache, where gateways judge
+// device health, and sends new configurations. Exemptions for a user or a check refresh all devices.
+func (s *grpcServer) refreshExemptedDevices(ctx context.Context, exemption *pb.IssueExemption) {
+	if exemption.GetDeviceID() == 0 {
+		if err := s.RefreshDevicePosture(ctx); err != nil {
+			s.log.WithError(err).WithField("exemptionId", exemption.GetId()).Error("refresh exempted devices")
+		}
+		return
+	}
+
+	device, err := s.db.ReadDeviceByID(ctx, exemption.GetDeviceID())
+	if err != nil {
+		s.log.WithError(err).WithField("exemptionId", exemption.GetId()).Error("refresh exempted device")
+	} else {
+		s.sessionStore.RefreshDevice(device)
+	}
+
+	s.SendAllDeviceConfigurations()
+	s.SendAllGatewayConfigurations()
+}
+
+func (s *grpcServer) GetPosturePolicy(ctx context.Context, r *pb.GetPosturePolicyRequest) (*pb.GetPosturePolicyResponse, error) {
+	policy := s.posturePolicy.Policy()
+	resp := &pb.GetPosturePolicyResponse{
+		Source:          s.posturePolicy.Path(),
+		DefaultSeverity: policy.DefaultSeverity,
+	}
+
+	for _, severity := range slices.Sorted(maps.Keys(policy.GracePeriods)) {
+		resp.GracePeriods = append(resp.GracePeriods, &pb.PostureGracePeriod{
+			Severity:    severity,
+			GracePeriod: durationpb.New(policy.GracePeriods[severity]),
+		})
+	}
+
+	for _, tag := range slices.Sorted(maps.Keys(policy.Tags)) {
+		resp.Tags = append(resp.Tags, &pb.PostureTagSeverity{
+			Tag:      tag,
+			Severity: policy.Tags[tag],
+		})
+	}
+
+	for _, checkID := range slices.Sorted(maps.Keys(policy.Checks)) {
+		override := policy.Checks[checkID]
+		check := &pb.PostureCheckOverride{
+			CheckID:  checkID,
+			Severity: override.Severity,
+		}
+		if override.GracePeriod != nil {
+			check.GracePeriod = durationpb.New(*override.GracePeriod)
+		}
+		resp.Checks = append(resp.Checks, check)
+	}
+
+	if r.GetCheckID() == 0 && len(r.GetTags()) == 0 {
+		return resp, nil
+	}
+
+	tags := r.GetTags()
+	if len(tags) == 0 {
+		checks, err := s.db.ReadKolideChecks(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "read kolide checks: %v", err)
+		}
+
+		check, ok := checks[r.GetCheckID()]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "kolide check %d not found", r.GetCheckID())
+		}
+		tags = strings.Split(check.Tags, ",")
+	}
+
+	rating := policy.RateCheck(r.GetCheckID(), tags)
+	resp.Rating = &pb.PostureRating{
+		CheckID:     r.GetCheckID(),
+		Tags:        tags,
+		Severity:    rating.Severity,
+		GracePeriod: durationpb.New(rating.GracePeriod),
+		Reason:      rating.Reason,
+	}
+
+	return resp, nil
+}
+
+func (s *grpcServer) GetKolideCache(ctx context.Context, r *pb.GetKolideCacheRequest) (*pb.GetKolideCacheResponse, error) {
 	if s.kolideClient == nil {
 		return nil, fmt.Errorf("kolide client not configured")
 	}
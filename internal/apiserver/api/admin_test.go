@@ -0,0 +1,639 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/api"
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/posture"
+	"github.com/nais/device/internal/apiserver/sqlc"
+	"github.com/nais/device/internal/passwordhash"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDeleteDeviceInvalidatesSession(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	device := &pb.Device{
+		Id:       1,
+		Serial:   "serial",
+		Platform: "darwin",
+		Username: "user@example.com",
+	}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadSessionInfo(mock.Anything, "session-key").Return(&pb.Session{
+		Key:    "session-key",
+		Groups: []string{"group"},
+		Expiry: timestamppb.New(time.Now().Add(time.Hour)),
+		Device: device,
+	}, nil).Once()
+	db.EXPECT().ReadDeviceByID(mock.Anything, device.Id).Return(device, nil).Maybe()
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{}, nil).Maybe()
+	db.EXPECT().DeleteDevice(mock.Anything, device.Id).Return(nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionDeviceDelete, "device:1", "").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, auth.NewSessionStore(db), nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetDeviceConfiguration(ctx, &pb.GetDeviceConfigurationRequest{SessionKey: "session-key"})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, pb.DeviceConfigurationStatus_DeviceHealthy, resp.GetStatus())
+
+	_, err = client.DeleteDevice(ctx, &pb.DeleteDeviceRequest{DeviceID: device.Id})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, pb.DeviceConfigurationStatus_InvalidSession, resp.GetStatus())
+
+	select {
+	case <-server.PeersChanged():
+	default:
+		t.Error("expected peers changed notification")
+	}
+}
+
+func TestReassignDeviceNotifiesPeers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	device := &pb.Device{Id: 1, Username: "bob@example.com"}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReassignDevice(mock.Anything, device.Id, "bob@example.com").Return(nil).Once()
+	db.EXPECT().ReadDeviceByID(mock.Anything, device.Id).Return(device, nil)
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{}, nil).Maybe()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionDeviceReassign, "device:1", "reassigned to bob@example.com").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, auth.NewSessionStore(db), nil, false)
+	client := serveAPIServer(t, server)
+
+	resp, err := client.ReassignDevice(ctx, &pb.ReassignDeviceRequest{DeviceID: device.Id, NewDeviceUsername: "bob@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob@example.com", resp.GetDevice().GetUsername())
+
+	select {
+	case <-server.PeersChanged():
+	default:
+		t.Error("expected peers changed notification")
+	}
+}
+
+func TestListDevices(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadDevices(mock.Anything).Return([]*pb.Device{
+		{Id: 1, Username: "alice@example.com", Platform: "darwin"},
+		{Id: 2, Username: "alice@example.com", Platform: "linux"},
+		{Id: 3, Username: "bob@example.com", Platform: "linux"},
+	}, nil)
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, nil, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	resp, err := client.ListDevices(ctx, &pb.ListDevicesRequest{
+		DeviceUsername: "alice@example.com",
+		Platform:       "linux",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+	assert.Equal(t, int64(2), resp.GetDevices()[0].GetId())
+}
+
+func TestRevokeSessionsEndsDeviceStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	device := &pb.Device{Id: 1, Username: "user@example.com"}
+	session := &pb.Session{
+		Key:      "session-key",
+		ObjectID: "user",
+		Groups:   []string{"group"},
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   device,
+	}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadDeviceByID(mock.Anything, device.Id).Return(device, nil).Maybe()
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{}, nil).Maybe()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionSessionRevoke, "device:1", mock.Anything).Return(nil).Once()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().Get(mock.Anything, session.Key).Return(session, nil)
+	sessionStore.EXPECT().RevokeByObjectID(mock.Anything, "user").Return([]*pb.Session{session}, nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetDeviceConfiguration(ctx, &pb.GetDeviceConfigurationRequest{SessionKey: session.Key})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, pb.DeviceConfigurationStatus_DeviceHealthy, resp.GetStatus())
+
+	revoked, err := client.RevokeSessions(ctx, &pb.RevokeSessionsRequest{
+		Target: &pb.RevokeSessionsRequest_ObjectID{ObjectID: "user"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, revoked.GetSessions(), 1)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, pb.DeviceConfigurationStatus_InvalidSession, resp.GetStatus())
+
+	_, err = client.RevokeSessions(ctx, &pb.RevokeSessionsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListGatewayJitaGrants(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadGatewayJitaGrants(mock.Anything, database.GatewayJitaGrantFilter{Gateway: "privileged", ActiveOnly: true}).Return([]*pb.GatewayJitaGrant{
+		{Id: 2, Gateway: "privileged", UserID: "alice"},
+		{Id: 1, Gateway: "privileged", UserID: "bob"},
+	}, nil)
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().Return([]*pb.Session{
+		{ObjectID: "alice", Device: &pb.Device{Username: "alice@example.com"}},
+	})
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	resp, err := client.ListGatewayJitaGrants(ctx, &pb.ListGatewayJitaGrantsRequest{
+		Gateway:    "privileged",
+		ActiveOnly: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetGatewayJitaGrants(), 2)
+	assert.Equal(t, "alice@example.com", resp.GetGatewayJitaGrants()[0].GetUsername())
+	assert.Empty(t, resp.GetGatewayJitaGrants()[1].GetUsername())
+}
+
+func TestRevokeGatewayJitaGrant(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().RevokeGatewayJitaGrant(mock.Anything, int64(1)).Return(&pb.GatewayJitaGrant{
+		Id:      1,
+		Gateway: "privileged",
+		UserID:  "alice",
+		Expires: timestamppb.New(time.Now().Add(time.Hour)),
+		Revoked: timestamppb.Now(),
+	}, nil).Once()
+	db.EXPECT().RevokeGatewayJitaGrant(mock.Anything, int64(1)).Return(nil, database.ErrGrantNotActive).Once()
+	db.EXPECT().ReadGatewayJitaGrants(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionJitaRevoke, "jita-grant:1", "gateway privileged for user alice: incident over").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, nil, nil, false)
+	client := serveAPIServer(t, server)
+
+	resp, err := client.RevokeGatewayJitaGrant(ctx, &pb.RevokeGatewayJitaGrantRequest{Id: 1, Reason: "incident over"})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp.GetGatewayJitaGrant().GetRevoked())
+
+	// revoking again is refused, and not audited
+	_, err = client.RevokeGatewayJitaGrant(ctx, &pb.RevokeGatewayJitaGrantRequest{Id: 1, Reason: "incident over"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGetPendingPrivilegedGatewayAccessRequests(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	approver := &pb.Session{Key: "approver", ObjectID: "carol", Groups: []string{"approvers"}, Expiry: timestamppb.New(time.Now().Add(time.Hour)), Device: &pb.Device{Username: "carol@example.com"}}
+	requester := &pb.Session{Key: "requester", ObjectID: "alice", Groups: []string{"group"}, Expiry: timestamppb.New(time.Now().Add(time.Hour)), Device: &pb.Device{Username: "alice@example.com"}}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadPendingGatewayJitaGrants(mock.Anything).Return([]*pb.GatewayJitaGrant{
+		{Id: 1, Gateway: "privileged", UserID: "alice", Approval: pb.JitaApproval_JitaApprovalPending},
+		{Id: 2, Gateway: "privileged", UserID: "carol", Approval: pb.JitaApproval_JitaApprovalPending},
+	}, nil).Once()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().Get(mock.Anything, approver.Key).Return(approver, nil)
+	sessionStore.EXPECT().Get(mock.Anything, requester.Key).Return(requester, nil)
+	sessionStore.EXPECT().All().Return([]*pb.Session{approver, requester})
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, sessionStore, nil, false, api.WithJITAApproverGroups([]string{"approvers"}))
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	_, err = client.GetPendingPrivilegedGatewayAccessRequests(ctx, &pb.GetPendingPrivilegedGatewayAccessRequestsRequest{SessionKey: requester.Key})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// approvers do not see their own requests
+	resp, err := client.GetPendingPrivilegedGatewayAccessRequests(ctx, &pb.GetPendingPrivilegedGatewayAccessRequestsRequest{SessionKey: approver.Key})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetGatewayJitaGrants(), 1)
+	assert.Equal(t, int64(1), resp.GetGatewayJitaGrants()[0].GetId())
+	assert.Equal(t, "alice@example.com", resp.GetGatewayJitaGrants()[0].GetUsername())
+}
+
+func TestSnapshotDatabase(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// larger than a single chunk
+	contents := bytes.Repeat([]byte("snapshot"), 100_000)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().Snapshot(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, path string) error {
+		return os.WriteFile(path, contents, 0o600)
+	}).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionDatabaseSnapshot, "database", "").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, nil, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.SnapshotDatabase(ctx, &pb.SnapshotDatabaseRequest{})
+	assert.NoError(t, err)
+
+	var received []byte
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+		received = append(received, resp.GetData()...)
+	}
+
+	assert.Equal(t, contents, received)
+}
+
+func TestGetWireGuardPrefixes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	prefixes := []netip.Prefix{netip.MustParsePrefix("10.255.240.0/21"), netip.MustParsePrefix("fd75:568f:0f19::/48")}
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, nil, nil, auth.NewMockAdminAuthenticator(), nil, nil, nil, nil, false, api.WithWireGuardPrefixes(prefixes))
+
+	resp, err := server.GetWireGuardPrefixes(ctx, &pb.GetWireGuardPrefixesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.255.240.0/21", "fd75:568f:f19::/48"}, resp.GetPrefixes())
+}
+
+func TestGetPosturePolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("tags:\n  must-fix: critical\nchecks:\n  \"7\":\n    grace_period: 2h\n"), 0o600))
+	policy := posture.NewPolicyFile(path, logrus.New())
+	_, err := policy.Sync(ctx)
+	assert.NoError(t, err)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadKolideChecks(mock.Anything).Return(map[int64]*sqlc.KolideCheck{
+		7: {ID: 7, Tags: "warning,must-fix"},
+	}, nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, nil, nil, false, api.WithPosturePolicy(policy))
+
+	resp, err := server.GetPosturePolicy(ctx, &pb.GetPosturePolicyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, path, resp.GetSource())
+	assert.Equal(t, pb.Severity_Warning, resp.GetDefaultSeverity())
+	assert.Contains(t, resp.GetTags(), &pb.PostureTagSeverity{Tag: "must-fix", Severity: pb.Severity_Critical})
+	assert.Len(t, resp.GetChecks(), 1)
+	assert.Nil(t, resp.GetRating())
+
+	resp, err = server.GetPosturePolicy(ctx, &pb.GetPosturePolicyRequest{CheckID: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"warning", "must-fix"}, resp.GetRating().GetTags())
+	assert.Equal(t, pb.Severity_Critical, resp.GetRating().GetSeverity())
+	assert.Equal(t, 2*time.Hour, resp.GetRating().GetGracePeriod().AsDuration())
+
+	resp, err = server.GetPosturePolicy(ctx, &pb.GetPosturePolicyRequest{Tags: []string{"notice"}})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.Severity_Notice, resp.GetRating().GetSeverity())
+}
+
+func TestCreateIssueExemption(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	expires := timestamppb.New(time.Now().Add(time.Hour))
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().CreateIssueExemption(mock.Anything, &pb.IssueExemption{
+		Username:  "user@example.com",
+		CheckID:   7,
+		Reason:    "replacement laptop ordered",
+		CreatedBy: "admin",
+		Expires:   expires,
+	}).Return(&pb.IssueExemption{Id: 1}, nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, "admin", database.AuditActionExemptionCreate, "exemption:1", "replacement laptop ordered").Return(nil).Once()
+
+	// the exemption is for a user, so all devices are refreshed
+	device := &pb.Device{Id: 1, Username: "user@example.com"}
+	db.EXPECT().ReadDevices(mock.Anything).Return([]*pb.Device{device}, nil).Once()
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().RefreshDevice(device).Return().Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, nil, sessionStore, nil, false)
+	ctx = auth.WithAdmin(ctx, &auth.Admin{Name: "admin", Role: auth.RoleOperator})
+
+	for _, r := range []*pb.CreateIssueExemptionRequest{
+		{Username: "admin", Reason: "no scope", Expires: expires},
+		{Username: "admin", User: "user@example.com", Expires: expires},
+		{Username: "admin", User: "user@example.com", Reason: "expired", Expires: timestamppb.New(time.Now().Add(-time.Hour))},
+	} {
+		_, err := server.CreateIssueExemption(ctx, r)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), r.GetReason())
+	}
+
+	exemption, err := server.CreateIssueExemption(ctx, &pb.CreateIssueExemptionRequest{
+		Username: "admin",
+		User:     "user@example.com",
+		CheckID:  7,
+		Reason:   "replacement laptop ordered",
+		Expires:  expires,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), exemption.GetId())
+}
+
+func TestIssueExemptionUpdatesGatewayConfiguration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+	exemption := &pb.IssueExemption{
+		Id:       1,
+		DeviceID: 1,
+		Reason:   "replacement laptop ordered",
+		Expires:  timestamppb.New(time.Now().Add(time.Hour)),
+	}
+	storedDevice := func(exemption *pb.IssueExemption) *pb.Device {
+		return &pb.Device{
+			Id:        1,
+			PublicKey: "devicePublicKey",
+			Issues: []*pb.DeviceIssue{
+				{Title: "disk not encrypted", ResolveBefore: timestamppb.New(time.Now().Add(-time.Hour)), Exemption: exemption},
+			},
+		}
+	}
+	session := &pb.Session{
+		Device:   storedDevice(nil),
+		ObjectID: "sessionUserId",
+		Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Groups:   []string{"groupId"},
+	}
+	var lock sync.Mutex
+
+	revoked := proto.Clone(exemption).(*pb.IssueExemption)
+	revoked.Revoked = timestamppb.Now()
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+	db.EXPECT().AddAuditEvent(mock.Anything, "admin", mock.Anything, "exemption:1", mock.Anything).Return(nil).Times(2)
+	db.EXPECT().CreateIssueExemption(mock.Anything, mock.Anything).Return(exemption, nil).Once()
+	db.EXPECT().RevokeIssueExemption(mock.Anything, int64(1)).Return(revoked, nil).Once()
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(1)).Return(storedDevice(exemption), nil).Once()
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(1)).Return(storedDevice(revoked), nil).Once()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().RunAndReturn(func() []*pb.Session {
+		lock.Lock()
+		defer lock.Unlock()
+		return []*pb.Session{proto.Clone(session).(*pb.Session)}
+	}).Maybe()
+	sessionStore.EXPECT().RefreshDevice(mock.Anything).Run(func(device *pb.Device) {
+		lock.Lock()
+		defer lock.Unlock()
+		session.Device = device
+	}).Return().Times(2)
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+	adminCtx := auth.WithAdmin(ctx, &auth.Admin{Name: "admin", Role: auth.RoleOperator})
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	// the issue is past its deadline
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+
+	_, err = server.CreateIssueExemption(adminCtx, &pb.CreateIssueExemptionRequest{
+		DeviceID: 1,
+		Reason:   exemption.GetReason(),
+		Expires:  exemption.GetExpires(),
+	})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+
+	_, err = server.RevokeIssueExemption(adminCtx, &pb.RevokeIssueExemptionRequest{Id: 1, Reason: "laptop replaced"})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+}
+
+func TestAdminRoles(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	salt := []byte("salt")
+	hash := string(passwordhash.FormatHash(passwordhash.HashPassword([]byte("secret"), salt), salt))
+	keys, err := auth.ParseAdminKeys([]string{"viewer:" + hash + ":viewer", "admin:" + hash})
+	assert.NoError(t, err)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadDevices(mock.Anything).Return(nil, nil).Once()
+	db.EXPECT().DeleteDevice(mock.Anything, int64(1)).Return(nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, "admin", database.AuditActionDeviceDelete, "device:1", "").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewAdminAuthenticator(keys, nil, nil), nil, nil, auth.NewSessionStore(db), nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	_, err = client.ListDevices(ctx, &pb.ListDevicesRequest{Username: "viewer", Password: "secret"})
+	assert.NoError(t, err)
+
+	_, err = client.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Username: "viewer", Password: "secret", DeviceID: 1})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Username: "viewer", Password: "wrong", DeviceID: 1})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Username: "admin", Password: "secret", DeviceID: 1})
+	assert.NoError(t, err)
+}
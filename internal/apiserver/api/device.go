@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/internal/apiserver/kolide"
 	"github.com/nais/device/internal/apiserver/metrics"
 	"github.com/nais/device/pkg/pb"
@@ -19,14 +21,20 @@ import (
 )
 
 func (s *grpcServer) GetDeviceConfiguration(request *pb.GetDeviceConfigurationRequest, stream pb.APIServer_GetDeviceConfigurationServer) error {
-	session, err := s.sessionStore.Get(stream.Context(), request.SessionKey)
-	if err != nil {
-		return err
-	}
+	session := auth.SessionFromContext(stream.Context())
 
 	log := s.log.WithField("deviceId", session.GetDevice().GetId())
 	log.Debug("incoming connection")
 
+	// agents reuse their session across restarts, so the version reported at login may be stale
+	if request.GetVersion() != "" {
+		if err := s.db.UpdateDeviceAgentVersion(stream.Context(), session.GetDevice().GetId(), request.GetVersion()); err != nil {
+			log.WithError(err).Error("update device agent version")
+		}
+	}
+
+	handover := s.handoverSignal()
+
 	trigger, err := s.devices.Add(session.GetDevice().GetId())
 	if err != nil {
 		// indicate that the client should retry
@@ -42,12 +50,14 @@ func (s *grpcServer) GetDeviceConfiguration(request *pb.GetDeviceConfigurationRe
 	}
 
 	timeout := time.After(time.Until(session.GetExpiry().AsTime()))
+	// wake up to tell the device to renew its session, the stream ends when the device reconnects with the renewed session
+	renew := time.After(time.Until(session.GetExpiry().AsTime().Add(-auth.SessionRenewalWindow)))
 	updateDeviceTicker := time.NewTicker(1 * time.Minute)
 	defer updateDeviceTicker.Stop()
 
 	var lastCfg *pb.GetDeviceConfigurationResponse
 	for {
-		if cfg, err := s.makeDeviceConfiguration(stream.Context(), request.SessionKey); err != nil {
+		if cfg, err := s.makeDeviceConfiguration(stream.Context(), session.GetKey()); err != nil {
 			log.WithError(err).Error("make device config")
 		} else if equalDeviceConfigurations(lastCfg, cfg) {
 			// no change, don't send
@@ -61,8 +71,21 @@ func (s *grpcServer) GetDeviceConfiguration(request *pb.GetDeviceConfigurationRe
 
 		// block until trigger or done
 		select {
-		case <-trigger:
+		case _, ok := <-trigger:
+			if !ok {
+				metrics.IncDeviceStreamsEnded("closed")
+				log.Info("device stream closed by apiserver, invalidating session")
+				_ = stream.Send(&pb.GetDeviceConfigurationResponse{
+					Status: pb.DeviceConfigurationStatus_InvalidSession,
+				})
+				return nil
+			}
+		case <-handover:
+			metrics.IncDeviceStreamsEnded("handover")
+			log.Debug("apiserver handing over, ending stream")
+			return errHandover()
 		case <-updateDeviceTicker.C:
+		case <-renew:
 		case <-stream.Context().Done():
 			metrics.IncDeviceStreamsEnded("context_done")
 			log.Debug("stream context done, tearing down")
@@ -84,7 +107,7 @@ func equalDeviceConfigurations(a, b *pb.GetDeviceConfigurationResponse) bool {
 		return false
 	}
 
-	if a.Status != b.Status {
+	if a.Status != b.Status || a.NewVersionAvailable != b.NewVersionAvailable || a.RenewSession != b.RenewSession {
 		return false
 	}
 
@@ -132,6 +155,22 @@ func (s *grpcServer) makeDeviceConfiguration(ctx context.Context, sessionKey str
 		return nil, err
 	}
 
+	if changed, err := s.updateAgentVersionOutdatedSince(ctx, device); err != nil {
+		return nil, err
+	} else if changed {
+		// the outdated agent issue comes from the stored device, which gateways also see through the session
+		device, err = s.db.ReadDeviceByID(ctx, device.GetId())
+		if err != nil {
+			return nil, err
+		}
+		s.sessionStore.RefreshDevice(device)
+		s.SendAllGatewayConfigurations()
+	}
+
+	newVersionAvailable := s.agentVersions.NewVersionAvailable(device.GetAgentVersion(), device.GetPlatform())
+	_, canRenew := s.authenticator.(auth.SessionRenewer)
+	renewSession := canRenew && time.Until(session.GetExpiry().AsTime()) < auth.SessionRenewalWindow
+
 	var sessionIssues []*pb.DeviceIssue
 	if s.kolideEnabled {
 		if acceptedAt, err := s.db.GetAcceptedAt(ctx, session.ObjectID); err != nil {
@@ -151,8 +190,10 @@ func (s *grpcServer) makeDeviceConfiguration(ctx context.Context, sessionKey str
 
 	if !device.Healthy() || len(sessionIssues) > 0 {
 		return &pb.GetDeviceConfigurationResponse{
-			Status: pb.DeviceConfigurationStatus_DeviceUnhealthy,
-			Issues: append(device.Issues, sessionIssues...),
+			Status:              pb.DeviceConfigurationStatus_DeviceUnhealthy,
+			Issues:              append(device.Issues, sessionIssues...),
+			NewVersionAvailable: newVersionAvailable,
+			RenewSession:        renewSession,
 		}, nil
 	}
 
@@ -179,16 +220,43 @@ func (s *grpcServer) makeDeviceConfiguration(ctx context.Context, sessionKey str
 	metrics.DeviceConfigsReturned.WithLabelValues(device.Serial, device.Username).Inc()
 
 	return &pb.GetDeviceConfigurationResponse{
-		Status:   pb.DeviceConfigurationStatus_DeviceHealthy,
-		Issues:   device.Issues,
-		Gateways: gateways,
+		Status:              pb.DeviceConfigurationStatus_DeviceHealthy,
+		Issues:              device.Issues,
+		Gateways:            gateways,
+		NewVersionAvailable: newVersionAvailable,
+		RenewSession:        renewSession,
 	}, nil
 }
 
+// updateAgentVersionOutdatedSince records when the device was first seen running an agent version below the minimum,
+// which starts the grace period of the outdated agent issue. It reports whether the record changed.
+func (s *grpcServer) updateAgentVersionOutdatedSince(ctx context.Context, device *pb.Device) (bool, error) {
+	outdated := s.agentVersions.Outdated(device.GetAgentVersion(), device.GetPlatform())
+	switch {
+	case outdated && device.GetAgentVersionOutdatedSince() == nil:
+		if err := s.db.UpdateDeviceAgentVersionOutdatedSince(ctx, device.GetId(), time.Now()); err != nil {
+			return false, fmt.Errorf("set agent version outdated since: %w", err)
+		}
+		return true, nil
+	case !outdated && device.GetAgentVersionOutdatedSince() != nil:
+		// the grace period starts over if the device falls behind again later
+		if err := s.db.UpdateDeviceAgentVersionOutdatedSince(ctx, device.GetId(), time.Time{}); err != nil {
+			return false, fmt.Errorf("clear agent version outdated since: %w", err)
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
 func (s *grpcServer) SendDeviceConfiguration(device *pb.Device) {
 	s.devices.Trigger(device.GetId())
 }
 
+func (s *grpcServer) SendAllDeviceConfigurations() {
+	s.devices.TriggerAll()
+}
+
 func (s *grpcServer) Login(ctx context.Context, r *pb.APIServerLoginRequest) (*pb.APIServerLoginResponse, error) {
 	version := r.Version
 	if version == "" {
@@ -201,6 +269,14 @@ func (s *grpcServer) Login(ctx context.Context, r *pb.APIServerLoginRequest) (*p
 		return nil, status.Errorf(codes.Unauthenticated, "login: %v", err)
 	}
 
+	s.audit(ctx, session.GetDevice().GetUsername(), database.AuditActionDeviceLogin, deviceTarget(session.GetDevice().GetId()), "")
+
+	if r.Version != "" {
+		if err := s.db.UpdateDeviceAgentVersion(ctx, session.GetDevice().GetId(), r.Version); err != nil {
+			s.log.WithError(err).WithField("deviceId", session.GetDevice().GetId()).Error("update device agent version")
+		}
+	}
+
 	s.SendAllGatewayConfigurations()
 
 	return &pb.APIServerLoginResponse{
@@ -208,6 +284,31 @@ func (s *grpcServer) Login(ctx context.Context, r *pb.APIServerLoginRequest) (*p
 	}, nil
 }
 
+// RenewSession replaces the session of the request with a new one, given a fresh token for the same user.
+// The device keeps its gateway peers across the renewal, and reconnects its configuration stream with the new session key.
+func (s *grpcServer) RenewSession(ctx context.Context, r *pb.RenewSessionRequest) (*pb.RenewSessionResponse, error) {
+	session := auth.SessionFromContext(ctx)
+
+	renewer, ok := s.authenticator.(auth.SessionRenewer)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "sessions can not be renewed for this tenant")
+	}
+
+	renewed, err := renewer.Renew(ctx, session, r.GetToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "renew session: %v", err)
+	}
+
+	s.audit(ctx, renewed.GetDevice().GetUsername(), database.AuditActionDeviceRenew, deviceTarget(renewed.GetDevice().GetId()), "")
+
+	// group memberships may have changed with the new token
+	s.SendAllGatewayConfigurations()
+
+	return &pb.RenewSessionResponse{
+		Session: renewed,
+	}, nil
+}
+
 func (s *grpcServer) UpdateAllDevices(ctx context.Context) error {
 	devices, err := s.db.ReadDevices(ctx)
 	if err != nil {
@@ -289,16 +390,38 @@ func (s *grpcServer) UpdateAllDevices(ctx context.Context) error {
 	return err
 }
 
-func (s *grpcServer) GetAcceptableUseAcceptedAt(ctx context.Context, req *pb.GetAcceptableUseAcceptedAtRequest) (*pb.GetAcceptableUseAcceptedAtResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
+// RefreshDevicePosture re-reads all devices after their issues have changed outside of the Kolide sync, such as a new
+// posture feed or policy, so that gateways judge device health on the same issues as the devices themselves.
+func (s *grpcServer) RefreshDevicePosture(ctx context.Context) error {
+	devices, err := s.db.ReadDevices(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
+		return fmt.Errorf("reading devices: %w", err)
 	}
 
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
+	for _, device := range devices {
+		s.sessionStore.RefreshDevice(device)
 	}
 
+	s.SendAllDeviceConfigurations()
+	s.SendAllGatewayConfigurations()
+
+	return nil
+}
+
+// SyncPosturePolicy reloads the posture policy. Severities and grace periods are part of the stored issues,
+// so the devices are refreshed if the policy changed.
+func (s *grpcServer) SyncPosturePolicy(ctx context.Context) error {
+	changed, err := s.posturePolicy.Sync(ctx)
+	if changed {
+		return errors.Join(err, s.RefreshDevicePosture(ctx))
+	}
+
+	return err
+}
+
+func (s *grpcServer) GetAcceptableUseAcceptedAt(ctx context.Context, req *pb.GetAcceptableUseAcceptedAtRequest) (*pb.GetAcceptableUseAcceptedAtResponse, error) {
+	session := auth.SessionFromContext(ctx)
+
 	if acceptedAt, err := s.db.GetAcceptedAt(ctx, session.GetObjectID()); err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to get acceptance: %v", err)
 	} else {
@@ -307,14 +430,7 @@ func (s *grpcServer) GetAcceptableUseAcceptedAt(ctx context.Context, req *pb.Get
 }
 
 func (s *grpcServer) SetAcceptableUseAccepted(ctx context.Context, req *pb.SetAcceptableUseAcceptedRequest) (*pb.SetAcceptableUseAcceptedResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
-	}
-
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
-	}
+	session := auth.SessionFromContext(ctx)
 
 	if req.Accepted {
 		return &pb.SetAcceptableUseAcceptedResponse{}, s.db.AcceptAcceptableUse(ctx, session.GetObjectID())
@@ -324,14 +440,7 @@ func (s *grpcServer) SetAcceptableUseAccepted(ctx context.Context, req *pb.SetAc
 }
 
 func (s *grpcServer) GetGatewayJitaGrantsForUser(ctx context.Context, req *pb.GetGatewayJitaGrantsForUserRequest) (*pb.GetGatewayJitaGrantsForUserResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
-	}
-
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
-	}
+	session := auth.SessionFromContext(ctx)
 
 	grants, err := s.db.GetGatewayJitaGrantsForUser(ctx, session.GetObjectID())
 	if err != nil {
@@ -344,14 +453,7 @@ func (s *grpcServer) GetGatewayJitaGrantsForUser(ctx context.Context, req *pb.Ge
 }
 
 func (s *grpcServer) UserHasAccessToPrivilegedGateway(ctx context.Context, req *pb.UserHasAccessToPrivilegedGatewayRequest) (*pb.UserHasAccessToPrivilegedGatewayResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
-	}
-
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
-	}
+	session := auth.SessionFromContext(ctx)
 
 	hasAccess, err := s.db.UserHasAccessToPrivilegedGateway(ctx, session.GetObjectID(), req.Gateway)
 	if err != nil {
@@ -364,49 +466,179 @@ func (s *grpcServer) UserHasAccessToPrivilegedGateway(ctx context.Context, req *
 }
 
 func (s *grpcServer) GrantPrivilegedGatewayAccess(ctx context.Context, req *pb.GrantPrivilegedGatewayAccessRequest) (*pb.GrantPrivilegedGatewayAccessResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
-	}
+	session := auth.SessionFromContext(ctx)
 
 	if err := s.authenticator.ValidateJita(session, req.Token); err != nil {
 		s.log.WithError(err).Error("validate token")
 		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
 
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
-	}
-
 	n := req.GetNewPrivilegedGatewayAccess()
 	if n == nil {
 		return nil, status.Error(codes.InvalidArgument, "no new privileged gateway access")
 	}
 
+	if !n.GetExpires().AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "expiry must be in the future")
+	}
+
+	gateway, err := s.db.ReadGateway(ctx, n.Gateway)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "gateway %q not found", n.Gateway)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "read gateway: %v", err)
+	}
+
+	policy := gateway.GetJitaPolicy()
+	if err := policy.CheckRequest(time.Until(n.GetExpires().AsTime()), n.GetReason()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "gateway %s: %v", n.Gateway, err)
+	}
+
+	if maxGrants := int(policy.GetMaxGrantsPerDay()); maxGrants > 0 {
+		grants, err := s.db.CountGatewayJitaGrantsForUserSince(ctx, session.GetObjectID(), n.Gateway, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "count gateway jita grants: %v", err)
+		}
+
+		if grants >= maxGrants {
+			return nil, status.Errorf(codes.InvalidArgument, "gateway %s: maximum of %d grants per day reached", n.Gateway, maxGrants)
+		}
+	}
+
+	if gateway.GetRequiresApproval() {
+		if len(s.jitaApproverGroups) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "gateway requires approval, but no approvers are configured")
+		}
+
+		if err := s.db.RequestPrivilegedGatewayAccess(ctx, session.GetObjectID(), n.Gateway, n.Expires.AsTime(), n.Reason); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to request privileged gateway access: %v", err)
+		}
+
+		s.audit(ctx, session.GetDevice().GetUsername(), database.AuditActionJitaRequest, gatewayTarget(n.Gateway), n.Reason)
+
+		return &pb.GrantPrivilegedGatewayAccessResponse{
+			PendingApproval: true,
+		}, nil
+	}
+
 	if err := s.db.GrantPrivilegedGatewayAccess(ctx, session.GetObjectID(), n.Gateway, n.Expires.AsTime(), n.Reason); err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to grant privileged gateway access: %v", err)
 	}
 
+	s.audit(ctx, session.GetDevice().GetUsername(), database.AuditActionJitaGrant, gatewayTarget(n.Gateway), n.Reason)
+
 	s.gateways.Trigger(req.NewPrivilegedGatewayAccess.Gateway)
+	s.rescheduleJitaExpiry()
 
 	return &pb.GrantPrivilegedGatewayAccessResponse{}, nil
 }
 
 func (s *grpcServer) RevokePrivilegedGatewayAccess(ctx context.Context, req *pb.RevokePrivilegedGatewayAccessRequest) (*pb.RevokePrivilegedGatewayAccessResponse, error) {
-	session, err := s.sessionStore.Get(ctx, req.GetSessionKey())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "unknown session")
-	}
-
-	if session.Expired() {
-		return nil, status.Error(codes.Unauthenticated, "session expired")
-	}
+	session := auth.SessionFromContext(ctx)
 
 	if err := s.db.RevokePrivilegedGatewayAccess(ctx, session.GetObjectID(), req.Gateway); err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to revoke privileged gateway access: %v", err)
 	}
 
+	s.audit(ctx, session.GetDevice().GetUsername(), database.AuditActionJitaRevoke, gatewayTarget(req.Gateway), "")
+
 	s.gateways.Trigger(req.Gateway)
+	s.rescheduleJitaExpiry()
 
 	return &pb.RevokePrivilegedGatewayAccessResponse{}, nil
 }
+
+func (s *grpcServer) GetPrivilegedGatewayAccessPolicy(ctx context.Context, req *pb.GetPrivilegedGatewayAccessPolicyRequest) (*pb.GetPrivilegedGatewayAccessPolicyResponse, error) {
+	gateway, err := s.db.ReadGateway(ctx, req.GetGateway())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "gateway %q not found", req.GetGateway())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "read gateway: %v", err)
+	}
+
+	return &pb.GetPrivilegedGatewayAccessPolicyResponse{
+		Policy: gateway.GetJitaPolicy(),
+	}, nil
+}
+
+func (s *grpcServer) GetPendingPrivilegedGatewayAccessRequests(ctx context.Context, req *pb.GetPendingPrivilegedGatewayAccessRequestsRequest) (*pb.GetPendingPrivilegedGatewayAccessRequestsResponse, error) {
+	session := auth.SessionFromContext(ctx)
+
+	if !s.isJITAApprover(session) {
+		return nil, status.Error(codes.PermissionDenied, "not a member of any approver group")
+	}
+
+	grants, err := s.db.ReadPendingGatewayJitaGrants(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to get pending gateway jita grants: %v", err)
+	}
+
+	// approvers can not approve their own requests
+	grants = filterList(grants, not(grantIsForUser(session.GetObjectID())))
+	s.resolveGrantUsernames(grants)
+
+	return &pb.GetPendingPrivilegedGatewayAccessRequestsResponse{
+		GatewayJitaGrants: grants,
+	}, nil
+}
+
+func (s *grpcServer) ReviewPrivilegedGatewayAccess(ctx context.Context, req *pb.ReviewPrivilegedGatewayAccessRequest) (*pb.ReviewPrivilegedGatewayAccessResponse, error) {
+	session := auth.SessionFromContext(ctx)
+
+	if err := s.authenticator.ValidateJita(session, req.Token); err != nil {
+		s.log.WithError(err).Error("validate token")
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	if !s.isJITAApprover(session) {
+		return nil, status.Error(codes.PermissionDenied, "not a member of any approver group")
+	}
+
+	grant, err := s.db.ReadGatewayJitaGrant(ctx, req.GetGrantID())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "grant %d not found", req.GetGrantID())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "read gateway jita grant: %v", err)
+	}
+
+	if grantIsForUser(session.GetObjectID())(grant) {
+		return nil, status.Error(codes.PermissionDenied, "can not review your own request")
+	}
+
+	grant, err = s.db.ReviewGatewayJitaGrant(ctx, grant.GetId(), session.GetDevice().GetUsername(), req.GetApproved())
+	if errors.Is(err, database.ErrGrantNotPending) {
+		return nil, status.Errorf(codes.FailedPrecondition, "grant %d is no longer pending approval", req.GetGrantID())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to review gateway jita grant: %v", err)
+	}
+
+	action := database.AuditActionJitaDeny
+	if req.GetApproved() {
+		action = database.AuditActionJitaApprove
+	}
+	s.audit(ctx, session.GetDevice().GetUsername(), action, gatewayTarget(grant.GetGateway()), fmt.Sprintf("grant %d for user %s", grant.GetId(), grant.GetUserID()))
+
+	if req.GetApproved() {
+		s.gateways.Trigger(grant.GetGateway())
+		s.rescheduleJitaExpiry()
+	}
+
+	return &pb.ReviewPrivilegedGatewayAccessResponse{
+		GatewayJitaGrant: grant,
+	}, nil
+}
+
+func (s *grpcServer) isJITAApprover(session *pb.Session) bool {
+	return slicesHasIntersect(session.GetGroups(), s.jitaApproverGroups)
+}
+
+// resolveGrantUsernames sets the username of grants from known sessions, as grants only know the user's object id.
+func (s *grpcServer) resolveGrantUsernames(grants []*pb.GatewayJitaGrant) {
+	usernames := make(map[string]string)
+	for _, session := range s.sessionStore.All() {
+		usernames[session.GetObjectID()] = session.GetDevice().GetUsername()
+	}
+	for _, grant := range grants {
+		grant.Username = usernames[grant.GetUserID()]
+	}
+}
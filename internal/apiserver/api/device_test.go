@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/agentversion"
 	"github.com/nais/device/internal/apiserver/api"
 	"github.com/nais/device/internal/apiserver/auth"
 	"github.com/nais/device/internal/apiserver/database"
@@ -167,7 +168,7 @@ func Test_GetDeviceConfiguration(t *testing.T) {
 			log := logrus.StandardLogger().WithField("component", "test")
 			server := api.NewGRPCServer(ctx, log, db, nil, nil, nil, nil, sessionStore, nil, tt.in.kolideEnabled)
 
-			s := grpc.NewServer()
+			s := grpc.NewServer(server.ServerOptions()...)
 			pb.RegisterAPIServerServer(s, server)
 
 			lis := bufconn.Listen(bufSize)
@@ -226,3 +227,148 @@ func Test_GetDeviceConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetDeviceConfigurationAgentVersion(t *testing.T) {
+	policy, err := agentversion.ParsePolicy([]string{"v1.4.0"}, "v1.5.0", 24*time.Hour)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		version       string
+		outdatedSince *timestamppb.Timestamp
+		getsConfig    bool
+		hasIssue      bool
+		newVersion    bool
+	}{
+		{
+			name:       "outdated version is detected and gets a grace period",
+			version:    "v1.3.0",
+			getsConfig: true,
+			hasIssue:   true,
+			newVersion: true,
+		},
+		{
+			name:          "outdated version past the deadline is unhealthy",
+			version:       "v1.3.0",
+			outdatedSince: timestamppb.New(time.Now().Add(-25 * time.Hour)),
+			getsConfig:    false,
+			hasIssue:      true,
+			newVersion:    true,
+		},
+		{
+			name:          "updated version clears the outdated timestamp",
+			version:       "v1.4.0",
+			outdatedSince: timestamppb.New(time.Now().Add(-25 * time.Hour)),
+			getsConfig:    true,
+			hasIssue:      false,
+			newVersion:    true,
+		},
+		{
+			name:       "latest version",
+			version:    "v1.5.0",
+			getsConfig: true,
+			hasIssue:   false,
+			newVersion: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			mockDevice := &pb.Device{
+				Id:                        123,
+				Serial:                    "deviceSerial",
+				Platform:                  "linux",
+				AgentVersion:              tt.version,
+				AgentVersionOutdatedSince: tt.outdatedSince,
+			}
+
+			mockSession := &pb.Session{
+				Key:      "sessionKey",
+				Device:   mockDevice,
+				ObjectID: "sessionUserId",
+				Groups:   []string{"groupId"},
+				Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+			}
+
+			sessionStore := auth.NewMockSessionStore(t)
+			sessionStore.EXPECT().Get(mock.Anything, mock.Anything).Return(mockSession, nil).Times(2)
+
+			// the database adds the outdated agent issue through the policy posture provider
+			storedDevice := func(since *timestamppb.Timestamp) *pb.Device {
+				device := &pb.Device{
+					Id:                        123,
+					Serial:                    "deviceSerial",
+					Platform:                  "linux",
+					AgentVersion:              tt.version,
+					AgentVersionOutdatedSince: since,
+				}
+				issues, err := policy.DeviceIssues(ctx, device)
+				assert.NoError(t, err)
+				device.Issues = issues
+				return device
+			}
+
+			db := database.NewMockDatabase(t)
+			db.EXPECT().UpdateDeviceAgentVersion(mock.Anything, int64(123), tt.version).Return(nil).Once()
+			db.EXPECT().ReadDeviceByID(mock.Anything, int64(123)).Return(storedDevice(tt.outdatedSince), nil).Once()
+			if tt.hasIssue && tt.outdatedSince == nil {
+				db.EXPECT().UpdateDeviceAgentVersionOutdatedSince(mock.Anything, int64(123), mock.AnythingOfType("time.Time")).Return(nil).Once()
+				updated := storedDevice(timestamppb.Now())
+				db.EXPECT().ReadDeviceByID(mock.Anything, int64(123)).Return(updated, nil).Once()
+				sessionStore.EXPECT().RefreshDevice(updated).Return().Once()
+			} else if !tt.hasIssue && tt.outdatedSince != nil {
+				db.EXPECT().UpdateDeviceAgentVersionOutdatedSince(mock.Anything, int64(123), time.Time{}).Return(nil).Once()
+				updated := storedDevice(nil)
+				db.EXPECT().ReadDeviceByID(mock.Anything, int64(123)).Return(updated, nil).Once()
+				sessionStore.EXPECT().RefreshDevice(updated).Return().Once()
+			}
+			db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{{Name: "gateway1", AccessGroupIDs: []string{"groupId"}}}, nil).Maybe()
+
+			log := logrus.StandardLogger().WithField("component", "test")
+			server := api.NewGRPCServer(ctx, log, db, nil, nil, nil, nil, sessionStore, nil, false, api.WithAgentVersionPolicy(policy))
+
+			s := grpc.NewServer(server.ServerOptions()...)
+			pb.RegisterAPIServerServer(s, server)
+
+			lis := bufconn.Listen(bufSize)
+			go func() {
+				err := s.Serve(lis)
+				assert.NoError(t, err)
+			}()
+
+			conn, err := grpc.NewClient(
+				"passthrough:///bufnet",
+				grpc.WithContextDialer(contextBufDialer(lis)),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			assert.NoError(t, err)
+			defer ioconvenience.CloseWithLog(conn, log)
+
+			client := pb.NewAPIServerClient(conn)
+
+			stream, err := client.GetDeviceConfiguration(ctx, &pb.GetDeviceConfigurationRequest{
+				SessionKey: mockSession.Key,
+				Version:    tt.version,
+			})
+			assert.NoError(t, err)
+
+			resp, err := stream.Recv()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.getsConfig, resp.GetStatus() == pb.DeviceConfigurationStatus_DeviceHealthy)
+			assert.Equal(t, tt.newVersion, resp.GetNewVersionAvailable())
+
+			hasIssue := false
+			for _, issue := range resp.GetIssues() {
+				if issue.GetTitle() == pb.IssueTitleAgentOutdated {
+					hasIssue = true
+					assert.Contains(t, issue.GetMessage(), "v1.4.0")
+				}
+			}
+			assert.Equal(t, tt.hasIssue, hasIssue)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"slices"
+	"strings"
 
 	"github.com/nais/device/pkg/pb"
 )
@@ -88,3 +89,31 @@ func gatewayForUserGroups(userGroups []string) func(*pb.Gateway) bool {
 		return slicesHasIntersect(gateway.AccessGroupIDs, userGroups)
 	}
 }
+
+// ---
+// Device filters
+// ---
+func deviceHasUsername(username string) func(*pb.Device) bool {
+	return func(device *pb.Device) bool {
+		return strings.EqualFold(device.GetUsername(), username)
+	}
+}
+
+func deviceIsPlatform(platform string) func(*pb.Device) bool {
+	return func(device *pb.Device) bool {
+		return device.GetPlatform() == platform
+	}
+}
+
+func deviceIsHealthy(device *pb.Device) bool {
+	return device.Healthy()
+}
+
+// ---
+// Gateway JITA grant filters
+// ---
+func grantIsForUser(userID string) func(*pb.GatewayJitaGrant) bool {
+	return func(grant *pb.GatewayJitaGrant) bool {
+		return grant.GetUserID() == userID
+	}
+}
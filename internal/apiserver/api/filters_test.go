@@ -2,9 +2,11 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nais/device/pkg/pb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestRemoveMSGateway(t *testing.T) {
@@ -63,3 +65,26 @@ func Test_sessionUserHasApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceFilters(t *testing.T) {
+	past := timestamppb.New(time.Now().Add(-time.Hour))
+	devices := []*pb.Device{
+		{Id: 1, Username: "Alice@example.com", Platform: "darwin"},
+		{Id: 2, Username: "alice@example.com", Platform: "linux", Issues: []*pb.DeviceIssue{{ResolveBefore: past}}},
+		{Id: 3, Username: "bob@example.com", Platform: "linux"},
+	}
+
+	ids := func(devices []*pb.Device) []int64 {
+		var ids []int64
+		for _, d := range devices {
+			ids = append(ids, d.Id)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []int64{1, 2}, ids(filterList(devices, deviceHasUsername("alice@example.com"))))
+	assert.Equal(t, []int64{2, 3}, ids(filterList(devices, deviceIsPlatform("linux"))))
+	assert.Equal(t, []int64{1, 3}, ids(filterList(devices, deviceIsHealthy)))
+	assert.Equal(t, []int64{2}, ids(filterList(devices, deviceHasUsername("alice@example.com"), not(deviceIsHealthy))))
+	assert.Equal(t, []int64{1, 2, 3}, ids(filterList(devices)))
+}
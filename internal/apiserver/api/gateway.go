@@ -3,54 +3,79 @@ package api
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/auth"
 	"github.com/nais/device/internal/apiserver/metrics"
 	"github.com/nais/device/pkg/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// gatewayConfigRefreshInterval is how often gateway configurations are rebuilt without being triggered.
+	// Expiring JITA grants are handled by the JITA expiry scheduler, and expiring sessions, issue grace periods and
+	// exemptions by the session deadline scheduler, so this is only a safety net.
+	gatewayConfigRefreshInterval = time.Minute
+	// gatewayFullSnapshotInterval is how often gateways in delta mode receive the complete configuration,
+	// so that any drift between the gateway and the apiserver is corrected.
+	gatewayFullSnapshotInterval = 10 * time.Minute
+)
+
 func (s *grpcServer) GetGatewayConfiguration(request *pb.GetGatewayConfigurationRequest, stream pb.APIServer_GetGatewayConfigurationServer) error {
-	err := s.gatewayAuth.Authenticate(stream.Context(), request.Gateway, request.Password)
-	if err != nil {
-		return status.Error(codes.Unauthenticated, err.Error())
-	}
+	gatewayName, _ := auth.GatewayFromContext(stream.Context())
 
-	log := s.log.WithField("gateway", request.Gateway)
+	log := s.log.WithField("gateway", gatewayName)
+	handover := s.handoverSignal()
 
-	trigger, err := s.gateways.Add(request.Gateway)
+	trigger, err := s.gateways.Add(gatewayName)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "this gateway already has an open session")
 	}
-	defer s.gateways.Remove(request.Gateway)
+	defer s.gateways.Remove(gatewayName)
 
 	log.Info("gateway connected")
 	defer log.Info("gateway disconnected")
 
-	metrics.SetGatewayConnected(request.Gateway, true)
-	defer metrics.SetGatewayConnected(request.Gateway, false)
+	metrics.SetGatewayConnected(gatewayName, true)
+	defer metrics.SetGatewayConnected(gatewayName, false)
+
+	// the gateway receives a fresh configuration on connect, so any expiry pending delivery is moot
+	s.jitaExpiry.forget(gatewayName)
 
-	updateGatewayTicker := time.NewTicker(10 * time.Second)
+	updateGatewayTicker := time.NewTicker(gatewayConfigRefreshInterval)
 	defer updateGatewayTicker.Stop()
 
-	var lastCfg *pb.GetGatewayConfigurationResponse
+	configStream := &gatewayConfigStream{
+		delta: request.GetMode() == pb.GatewayConfigurationMode_GatewayConfigurationModeDelta,
+	}
 	for {
-		if cfg, err := s.makeGatewayConfiguration(stream.Context(), request.Gateway); err != nil {
+		built := time.Now()
+		if cfg, err := s.makeGatewayConfiguration(stream.Context(), gatewayName); err != nil {
 			log.WithError(err).Error("make gateway config")
-		} else if equalGatewayConfigurations(lastCfg, cfg) {
+		} else if msg := configStream.next(cfg, built); msg == nil {
 			// no change, don't send
+			s.jitaExpiry.delivered(gatewayName, built)
 		} else {
-			if err := stream.Send(cfg); err != nil {
+			if err := stream.Send(msg); err != nil {
 				log.WithError(err).Error("send gateway config")
 			} else {
-				lastCfg = cfg
+				configStream.sent(cfg, msg, built)
+				s.jitaExpiry.delivered(gatewayName, built)
 			}
 		}
 
 		// block until trigger or done
 		select {
-		case <-trigger:
+		case _, ok := <-trigger:
+			if !ok {
+				log.Info("gateway stream closed by apiserver")
+				return status.Error(codes.Unavailable, "gateway stream closed")
+			}
+		case <-handover:
+			log.Info("apiserver handing over, ending gateway stream")
+			return errHandover()
 		case <-updateGatewayTicker.C:
 		case <-stream.Context().Done():
 			return nil
@@ -59,7 +84,7 @@ func (s *grpcServer) GetGatewayConfiguration(request *pb.GetGatewayConfiguration
 }
 
 // Return a list of user sessions that are authorized to access a gateway through JITA.
-func (s grpcServer) privilegedUsersForGateway(ctx context.Context, gateway *pb.Gateway) []string {
+func (s *grpcServer) privilegedUsersForGateway(ctx context.Context, gateway *pb.Gateway) []string {
 	privilegedUsers, err := s.db.UsersWithAccessToPrivilegedGateway(ctx, gateway.Name)
 	if err != nil {
 		s.log.WithError(err).Error("get privileged users")
@@ -71,6 +96,88 @@ func (s grpcServer) privilegedUsersForGateway(ctx context.Context, gateway *pb.G
 	return privilegedUsers
 }
 
+// gatewayConfigStream keeps track of what has been sent on a gateway configuration stream,
+// and decides what to send next.
+type gatewayConfigStream struct {
+	delta        bool
+	last         *pb.GetGatewayConfigurationResponse
+	generation   uint64
+	lastSnapshot time.Time
+}
+
+// next returns the message that brings the gateway up to date with cfg, or nil if it already is.
+func (g *gatewayConfigStream) next(cfg *pb.GetGatewayConfigurationResponse, now time.Time) *pb.GetGatewayConfigurationResponse {
+	if !g.delta {
+		if equalGatewayConfigurations(g.last, cfg) {
+			return nil
+		}
+		return cfg
+	}
+
+	if g.last == nil || now.Sub(g.lastSnapshot) >= gatewayFullSnapshotInterval {
+		return &pb.GetGatewayConfigurationResponse{
+			Devices:      cfg.GetDevices(),
+			RoutesIPv4:   cfg.GetRoutesIPv4(),
+			RoutesIPv6:   cfg.GetRoutesIPv6(),
+			Generation:   g.generation + 1,
+			FullSnapshot: true,
+		}
+	}
+
+	delta := gatewayConfigurationDelta(g.last, cfg)
+	if len(delta.AddedDevices) == 0 && len(delta.RemovedPublicKeys) == 0 && !delta.RoutesChanged {
+		return nil
+	}
+	delta.Generation = g.generation + 1
+	return delta
+}
+
+// sent records that msg, made from cfg, has been delivered to the gateway.
+func (g *gatewayConfigStream) sent(cfg, msg *pb.GetGatewayConfigurationResponse, now time.Time) {
+	g.last = cfg
+	g.generation = msg.GetGeneration()
+	if msg.GetFullSnapshot() {
+		g.lastSnapshot = now
+	}
+}
+
+// gatewayConfigurationDelta returns the changes needed to go from prev to cfg.
+// Peers are identified by their public key, as that is what WireGuard uses.
+func gatewayConfigurationDelta(prev, cfg *pb.GetGatewayConfigurationResponse) *pb.GetGatewayConfigurationResponse {
+	delta := &pb.GetGatewayConfigurationResponse{}
+
+	previous := make(map[string]*pb.Device, len(prev.GetDevices()))
+	for _, device := range prev.GetDevices() {
+		previous[device.GetPublicKey()] = device
+	}
+
+	for _, device := range cfg.GetDevices() {
+		if p, ok := previous[device.GetPublicKey()]; !ok || !equalPeers(p, device) {
+			delta.AddedDevices = append(delta.AddedDevices, device)
+		}
+		delete(previous, device.GetPublicKey())
+	}
+
+	for publicKey := range previous {
+		delta.RemovedPublicKeys = append(delta.RemovedPublicKeys, publicKey)
+	}
+	slices.Sort(delta.RemovedPublicKeys)
+
+	if !slices.Equal(prev.GetRoutesIPv4(), cfg.GetRoutesIPv4()) || !slices.Equal(prev.GetRoutesIPv6(), cfg.GetRoutesIPv6()) {
+		delta.RoutesChanged = true
+		delta.RoutesIPv4 = cfg.GetRoutesIPv4()
+		delta.RoutesIPv6 = cfg.GetRoutesIPv6()
+	}
+
+	return delta
+}
+
+func equalPeers(a, b *pb.Device) bool {
+	return a.GetName() == b.GetName() &&
+		a.GetPublicKey() == b.GetPublicKey() &&
+		slices.Equal(a.GetAllowedIPs(), b.GetAllowedIPs())
+}
+
 func equalGatewayConfigurations(a, b *pb.GetGatewayConfigurationResponse) bool {
 	if a == b {
 		return true
@@ -155,4 +262,6 @@ func (s *grpcServer) makeGatewayConfiguration(ctx context.Context, gatewayName s
 
 func (s *grpcServer) SendAllGatewayConfigurations() {
 	s.gateways.TriggerAll()
+	// whatever changed may have moved the next session deadline
+	s.rescheduleSessionDeadlines()
 }
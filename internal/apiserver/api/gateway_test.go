@@ -2,19 +2,29 @@ package api_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/nais/device/internal/apiserver/api"
 	"github.com/nais/device/internal/apiserver/auth"
 	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/kolide"
+	"github.com/nais/device/internal/apiserver/posture"
+	"github.com/nais/device/internal/apiserver/testdatabase"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -80,7 +90,7 @@ func Test_MakeGatewayConfiguration(t *testing.T) {
 	log := logrus.StandardLogger().WithField("component", "test")
 	server := api.NewGRPCServer(ctx, log, db, nil, nil, gatewayAuthenticator, nil, sessionStore, nil, true)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(server.ServerOptions()...)
 	pb.RegisterAPIServerServer(s, server)
 	lis := bufconn.Listen(bufSize)
 	go func() {
@@ -152,3 +162,468 @@ func Test_MakeGatewayConfiguration(t *testing.T) {
 
 	stream2Cancel()
 }
+
+func TestDeleteGatewayClosesStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+	db.EXPECT().DeleteGateway(mock.Anything, "gateway").Return(nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, mock.Anything, database.AuditActionGatewayDelete, "gateway:gateway", "").Return(nil).Once()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.On("All").Return([]*pb.Session{}).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	_, err = client.DeleteGateway(ctx, &pb.ModifyGatewayRequest{Gateway: &pb.Gateway{Name: "gateway"}})
+	assert.NoError(t, err)
+
+	select {
+	case <-server.PeersChanged():
+	default:
+		t.Error("expected peers changed notification")
+	}
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestEndStreamsEndsGatewayStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.On("All").Return([]*pb.Session{}).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	server.EndStreams()
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// the gateway can reconnect right away
+	stream, err = client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+}
+
+func TestJitaExpirySchedulerRemovesExpiredGrant(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:                     "privileged",
+		RoutesIPv4:               []string{"mockroute"},
+		AccessGroupIDs:           []string{"groupId"},
+		RequiresPrivilegedAccess: true,
+	}
+
+	expires := time.Now().Add(500 * time.Millisecond)
+	active := func() bool { return time.Now().Before(expires) }
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "privileged").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+	db.EXPECT().UsersWithAccessToPrivilegedGateway(mock.Anything, "privileged").RunAndReturn(func(context.Context, string) ([]string, error) {
+		if active() {
+			return []string{"sessionUserId"}, nil
+		}
+		return nil, nil
+	}).Maybe()
+	db.EXPECT().ReadGatewayJitaGrants(mock.Anything, database.GatewayJitaGrantFilter{ActiveOnly: true}).RunAndReturn(func(context.Context, database.GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error) {
+		if active() {
+			return []*pb.GatewayJitaGrant{{Id: 1, Gateway: "privileged", UserID: "sessionUserId", Expires: timestamppb.New(expires)}}, nil
+		}
+		return nil, nil
+	}).Maybe()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.On("All").Return([]*pb.Session{
+		{
+			Device:   &pb.Device{PublicKey: "devicePublicKey"},
+			ObjectID: "sessionUserId",
+			Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+			Groups:   []string{"groupId"},
+		},
+	}).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "privileged"})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+
+	go server.RunJitaExpiryScheduler(ctx)
+
+	// the periodic refresh is far longer than the test timeout, so this update must come from the scheduler
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+	assert.False(t, time.Now().Before(expires))
+	assert.WithinDuration(t, expires, time.Now(), time.Second)
+}
+
+func TestSessionDeadlineSchedulerRemovesUnhealthyDevice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+
+	resolveBefore := time.Now().Add(500 * time.Millisecond)
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().Return([]*pb.Session{
+		{
+			Device: &pb.Device{
+				PublicKey: "devicePublicKey",
+				Issues: []*pb.DeviceIssue{
+					{Title: "outdated", ResolveBefore: timestamppb.New(resolveBefore)},
+				},
+			},
+			ObjectID: "sessionUserId",
+			Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+			Groups:   []string{"groupId"},
+		},
+	}).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+
+	go server.RunSessionDeadlineScheduler(ctx)
+
+	// the periodic refresh is far longer than the test timeout, so this update must come from the scheduler
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+	assert.WithinDuration(t, resolveBefore, time.Now(), time.Second)
+}
+
+func TestRefreshDevicePostureUpdatesGateways(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+
+	// the cached device still has an issue past its deadline, which the posture feed no longer reports
+	session := &pb.Session{
+		Device: &pb.Device{
+			Id:        1,
+			PublicKey: "devicePublicKey",
+			Issues: []*pb.DeviceIssue{
+				{Title: "disk not encrypted", ResolveBefore: timestamppb.New(time.Now().Add(-time.Hour))},
+			},
+		},
+		ObjectID: "sessionUserId",
+		Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Groups:   []string{"groupId"},
+	}
+	storedDevice := &pb.Device{Id: 1, PublicKey: "devicePublicKey"}
+
+	var lock sync.Mutex
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+	db.EXPECT().ReadDevices(mock.Anything).Return([]*pb.Device{storedDevice}, nil).Once()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().RunAndReturn(func() []*pb.Session {
+		lock.Lock()
+		defer lock.Unlock()
+		return []*pb.Session{proto.Clone(session).(*pb.Session)}
+	}).Maybe()
+	sessionStore.EXPECT().RefreshDevice(storedDevice).Run(func(device *pb.Device) {
+		lock.Lock()
+		defer lock.Unlock()
+		session.Device = device
+	}).Return().Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+
+	assert.NoError(t, server.RefreshDevicePosture(ctx))
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+}
+
+func TestSyncPosturePolicyUpdatesGateways(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	policyPath := filepath.Join(t.TempDir(), "policy.yaml")
+	writePolicy := func(severity string) {
+		require.NoError(t, os.WriteFile(policyPath, []byte("tags:\n  must-fix: "+severity+"\n"), 0o600))
+	}
+	writePolicy("notice")
+	policy := posture.NewPolicyFile(policyPath, logrus.New())
+	_, err := policy.Sync(ctx)
+	require.NoError(t, err)
+
+	db := testdatabase.Setup(t, true, database.WithPosturePolicy(policy))
+
+	d := &pb.Device{Username: "username", PublicKey: "devicePublicKey", Serial: "serial", Platform: "darwin", ExternalID: "1"}
+	require.NoError(t, db.AddDevice(ctx, d))
+	require.NoError(t, db.LinkKolideDevice(ctx, d.ExternalID, d.Serial, d.Platform))
+	require.NoError(t, db.UpdateKolideChecks(ctx, []*kolide.Check{{ID: "1", Tags: []kolide.CheckTag{{Name: "must-fix"}}, IssueTitle: "disk not encrypted"}}))
+	detectedAt := time.Now().Add(-time.Hour)
+	require.NoError(t, db.UpdateKolideIssuesForDevice(ctx, d.ExternalID, []*kolide.Issue{{
+		ID:         "1",
+		Title:      "disk not encrypted",
+		DeviceRef:  kolide.Reference{Identifier: d.ExternalID},
+		CheckRef:   kolide.Reference{Identifier: "1"},
+		DetectedAt: &detectedAt,
+	}}))
+
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	require.NoError(t, err)
+	require.NoError(t, db.AddSessionInfo(ctx, &pb.Session{
+		Key:      "key",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   device,
+		Groups:   []string{"groupId"},
+		ObjectID: "oid",
+	}))
+	require.NoError(t, db.AddGateway(ctx, &pb.Gateway{Name: "gateway", PublicKey: "gatewayPublicKey", Endpoint: "1.2.3.4:56789"}))
+	require.NoError(t, db.UpdateGatewaysDynamicFields(ctx, []*pb.Gateway{{Name: "gateway", AccessGroupIDs: []string{"groupId"}, RoutesIPv4: []string{"10.0.0.0/24"}}}))
+
+	sessionStore := auth.NewSessionStore(db)
+	require.NoError(t, sessionStore.Reload(ctx))
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false, api.WithPosturePolicy(policy))
+	client := serveAPIServer(t, server)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "gateway"})
+	require.NoError(t, err)
+
+	// a notice has a grace period of days
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Len(t, resp.GetDevices(), 1)
+
+	// critical issues have no grace period
+	writePolicy("critical")
+	require.NoError(t, server.SyncPosturePolicy(ctx))
+
+	session, err := sessionStore.Get(ctx, "key")
+	require.NoError(t, err)
+	if assert.Len(t, session.GetDevice().GetIssues(), 1) {
+		assert.Equal(t, pb.Severity_Critical, session.GetDevice().GetIssues()[0].GetSeverity())
+	}
+
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetDevices())
+}
+
+func TestGatewayConfigurationDeltaMode(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mockGateway := &pb.Gateway{
+		Name:           "gateway",
+		RoutesIPv4:     []string{"mockroute"},
+		AccessGroupIDs: []string{"groupId"},
+	}
+
+	session1 := &pb.Session{
+		Device:   &pb.Device{Id: 1, PublicKey: "devicePublicKey1", Ipv4: "10.255.240.2"},
+		ObjectID: "user1",
+		Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Groups:   []string{"groupId"},
+	}
+	session2 := &pb.Session{
+		Device:   &pb.Device{Id: 2, PublicKey: "devicePublicKey2", Ipv4: "10.255.240.3"},
+		ObjectID: "user2",
+		Expiry:   timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Groups:   []string{"groupId"},
+	}
+
+	var lock sync.Mutex
+	sessions := []*pb.Session{session1}
+
+	db := database.NewMockDatabase(t)
+	db.On("ReadGateway", mock.Anything, "gateway").Return(mockGateway, nil).Maybe()
+	db.On("GetAcceptances", mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().RunAndReturn(func() []*pb.Session {
+		lock.Lock()
+		defer lock.Unlock()
+		return sessions
+	}).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, auth.NewMockAPIKeyAuthenticator(), nil, sessionStore, nil, false)
+
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	client := pb.NewAPIServerClient(conn)
+
+	stream, err := client.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{
+		Gateway: "gateway",
+		Mode:    pb.GatewayConfigurationMode_GatewayConfigurationModeDelta,
+	})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.True(t, resp.GetFullSnapshot())
+	assert.Equal(t, uint64(1), resp.GetGeneration())
+	assert.Len(t, resp.GetDevices(), 1)
+	assert.Equal(t, mockGateway.GetRoutesIPv4(), resp.GetRoutesIPv4())
+
+	lock.Lock()
+	sessions = []*pb.Session{session2}
+	lock.Unlock()
+	server.SendAllGatewayConfigurations()
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.False(t, resp.GetFullSnapshot())
+	assert.Equal(t, uint64(2), resp.GetGeneration())
+	assert.Empty(t, resp.GetDevices())
+	assert.Len(t, resp.GetAddedDevices(), 1)
+	assert.Equal(t, "devicePublicKey2", resp.GetAddedDevices()[0].GetPublicKey())
+	assert.Equal(t, []string{"devicePublicKey1"}, resp.GetRemovedPublicKeys())
+	assert.False(t, resp.GetRoutesChanged())
+	assert.Empty(t, resp.GetRoutesIPv4())
+}
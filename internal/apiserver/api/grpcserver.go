@@ -2,28 +2,55 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"sync"
 
+	"github.com/nais/device/internal/apiserver/agentversion"
 	"github.com/nais/device/internal/apiserver/api/triggers"
 	"github.com/nais/device/internal/apiserver/auth"
 	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/graph"
 	"github.com/nais/device/internal/apiserver/kolide"
+	"github.com/nais/device/internal/apiserver/posture"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcServer struct {
 	pb.UnimplementedAPIServerServer
 
 	authenticator  auth.Authenticator
-	adminAuth      auth.UsernamePasswordAuthenticator
+	adminAuth      auth.AdminAuthenticator
 	gatewayAuth    auth.UsernamePasswordAuthenticator
 	prometheusAuth auth.UsernamePasswordAuthenticator
 	kolideClient   kolide.Client
 	kolideEnabled  bool
 
+	jitaApproverGroups []string
+	agentVersions      *agentversion.Policy
+	posturePolicy      *posture.PolicyFile
+	groupMembership    graph.Client
+	wireguardPrefixes  []netip.Prefix
+
+	// usersNotFound are the users that were not found in the identity provider by the previous RefreshSessionGroups
+	usersNotFound     map[string]bool
+	usersNotFoundLock sync.Mutex
+
 	devices  *triggers.StreamTriggers[int64]
 	gateways *triggers.StreamTriggers[string]
 
+	jitaExpiry *jitaExpiry
+	// sessionDeadlines wakes the session deadline scheduler when sessions or device issues change
+	sessionDeadlines chan struct{}
+
+	peersChanged chan struct{}
+
+	handover     chan struct{}
+	handoverLock sync.Mutex
+
 	db           database.Database
 	sessionStore auth.SessionStore
 
@@ -34,31 +61,68 @@ type grpcServer struct {
 
 var _ pb.APIServerServer = &grpcServer{}
 
-func NewGRPCServer(ctx context.Context, log logrus.FieldLogger, db database.Database, authenticator auth.Authenticator, adminAuth, gatewayAuth, prometheusAuth auth.UsernamePasswordAuthenticator, sessionStore auth.SessionStore, kolideClient kolide.Client, kolideEnabled bool) *grpcServer {
-	return &grpcServer{
-		devices:        triggers.New[int64](),
-		gateways:       triggers.New[string](),
-		authenticator:  authenticator,
-		adminAuth:      adminAuth,
-		gatewayAuth:    gatewayAuth,
-		prometheusAuth: prometheusAuth,
-		db:             db,
-		kolideClient:   kolideClient,
-		sessionStore:   sessionStore,
-		programContext: ctx,
-		log:            log,
-		kolideEnabled:  kolideEnabled,
+type Option func(*grpcServer)
+
+// WithJITAApproverGroups sets the groups whose members may approve grants for gateways requiring approval.
+func WithJITAApproverGroups(groups []string) Option {
+	return func(s *grpcServer) {
+		s.jitaApproverGroups = groups
+	}
+}
+
+// WithAgentVersionPolicy sets the minimum and latest naisdevice agent versions that devices are held to.
+func WithAgentVersionPolicy(policy *agentversion.Policy) Option {
+	return func(s *grpcServer) {
+		s.agentVersions = policy
+	}
+}
+
+// WithPosturePolicy sets the posture policy shown by GetPosturePolicy. It should be the policy used by the database.
+func WithPosturePolicy(policy *posture.PolicyFile) Option {
+	return func(s *grpcServer) {
+		s.posturePolicy = policy
 	}
 }
 
-func authenticateAny(ctx context.Context, username, password string, auths ...auth.UsernamePasswordAuthenticator) error {
-	for _, a := range auths {
-		if a.Authenticate(ctx, username, password) == nil {
-			return nil
-		}
+// WithGroupMembership sets the client used by RefreshSessionGroups to look up the groups of users in the identity provider.
+func WithGroupMembership(client graph.Client) Option {
+	return func(s *grpcServer) {
+		s.groupMembership = client
 	}
+}
 
-	return auth.ErrInvalidAuth
+// WithWireGuardPrefixes sets the WireGuard networks returned by GetWireGuardPrefixes. They should be the prefixes given to the gateway configurer.
+func WithWireGuardPrefixes(prefixes []netip.Prefix) Option {
+	return func(s *grpcServer) {
+		s.wireguardPrefixes = prefixes
+	}
+}
+
+func NewGRPCServer(ctx context.Context, log logrus.FieldLogger, db database.Database, authenticator auth.Authenticator, adminAuth auth.AdminAuthenticator, gatewayAuth, prometheusAuth auth.UsernamePasswordAuthenticator, sessionStore auth.SessionStore, kolideClient kolide.Client, kolideEnabled bool, opts ...Option) *grpcServer {
+	s := &grpcServer{
+		devices:          triggers.New[int64](),
+		gateways:         triggers.New[string](),
+		jitaExpiry:       newJitaExpiry(),
+		sessionDeadlines: make(chan struct{}, 1),
+		peersChanged:     make(chan struct{}, 1),
+		handover:         make(chan struct{}),
+		authenticator:    authenticator,
+		adminAuth:        adminAuth,
+		gatewayAuth:      gatewayAuth,
+		prometheusAuth:   prometheusAuth,
+		db:               db,
+		kolideClient:     kolideClient,
+		sessionStore:     sessionStore,
+		programContext:   ctx,
+		log:              log,
+		kolideEnabled:    kolideEnabled,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *grpcServer) UpdateKolideChecks(ctx context.Context) error {
@@ -69,3 +133,67 @@ func (s *grpcServer) UpdateKolideChecks(ctx context.Context) error {
 	}
 	return nil
 }
+
+// PeersChanged signals when WireGuard peers have been removed from the database,
+// so that the apiserver's own WireGuard config can be synced without waiting for the next interval.
+func (s *grpcServer) PeersChanged() <-chan struct{} {
+	return s.peersChanged
+}
+
+func (s *grpcServer) notifyPeersChanged() {
+	select {
+	case s.peersChanged <- struct{}{}:
+	default:
+	}
+}
+
+// EndStreams ends all open device and gateway streams with codes.Unavailable, so that clients reconnect with their existing sessions.
+// This is used when the apiserver hands over to another instance, and unlike closing the stream triggers, it does not invalidate device sessions.
+func (s *grpcServer) EndStreams() {
+	s.handoverLock.Lock()
+	defer s.handoverLock.Unlock()
+
+	close(s.handover)
+	s.handover = make(chan struct{})
+}
+
+// handoverSignal returns a channel that is closed when the currently open streams should end.
+func (s *grpcServer) handoverSignal() <-chan struct{} {
+	s.handoverLock.Lock()
+	defer s.handoverLock.Unlock()
+
+	return s.handover
+}
+
+func errHandover() error {
+	return status.Error(codes.Unavailable, "apiserver is handing over to another instance")
+}
+
+// audit records an event in the audit log. Failing to write the event does not fail the request,
+// as the change it describes has already been made.
+func (s *grpcServer) audit(ctx context.Context, actor, action, target, reason string) {
+	log := s.log.WithFields(logrus.Fields{
+		"actor":  actor,
+		"action": action,
+		"target": target,
+	})
+	if err := s.db.AddAuditEvent(ctx, actor, action, target, reason); err != nil {
+		log.WithError(err).Error("write audit event")
+	}
+}
+
+func gatewayTarget(name string) string {
+	return "gateway:" + name
+}
+
+func deviceTarget(deviceID int64) string {
+	return fmt.Sprintf("device:%d", deviceID)
+}
+
+func jitaGrantTarget(id int64) string {
+	return fmt.Sprintf("jita-grant:%d", id)
+}
+
+func exemptionTarget(id int64) string {
+	return fmt.Sprintf("exemption:%d", id)
+}
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"context"
+	"database/sql"
 	"net"
 	"testing"
 	"time"
@@ -75,7 +76,7 @@ func TestGetDeviceConfiguration(t *testing.T) {
 	log := logrus.StandardLogger().WithField("component", "test")
 	server := api.NewGRPCServer(ctx, log, db, nil, nil, gatewayAuthenticator, nil, auth.NewSessionStore(db), kolideClient, true)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(server.ServerOptions()...)
 	pb.RegisterAPIServerServer(s, server)
 	lis := bufconn.Listen(bufSize)
 	go func() {
@@ -92,7 +93,7 @@ func TestGetDeviceConfiguration(t *testing.T) {
 	defer conn.Close()
 
 	client := pb.NewAPIServerClient(conn)
-	configClient, err := client.GetDeviceConfiguration(ctx, &pb.GetDeviceConfigurationRequest{})
+	configClient, err := client.GetDeviceConfiguration(pb.WithSessionKey(ctx, "session-key"), &pb.GetDeviceConfigurationRequest{})
 	assert.NoError(t, err)
 
 	resp, err := configClient.Recv()
@@ -106,6 +107,90 @@ func TestGetDeviceConfiguration(t *testing.T) {
 	assert.Equal(t, "gateway", gw.Name)
 }
 
+func TestRenewSession(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	device := &pb.Device{Id: 1, Username: "user@example.com"}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadSessionInfo(mock.Anything, "old-key").Return(&pb.Session{
+		Key:      "old-key",
+		ObjectID: "objectId123",
+		Expiry:   timestamppb.New(time.Now().Add(auth.SessionRenewalWindow / 2)),
+		Device:   device,
+	}, nil).Once()
+	db.EXPECT().ReadSessionInfo(mock.Anything, "old-key").Return(nil, sql.ErrNoRows)
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(1)).Return(device, nil)
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{}, nil)
+	db.EXPECT().RenewSessionInfo(mock.Anything, "old-key", mock.Anything).Return(nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, "user@example.com", database.AuditActionDeviceRenew, "device:1", "").Return(nil).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	sessionStore := auth.NewSessionStore(db)
+	server := api.NewGRPCServer(ctx, log, db, auth.NewMockAuthenticator(sessionStore), nil, nil, nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+
+	recv := func(key string) *pb.GetDeviceConfigurationResponse {
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := client.GetDeviceConfiguration(pb.WithSessionKey(streamCtx, key), &pb.GetDeviceConfigurationRequest{})
+		assert.NoError(t, err)
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		return resp
+	}
+
+	// sessions about to expire are asked to renew
+	assert.True(t, recv("old-key").GetRenewSession())
+
+	resp, err := client.RenewSession(pb.WithSessionKey(ctx, "old-key"), &pb.RenewSessionRequest{Token: "token"})
+	assert.NoError(t, err)
+	renewed := resp.GetSession()
+	assert.NotEqual(t, "old-key", renewed.GetKey())
+	assert.Equal(t, int64(1), renewed.GetDevice().GetId())
+	assert.WithinDuration(t, time.Now().Add(auth.SessionDuration), renewed.GetExpiry().AsTime(), time.Minute)
+
+	assert.False(t, recv(renewed.GetKey()).GetRenewSession())
+
+	// the old session key stops working
+	_, err = client.RenewSession(pb.WithSessionKey(ctx, "old-key"), &pb.RenewSessionRequest{Token: "token"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRenewSessionGoogle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	device := &pb.Device{Id: 1, Username: "user@example.com"}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadSessionInfo(mock.Anything, "key").Return(&pb.Session{
+		Key:      "key",
+		ObjectID: "objectId123",
+		Expiry:   timestamppb.New(time.Now().Add(auth.SessionRenewalWindow / 2)),
+		Device:   device,
+	}, nil)
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(1)).Return(device, nil)
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{}, nil)
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	sessionStore := auth.NewSessionStore(db)
+	server := api.NewGRPCServer(ctx, log, db, auth.NewGoogleAuthenticator(nil, db, sessionStore), nil, nil, nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+
+	// google devices can not refresh their token, so they are not asked to renew
+	stream, err := client.GetDeviceConfiguration(pb.WithSessionKey(ctx, "key"), &pb.GetDeviceConfigurationRequest{})
+	assert.NoError(t, err)
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.False(t, resp.GetRenewSession())
+
+	_, err = client.RenewSession(pb.WithSessionKey(ctx, "key"), &pb.RenewSessionRequest{Token: "token"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
 func TestGatewayPasswordAuthentication(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -132,7 +217,7 @@ func TestGatewayPasswordAuthentication(t *testing.T) {
 	log := logrus.StandardLogger().WithField("component", "test")
 	server := api.NewGRPCServer(ctx, log, db, nil, nil, gatewayAuthenticator, nil, sessionStore, nil, false)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(server.ServerOptions()...)
 	pb.RegisterAPIServerServer(s, server)
 	lis := bufconn.Listen(bufSize)
 	go func() {
@@ -189,7 +274,7 @@ func TestGatewayPasswordAuthenticationFail(t *testing.T) {
 	log := logrus.StandardLogger().WithField("component", "test")
 	server := api.NewGRPCServer(ctx, log, db, nil, nil, gatewayAuthenticator, nil, nil, nil, false)
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(server.ServerOptions()...)
 	pb.RegisterAPIServerServer(s, server)
 	lis := bufconn.Listen(bufSize)
 	go func() {
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type principalKind int
+
+const (
+	principalNone principalKind = iota
+	principalAdmin
+	principalGateway
+	principalSession
+)
+
+// methodAuth is how requests to a method are authenticated.
+type methodAuth struct {
+	principal principalKind
+	// role is the lowest admin role allowed to call the method
+	role auth.Role
+	// prometheus allows the prometheus agent to call the method as well as admins
+	prometheus bool
+	// allowExpired accepts expired sessions, for streams that end themselves when the session expires
+	allowExpired bool
+}
+
+var methodAuths = map[string]methodAuth{
+	pb.APIServer_Login_FullMethodName: {principal: principalNone},
+
+	pb.APIServer_GetDeviceConfiguration_FullMethodName:                    {principal: principalSession, allowExpired: true},
+	pb.APIServer_RenewSession_FullMethodName:                              {principal: principalSession},
+	pb.APIServer_GetAcceptableUseAcceptedAt_FullMethodName:                {principal: principalSession},
+	pb.APIServer_SetAcceptableUseAccepted_FullMethodName:                  {principal: principalSession},
+	pb.APIServer_GetGatewayJitaGrantsForUser_FullMethodName:               {principal: principalSession},
+	pb.APIServer_UserHasAccessToPrivilegedGateway_FullMethodName:          {principal: principalSession},
+	pb.APIServer_GrantPrivilegedGatewayAccess_FullMethodName:              {principal: principalSession},
+	pb.APIServer_RevokePrivilegedGatewayAccess_FullMethodName:             {principal: principalSession},
+	pb.APIServer_GetPrivilegedGatewayAccessPolicy_FullMethodName:          {principal: principalSession},
+	pb.APIServer_GetPendingPrivilegedGatewayAccessRequests_FullMethodName: {principal: principalSession},
+	pb.APIServer_ReviewPrivilegedGatewayAccess_FullMethodName:             {principal: principalSession},
+
+	pb.APIServer_GetGatewayConfiguration_FullMethodName: {principal: principalGateway},
+
+	pb.APIServer_ListGateways_FullMethodName:           {principal: principalAdmin, role: auth.RoleViewer, prometheus: true},
+	pb.APIServer_GetGateway_FullMethodName:             {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_ListDevices_FullMethodName:            {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_GetDevice_FullMethodName:              {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_GetSessions_FullMethodName:            {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_ListAuditEvents_FullMethodName:        {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_ListGatewayJitaGrants_FullMethodName:  {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_ListIssueExemptions_FullMethodName:    {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_GetPosturePolicy_FullMethodName:       {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_GetWireGuardPrefixes_FullMethodName:   {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_GetKolideCache_FullMethodName:         {principal: principalAdmin, role: auth.RoleViewer},
+	pb.APIServer_EnrollGateway_FullMethodName:          {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_UpdateGateway_FullMethodName:          {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_ReassignDevice_FullMethodName:         {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_RevokeSessions_FullMethodName:         {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_RevokeGatewayJitaGrant_FullMethodName: {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_CreateIssueExemption_FullMethodName:   {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_RevokeIssueExemption_FullMethodName:   {principal: principalAdmin, role: auth.RoleOperator},
+	pb.APIServer_DeleteGateway_FullMethodName:          {principal: principalAdmin, role: auth.RoleAdmin},
+	pb.APIServer_DeleteDevice_FullMethodName:           {principal: principalAdmin, role: auth.RoleAdmin},
+	pb.APIServer_SnapshotDatabase_FullMethodName:       {principal: principalAdmin, role: auth.RoleAdmin},
+}
+
+// Credentials in request messages from clients that do not send them as metadata yet.
+type (
+	usernamePasswordRequest interface {
+		GetUsername() string
+		GetPassword() string
+	}
+	gatewayRequest interface {
+		GetGateway() string
+		GetPassword() string
+	}
+	sessionRequest interface {
+		GetSessionKey() string
+	}
+)
+
+// ServerOptions installs the interceptors authenticating requests to the server.
+func (s *grpcServer) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(s.StreamServerInterceptor),
+	}
+}
+
+// UnaryServerInterceptor authenticates unary requests, and puts the principal into the request context.
+func (s *grpcServer) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticateRequest(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor authenticates streaming requests when the request message is received,
+// so that credentials in the message can be used, and puts the principal into the stream context.
+func (s *grpcServer) StreamServerInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &authenticatedStream{
+		ServerStream: stream,
+		authenticate: func(ctx context.Context, req any) (context.Context, error) {
+			return s.authenticateRequest(ctx, info.FullMethod, req)
+		},
+	})
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	authenticate func(context.Context, any) (context.Context, error)
+}
+
+func (a *authenticatedStream) Context() context.Context {
+	if a.ctx != nil {
+		return a.ctx
+	}
+	return a.ServerStream.Context()
+}
+
+func (a *authenticatedStream) RecvMsg(m any) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if a.ctx != nil {
+		return nil
+	}
+
+	ctx, err := a.authenticate(a.ServerStream.Context(), m)
+	if err != nil {
+		return err
+	}
+	a.ctx = ctx
+
+	return nil
+}
+
+// authenticateRequest authenticates a request with credentials from the request metadata,
+// and falls back to credentials in the request message.
+func (s *grpcServer) authenticateRequest(ctx context.Context, method string, req any) (context.Context, error) {
+	methodAuth, ok := methodAuths[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "no authentication for method %s", method)
+	}
+
+	switch methodAuth.principal {
+	case principalNone:
+		return ctx, nil
+
+	case principalSession:
+		key, ok := auth.SessionKey(ctx)
+		if !ok {
+			if r, isSessionRequest := req.(sessionRequest); isSessionRequest {
+				key = r.GetSessionKey()
+			}
+		}
+
+		if key == "" {
+			return nil, status.Error(codes.Unauthenticated, "no session key")
+		}
+
+		session, err := s.sessionStore.Get(ctx, key)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "unknown session")
+		}
+
+		if session.Expired() && !methodAuth.allowExpired {
+			return nil, status.Error(codes.Unauthenticated, "session expired")
+		}
+
+		return auth.WithSession(ctx, session), nil
+
+	case principalGateway:
+		name, password, ok := auth.BasicAuth(ctx)
+		if !ok {
+			if r, isGatewayRequest := req.(gatewayRequest); isGatewayRequest {
+				name, password = r.GetGateway(), r.GetPassword()
+			}
+		}
+
+		// gateways without a password may authenticate with a client certificate named after the gateway
+		if certificate, hasCertificate := auth.ClientCertificate(ctx); hasCertificate && password == "" {
+			name = certificate.Subject.CommonName
+			if _, err := s.db.ReadGateway(ctx, name); err != nil {
+				return nil, status.Errorf(codes.Unauthenticated, "client certificate for unknown gateway %q", name)
+			}
+
+			return auth.WithGateway(ctx, name), nil
+		}
+
+		if err := s.gatewayAuth.Authenticate(ctx, name, password); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return auth.WithGateway(ctx, name), nil
+
+	case principalAdmin:
+		username, password, ok := auth.BasicAuth(ctx)
+		if !ok {
+			if r, isUsernamePasswordRequest := req.(usernamePasswordRequest); isUsernamePasswordRequest {
+				username, password = r.GetUsername(), r.GetPassword()
+			}
+		}
+
+		if methodAuth.prometheus {
+			if err := s.prometheusAuth.Authenticate(ctx, username, password); err == nil {
+				return auth.WithPrometheus(ctx, username), nil
+			}
+		}
+
+		admin, err := s.adminAuth.AuthenticateAdmin(ctx, username, password)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "authenticate: %v", err)
+		}
+
+		if admin.Role < methodAuth.role {
+			return nil, status.Errorf(codes.PermissionDenied, "%s has role %s, requires %s", admin.Name, admin.Role, methodAuth.role)
+		}
+
+		return auth.WithAdmin(ctx, admin), nil
+	}
+
+	return nil, status.Errorf(codes.Internal, "unknown principal for method %s", method)
+}
@@ -0,0 +1,258 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/api"
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/passwordhash"
+	"github.com/nais/device/internal/tlsconfig"
+	"github.com/nais/device/internal/tlsconfig/tlsconfigtest"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestInterceptorsAuthenticateSessions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadSessionInfo(mock.Anything, "session-key").Return(&pb.Session{
+		Key:      "session-key",
+		ObjectID: "user-id",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   &pb.Device{Id: 1},
+	}, nil).Once()
+	db.EXPECT().ReadSessionInfo(mock.Anything, "expired-key").Return(&pb.Session{
+		Key:      "expired-key",
+		ObjectID: "other-user-id",
+		Expiry:   timestamppb.New(time.Now().Add(-time.Hour)),
+		Device:   &pb.Device{Id: 2},
+	}, nil).Once()
+	db.EXPECT().ReadSessionInfo(mock.Anything, "unknown-key").Return(nil, sql.ErrNoRows)
+	db.EXPECT().GetAcceptedAt(mock.Anything, "user-id").Return(timestamppb.Now(), nil).Twice()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, nil, nil, auth.NewSessionStore(db), nil, false)
+	client := serveAPIServer(t, server)
+
+	_, err := client.GetAcceptableUseAcceptedAt(pb.WithSessionKey(ctx, "session-key"), &pb.GetAcceptableUseAcceptedAtRequest{})
+	assert.NoError(t, err)
+
+	// clients that do not send metadata yet
+	_, err = client.GetAcceptableUseAcceptedAt(ctx, &pb.GetAcceptableUseAcceptedAtRequest{SessionKey: "session-key"})
+	assert.NoError(t, err)
+
+	_, err = client.GetAcceptableUseAcceptedAt(pb.WithSessionKey(ctx, "unknown-key"), &pb.GetAcceptableUseAcceptedAtRequest{SessionKey: "session-key"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetAcceptableUseAcceptedAt(pb.WithSessionKey(ctx, "expired-key"), &pb.GetAcceptableUseAcceptedAtRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetAcceptableUseAcceptedAt(ctx, &pb.GetAcceptableUseAcceptedAtRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestInterceptorsAuthenticateAdminsAndPrometheus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{{Name: "gateway"}}, nil).Times(3)
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	adminAuth := auth.NewAdminAuthenticator(map[string]auth.AdminKey{}, nil, nil)
+	prometheusAuth := auth.NewAPIKeyAuthenticator(map[string]string{"prometheus": "secret"})
+	server := api.NewGRPCServer(ctx, log, db, nil, adminAuth, nil, prometheusAuth, nil, nil, false)
+	client := serveAPIServer(t, server)
+
+	listGateways := func(ctx context.Context, request *pb.ListGatewayRequest) error {
+		stream, err := client.ListGateways(ctx, request)
+		if err != nil {
+			return err
+		}
+		_, err = stream.Recv()
+		return err
+	}
+
+	assert.NoError(t, listGateways(withBasicAuth(ctx, "prometheus", "secret"), &pb.ListGatewayRequest{}))
+
+	// clients that do not send metadata yet
+	assert.NoError(t, listGateways(ctx, &pb.ListGatewayRequest{Username: "prometheus", Password: "secret"}))
+
+	// metadata takes precedence over the request
+	err := listGateways(withBasicAuth(ctx, "prometheus", "wrong"), &pb.ListGatewayRequest{Username: "prometheus", Password: "secret"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// prometheus may only list gateways
+	_, err = client.ListDevices(withBasicAuth(ctx, "prometheus", "secret"), &pb.ListDevicesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	adminServer := api.NewGRPCServer(ctx, log, db, nil, auth.NewMockAdminAuthenticator(), nil, prometheusAuth, nil, nil, false)
+	adminClient := serveAPIServer(t, adminServer)
+
+	stream, err := adminClient.ListGateways(withBasicAuth(ctx, "admin", "password"), &pb.ListGatewayRequest{})
+	assert.NoError(t, err)
+	gateway, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "gateway", gateway.GetName())
+}
+
+func TestInterceptorsAuthenticateGatewayPasswords(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	salt := []byte("salt")
+	hash := string(passwordhash.FormatHash(passwordhash.HashPassword([]byte("secret"), salt), salt))
+	keys, err := auth.ParseAdminKeys([]string{"admin:" + hash})
+	assert.NoError(t, err)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadGateway(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, name string) (*pb.Gateway, error) {
+		return &pb.Gateway{Name: name}, nil
+	})
+	db.EXPECT().GetAcceptances(mock.Anything).Return(map[string]struct{}{}, nil).Maybe()
+
+	sessionStore := auth.NewMockSessionStore(t)
+	sessionStore.EXPECT().All().Return(nil).Maybe()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	gatewayAuth := auth.NewAPIKeyAuthenticator(map[string]string{"gateway": "secret", "old-gateway": "secret"})
+	server := api.NewGRPCServer(ctx, log, db, nil, auth.NewAdminAuthenticator(keys, nil, nil), gatewayAuth, nil, sessionStore, nil, false)
+	client := serveAPIServer(t, server)
+
+	getGatewayConfiguration := func(ctx context.Context, request *pb.GetGatewayConfigurationRequest) error {
+		stream, err := client.GetGatewayConfiguration(ctx, request)
+		if err != nil {
+			return err
+		}
+		_, err = stream.Recv()
+		return err
+	}
+
+	assert.NoError(t, getGatewayConfiguration(withBasicAuth(ctx, "gateway", "secret"), &pb.GetGatewayConfigurationRequest{}))
+
+	// gateway-agents that do not send metadata yet
+	assert.NoError(t, getGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "old-gateway", Password: "secret"}))
+
+	err = getGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{Gateway: "old-gateway", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = getGatewayConfiguration(withBasicAuth(ctx, "gateway", "wrong"), &pb.GetGatewayConfigurationRequest{Gateway: "gateway", Password: "secret"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// gateway administration with the password in the request
+	gateway, err := client.GetGateway(ctx, &pb.ModifyGatewayRequest{Username: "admin", Password: "secret", Gateway: &pb.Gateway{Name: "gateway"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "gateway", gateway.GetName())
+
+	_, err = client.GetGateway(ctx, &pb.ModifyGatewayRequest{Username: "admin", Password: "wrong", Gateway: &pb.Gateway{Name: "gateway"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestInterceptorsAuthenticateClientCertificates(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ca := tlsconfigtest.NewCA(t)
+	serverFiles := ca.Issue(t, "apiserver")
+	certificates, err := tlsconfig.NewReloader(serverFiles.CertFile, serverFiles.KeyFile, ca.File)
+	assert.NoError(t, err)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadDevices(mock.Anything).Return([]*pb.Device{{Id: 1}}, nil).Once()
+	db.EXPECT().ReadGateway(mock.Anything, "unknown-gateway").Return(nil, sql.ErrNoRows).Once()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	adminAuth := auth.NewAdminAuthenticator(nil, nil, map[string]auth.Role{"viewer": auth.RoleViewer})
+	server := api.NewGRPCServer(ctx, log, db, nil, adminAuth, auth.NewAPIKeyAuthenticator(nil), nil, nil, nil, false)
+
+	s := grpc.NewServer(append(server.ServerOptions(), grpc.Creds(credentials.NewTLS(certificates.ServerConfig())))...)
+	pb.RegisterAPIServerServer(s, server)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+	t.Cleanup(s.Stop)
+
+	dial := func(commonName string) pb.APIServerClient {
+		files := ca.Issue(t, commonName)
+		transportCredentials, err := tlsconfig.Client{
+			CAFile:   ca.File,
+			CertFile: files.CertFile,
+			KeyFile:  files.KeyFile,
+		}.TransportCredentials()
+		assert.NoError(t, err)
+
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(transportCredentials))
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		return pb.NewAPIServerClient(conn)
+	}
+
+	viewer := dial("viewer")
+	_, err = viewer.ListDevices(ctx, &pb.ListDevicesRequest{})
+	assert.NoError(t, err)
+
+	_, err = viewer.DeleteDevice(ctx, &pb.DeleteDeviceRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// certificates without a role are not admins
+	_, err = dial("someone").ListDevices(ctx, &pb.ListDevicesRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// gateways authenticate with certificates named after them
+	stream, err := dial("unknown-gateway").GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func serveAPIServer(t *testing.T, server interface {
+	pb.APIServerServer
+	ServerOptions() []grpc.ServerOption
+},
+) pb.APIServerClient {
+	s := grpc.NewServer(server.ServerOptions()...)
+	pb.RegisterAPIServerServer(s, server)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		err := s.Serve(lis)
+		assert.NoError(t, err)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(contextBufDialer(lis)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewAPIServerClient(conn)
+}
+
+func withBasicAuth(ctx context.Context, username, password string) context.Context {
+	credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Basic "+credentials)
+}
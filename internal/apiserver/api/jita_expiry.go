@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/metrics"
+	"github.com/nais/device/pkg/pb"
+)
+
+const (
+	// jitaExpiryIdleInterval is how long the scheduler sleeps when there are no active grants.
+	// Any change to the set of active grants wakes it up immediately, so this is only a safety net.
+	jitaExpiryIdleInterval = 5 * time.Minute
+	// jitaExpiryRetryInterval is how long the scheduler waits before retrying after a database error.
+	jitaExpiryRetryInterval = 10 * time.Second
+	// jitaExpiryMinWait prevents the scheduler from spinning if the database still reports a grant as active
+	// right at its expiry time.
+	jitaExpiryMinWait = 100 * time.Millisecond
+)
+
+// jitaExpiry keeps track of expired JITA grants that have not yet been delivered to their gateway,
+// so that the delay between a grant expiring and the peer being removed can be measured.
+type jitaExpiry struct {
+	reschedule chan struct{}
+
+	lock    sync.Mutex
+	expired map[string]time.Time
+}
+
+func newJitaExpiry() *jitaExpiry {
+	return &jitaExpiry{
+		reschedule: make(chan struct{}, 1),
+		expired:    make(map[string]time.Time),
+	}
+}
+
+// markExpired records that a grant for gateway expired at the given time.
+// Only the earliest undelivered expiry per gateway is kept.
+func (e *jitaExpiry) markExpired(gateway string, expires time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if t, ok := e.expired[gateway]; ok && t.Before(expires) {
+		return
+	}
+	e.expired[gateway] = expires
+}
+
+// delivered is called when a gateway has received a configuration built at the given time.
+// If the configuration was built after a pending expiry, the delay is reported.
+func (e *jitaExpiry) delivered(gateway string, built time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	expires, ok := e.expired[gateway]
+	if !ok || built.Before(expires) {
+		return
+	}
+	delete(e.expired, gateway)
+	metrics.ObserveJitaExpiryDelay(time.Since(expires))
+}
+
+// forget drops any pending expiry for gateway, e.g. when it reconnects and receives a fresh configuration anyway.
+func (e *jitaExpiry) forget(gateway string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	delete(e.expired, gateway)
+}
+
+// rescheduleJitaExpiry makes the scheduler re-read active grants, and must be called whenever a grant is
+// created, approved or revoked.
+func (s *grpcServer) rescheduleJitaExpiry() {
+	select {
+	case s.jitaExpiry.reschedule <- struct{}{}:
+	default:
+	}
+}
+
+// RunJitaExpiryScheduler triggers a gateway configuration update at the exact time a JITA grant for the gateway expires.
+// It blocks until ctx is done.
+func (s *grpcServer) RunJitaExpiryScheduler(ctx context.Context) {
+	log := s.log.WithField("component", "jita-expiry")
+	known := make(map[int64]*pb.GatewayJitaGrant)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-s.jitaExpiry.reschedule:
+		}
+
+		wait := jitaExpiryIdleInterval
+		next, err := s.expireJitaGrants(ctx, known, time.Now())
+		if err != nil {
+			log.WithError(err).Error("expire jita grants")
+			wait = jitaExpiryRetryInterval
+		} else if !next.IsZero() {
+			wait = max(time.Until(next), jitaExpiryMinWait)
+		}
+
+		timer.Reset(wait)
+	}
+}
+
+// expireJitaGrants compares the currently active grants with the ones known from the previous run, and triggers the
+// gateways of grants that have expired since then. known is updated in place. The earliest expiry among the active
+// grants is returned, or the zero time if there are none.
+func (s *grpcServer) expireJitaGrants(ctx context.Context, known map[int64]*pb.GatewayJitaGrant, now time.Time) (time.Time, error) {
+	grants, err := s.db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{ActiveOnly: true})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	active := make(map[int64]*pb.GatewayJitaGrant, len(grants))
+	var next time.Time
+	for _, grant := range grants {
+		active[grant.GetId()] = grant
+		if expires := grant.GetExpires().AsTime(); next.IsZero() || expires.Before(next) {
+			next = expires
+		}
+	}
+
+	for id, grant := range known {
+		if _, ok := active[id]; ok {
+			continue
+		}
+		delete(known, id)
+
+		// grants that disappear before their expiry have been revoked, which triggers the gateway on its own
+		if expires := grant.GetExpires().AsTime(); !expires.After(now) {
+			s.log.WithField("grantId", id).WithField("gateway", grant.GetGateway()).Debug("gateway jita grant expired")
+			s.jitaExpiry.markExpired(grant.GetGateway(), expires)
+			s.gateways.Trigger(grant.GetGateway())
+		}
+	}
+
+	for id, grant := range active {
+		known[id] = grant
+	}
+
+	return next, nil
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+)
+
+const (
+	// sessionDeadlineIdleInterval is how long the scheduler sleeps when no session has an upcoming deadline.
+	// Any change to sessions or device issues wakes it up immediately, so this is only a safety net.
+	sessionDeadlineIdleInterval = 5 * time.Minute
+	// sessionDeadlineMinWait prevents the scheduler from spinning on a deadline that has just passed.
+	sessionDeadlineMinWait = 100 * time.Millisecond
+)
+
+// rescheduleSessionDeadlines makes the scheduler look for the next deadline again, and must be called whenever
+// sessions or the issues of their devices change.
+func (s *grpcServer) rescheduleSessionDeadlines() {
+	select {
+	case s.sessionDeadlines <- struct{}{}:
+	default:
+	}
+}
+
+// RunSessionDeadlineScheduler triggers all device and gateway configuration updates at the exact time a session
+// expires, a device issue passes its grace period, or an exemption for an issue expires, as each of these changes
+// which devices the gateways let through. It blocks until ctx is done.
+func (s *grpcServer) RunSessionDeadlineScheduler(ctx context.Context) {
+	log := s.log.WithField("component", "session-deadlines")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			s.devices.TriggerAll()
+			s.gateways.TriggerAll()
+		case <-s.sessionDeadlines:
+		}
+
+		wait := sessionDeadlineIdleInterval
+		if next := nextSessionDeadline(s.sessionStore.All(), time.Now()); !next.IsZero() {
+			log.WithField("deadline", next).Debug("next session deadline")
+			wait = min(max(time.Until(next), sessionDeadlineMinWait), sessionDeadlineIdleInterval)
+		}
+
+		timer.Reset(wait)
+	}
+}
+
+// nextSessionDeadline returns the earliest time after now at which one of the sessions expires, or the health of its
+// device changes. The zero time is returned if there is none.
+func nextSessionDeadline(sessions []*pb.Session, now time.Time) time.Time {
+	var next time.Time
+	consider := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for _, session := range sessions {
+		consider(session.GetExpiry().AsTime())
+
+		for _, issue := range session.GetDevice().GetIssues() {
+			consider(issue.GetResolveBefore().AsTime())
+
+			if exemption := issue.GetExemption(); exemption != nil && exemption.GetRevoked() == nil {
+				consider(exemption.GetExpires().AsTime())
+			}
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/graph"
+	"github.com/sirupsen/logrus"
+)
+
+// auditActorGroupRefresh is the actor recorded in the audit log for sessions revoked by the group refresh.
+const auditActorGroupRefresh = "group-refresh"
+
+// RefreshSessionGroups checks the groups of active sessions against the identity provider, so that users removed from a group
+// lose access to its gateways before their session expires, and users added to a group get access without logging in again.
+// Devices with changed groups, and gateways for the changed groups, get new configuration.
+// Sessions of users deleted from the identity provider are revoked once the user is not found by two refreshes in a row,
+// so that a single spurious not found response does not log users out.
+func (s *grpcServer) RefreshSessionGroups(ctx context.Context) error {
+	if s.groupMembership == nil {
+		return nil
+	}
+
+	s.usersNotFoundLock.Lock()
+	defer s.usersNotFoundLock.Unlock()
+
+	var errs []error
+	memberGroups := make(map[string][]string)
+	failed := make(map[string]bool)
+	changedGroups := make(map[string]bool)
+	notFound := make(map[string]bool)
+
+	for _, session := range s.sessionStore.All() {
+		objectID := session.GetObjectID()
+		if failed[objectID] {
+			continue
+		}
+
+		groups, ok := memberGroups[objectID]
+		if !ok {
+			var err error
+			groups, err = s.groupMembership.MemberGroups(ctx, objectID)
+			if errors.Is(err, graph.ErrUserNotFound) {
+				failed[objectID] = true
+				notFound[objectID] = true
+				if !s.usersNotFound[objectID] {
+					s.log.WithField("objectId", objectID).Warn("user not found in the identity provider, revoking sessions if still not found on the next refresh")
+					continue
+				}
+				// the remaining sessions of the user are revoked along with this one
+				if err := s.revokeDeletedUserSessions(ctx, objectID); err != nil {
+					errs = append(errs, err)
+				}
+				continue
+			} else if err != nil {
+				failed[objectID] = true
+				errs = append(errs, fmt.Errorf("get groups for %s: %w", objectID, err))
+				continue
+			}
+			slices.Sort(groups)
+			memberGroups[objectID] = groups
+		}
+
+		current := slices.Sorted(slices.Values(session.GetGroups()))
+		if slices.Equal(current, groups) {
+			continue
+		}
+
+		if err := s.sessionStore.UpdateGroups(ctx, session.GetKey(), groups); err != nil {
+			errs = append(errs, fmt.Errorf("update groups for device %d: %w", session.GetDevice().GetId(), err))
+			continue
+		}
+
+		added, removed := groupChanges(current, groups)
+		for _, group := range append(added, removed...) {
+			changedGroups[group] = true
+		}
+
+		s.log.WithFields(logrus.Fields{
+			"deviceId": session.GetDevice().GetId(),
+			"added":    added,
+			"removed":  removed,
+		}).Info("session groups changed")
+
+		s.devices.Trigger(session.GetDevice().GetId())
+	}
+	s.usersNotFound = notFound
+
+	if len(changedGroups) > 0 {
+		gateways, err := s.db.ReadGateways(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("read gateways: %w", err))
+		}
+
+		for _, gateway := range gateways {
+			if slices.ContainsFunc(gateway.GetAccessGroupIDs(), func(group string) bool { return changedGroups[group] }) {
+				s.gateways.Trigger(gateway.GetName())
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// revokeDeletedUserSessions revokes the sessions of a user deleted from the identity provider, which ends their device streams.
+func (s *grpcServer) revokeDeletedUserSessions(ctx context.Context, objectID string) error {
+	revoked, err := s.sessionStore.RevokeByObjectID(ctx, objectID)
+	if err != nil {
+		return fmt.Errorf("revoke sessions for deleted user %s: %w", objectID, err)
+	}
+
+	for _, session := range revoked {
+		s.log.WithField("deviceId", session.GetDevice().GetId()).WithField("user", session.GetDevice().GetUsername()).Info("session revoked, user deleted from the identity provider")
+		s.audit(ctx, auditActorGroupRefresh, database.AuditActionSessionRevoke, deviceTarget(session.GetDevice().GetId()), "user deleted from the identity provider")
+		// closing the trigger ends the configuration stream with an invalid session status
+		s.devices.Remove(session.GetDevice().GetId())
+	}
+
+	if len(revoked) > 0 {
+		s.SendAllGatewayConfigurations()
+	}
+
+	return nil
+}
+
+// groupChanges returns the groups added and removed going from one sorted list of groups to another.
+func groupChanges(from, to []string) (added, removed []string) {
+	for _, group := range to {
+		if _, found := slices.BinarySearch(from, group); !found {
+			added = append(added, group)
+		}
+	}
+
+	for _, group := range from {
+		if _, found := slices.BinarySearch(to, group); !found {
+			removed = append(removed, group)
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,112 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/api"
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/graph"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRefreshSessionGroups(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	expiry := timestamppb.New(time.Now().Add(auth.SessionDuration))
+	session := func(key, objectID string, deviceID int64) *pb.Session {
+		return &pb.Session{
+			Key:      key,
+			ObjectID: objectID,
+			Groups:   []string{"group-1"},
+			Expiry:   expiry,
+			Device:   &pb.Device{Id: deviceID},
+		}
+	}
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadSessionInfos(mock.Anything).Return([]*pb.Session{
+		session("added-key", "added-user", 1),
+		session("unchanged-key", "unchanged-user", 2),
+		session("deleted-key", "deleted-user", 3),
+	}, nil)
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(1)).Return(&pb.Device{Id: 1}, nil)
+	db.EXPECT().ReadDeviceByID(mock.Anything, int64(3)).Return(&pb.Device{Id: 3}, nil)
+	db.EXPECT().ReadGateways(mock.Anything).RunAndReturn(func(context.Context) ([]*pb.Gateway, error) {
+		return []*pb.Gateway{
+			{Name: "gateway-1", AccessGroupIDs: []string{"group-1"}},
+			{Name: "gateway-2", AccessGroupIDs: []string{"group-2"}},
+		}, nil
+	})
+	db.EXPECT().UpdateSessionGroups(mock.Anything, "added-key", []string{"group-1", "group-2"}).Return(nil).Once()
+	db.EXPECT().RemoveSessionsForUser(mock.Anything, "deleted-user").Return(nil).Once()
+	db.EXPECT().AddAuditEvent(mock.Anything, "group-refresh", database.AuditActionSessionRevoke, "device:3", mock.Anything).Return(nil).Once()
+
+	sessionStore := auth.NewSessionStore(db)
+	require.NoError(t, sessionStore.Reload(ctx))
+
+	groupMembership := graph.NewFakeClient()
+	groupMembership.SetGroups("added-user", "group-2", "group-1")
+	groupMembership.SetGroups("unchanged-user", "group-1")
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	server := api.NewGRPCServer(ctx, log, db, nil, nil, nil, nil, sessionStore, nil, false, api.WithGroupMembership(groupMembership))
+	client := serveAPIServer(t, server)
+
+	stream, err := client.GetDeviceConfiguration(pb.WithSessionKey(ctx, "added-key"), &pb.GetDeviceConfigurationRequest{})
+	require.NoError(t, err)
+
+	gatewayNames := func() []string {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+
+		var names []string
+		for _, gateway := range resp.GetGateways() {
+			names = append(names, gateway.GetName())
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"gateway-1"}, gatewayNames())
+
+	deletedStream, err := client.GetDeviceConfiguration(pb.WithSessionKey(ctx, "deleted-key"), &pb.GetDeviceConfigurationRequest{})
+	require.NoError(t, err)
+	_, err = deletedStream.Recv()
+	require.NoError(t, err)
+
+	require.NoError(t, server.RefreshSessionGroups(ctx))
+
+	// the device stream gets the gateways of the new group without logging in again
+	assert.Equal(t, []string{"gateway-1", "gateway-2"}, gatewayNames())
+
+	// the deleted user keeps the session until it is not found on the next refresh as well
+	assert.Len(t, sessionStore.All(), 3)
+	db.AssertNotCalled(t, "RemoveSessionsForUser", mock.Anything, "deleted-user")
+
+	require.NoError(t, server.RefreshSessionGroups(ctx))
+
+	// the session of the deleted user is revoked, which ends its stream
+	resp, err := deletedStream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pb.DeviceConfigurationStatus_InvalidSession, resp.GetStatus())
+
+	groups := make(map[string][]string)
+	for _, session := range sessionStore.All() {
+		groups[session.GetKey()] = session.GetGroups()
+	}
+	assert.Equal(t, map[string][]string{
+		"added-key":     {"group-1", "group-2"},
+		"unchanged-key": {"group-1"},
+	}, groups)
+
+	// nothing is updated when the groups have not changed
+	require.NoError(t, server.RefreshSessionGroups(ctx))
+}
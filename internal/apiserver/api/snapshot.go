@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// snapshotChunkSize is the size of each message in a database snapshot stream, well below the default gRPC message size limit.
+const snapshotChunkSize = 256 * 1024
+
+func (s *grpcServer) SnapshotDatabase(r *pb.SnapshotDatabaseRequest, stream pb.APIServer_SnapshotDatabaseServer) error {
+	ctx := stream.Context()
+
+	admin := auth.AdminFromContext(ctx)
+
+	dir, err := os.MkdirTemp("", "naisdevice-snapshot")
+	if err != nil {
+		return status.Errorf(codes.Internal, "create snapshot directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snapshot.db")
+	err = s.db.Snapshot(ctx, path)
+	if errors.Is(err, database.ErrSnapshotNotSupported) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "snapshot database: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return status.Errorf(codes.Internal, "open snapshot: %v", err)
+	}
+	defer f.Close()
+
+	s.audit(ctx, admin.Name, database.AuditActionDatabaseSnapshot, "database", "")
+
+	buf := make([]byte, snapshotChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.SnapshotDatabaseResponse{Data: buf[:n]}); err != nil {
+				return status.Error(codes.Aborted, err.Error())
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "read snapshot: %v", err)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nais/device/internal/passwordhash"
+	"github.com/nais/device/internal/token"
+	"google.golang.org/grpc/metadata"
+)
+
+// Role is what an admin may do through the control plane. Every role includes the roles below it.
+type Role int
+
+const (
+	RoleNone Role = iota
+	// RoleViewer may read gateways, devices, sessions, grants and the audit log.
+	RoleViewer
+	// RoleOperator may additionally change gateways, sessions, grants and exemptions.
+	RoleOperator
+	// RoleAdmin may additionally delete gateways and devices, and snapshot the database.
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:     "none",
+	RoleViewer:   "viewer",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+func ParseRole(name string) (Role, error) {
+	for role, roleName := range roleNames {
+		if role != RoleNone && strings.EqualFold(name, roleName) {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown role %q, must be one of viewer, operator or admin", name)
+}
+
+// Admin is an authenticated user of the control plane.
+type Admin struct {
+	// Name identifies the admin in logs and the audit log, the email address for OIDC tokens and the username for static keys.
+	Name string
+	Role Role
+}
+
+var ErrNoAdminRole = errors.New("user has no admin role")
+
+// AdminAuthenticator authenticates control plane requests.
+type AdminAuthenticator interface {
+	// AuthenticateAdmin authenticates with a bearer token in the request metadata if there is one,
+	// then with a client certificate if the client presented a known one, and with the username and password otherwise.
+	AuthenticateAdmin(ctx context.Context, username, password string) (*Admin, error)
+}
+
+// AdminKey is a static admin credential.
+type AdminKey struct {
+	PasswordHash string
+	Role         Role
+}
+
+type adminAuthenticator struct {
+	keys             map[string]AdminKey
+	parsers          []token.Parser
+	groupRoles       map[string]Role
+	certificateRoles map[string]Role
+}
+
+// NewAdminAuthenticator authenticates admins with static keys, with client certificates whose common name is in certificateRoles,
+// and with OIDC tokens accepted by any of parsers. Token users get the highest role mapped from their groups in groupRoles.
+func NewAdminAuthenticator(keys map[string]AdminKey, groupRoles, certificateRoles map[string]Role, parsers ...token.Parser) AdminAuthenticator {
+	return &adminAuthenticator{
+		keys:             keys,
+		parsers:          parsers,
+		groupRoles:       groupRoles,
+		certificateRoles: certificateRoles,
+	}
+}
+
+func (a *adminAuthenticator) AuthenticateAdmin(ctx context.Context, username, password string) (*Admin, error) {
+	if bearer, ok := BearerToken(ctx); ok {
+		return a.authenticateToken(bearer)
+	}
+
+	if certificate, ok := ClientCertificate(ctx); ok {
+		if role, ok := a.certificateRoles[certificate.Subject.CommonName]; ok {
+			return &Admin{Name: certificate.Subject.CommonName, Role: role}, nil
+		}
+	}
+
+	key, ok := a.keys[username]
+	if !ok || len(password) == 0 {
+		return nil, ErrInvalidAuth
+	}
+
+	if err := passwordhash.Validate([]byte(password), []byte(key.PasswordHash)); err != nil {
+		return nil, ErrInvalidAuth
+	}
+
+	return &Admin{Name: username, Role: key.Role}, nil
+}
+
+func (a *adminAuthenticator) authenticateToken(bearer string) (*Admin, error) {
+	if len(a.parsers) == 0 {
+		return nil, fmt.Errorf("token authentication is not enabled")
+	}
+
+	var errs []error
+	for _, parser := range a.parsers {
+		user, err := parser.ParseString(bearer)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		role := RoleNone
+		for _, group := range user.Groups {
+			role = max(role, a.groupRoles[group])
+		}
+		if role == RoleNone {
+			return nil, fmt.Errorf("%s: %w", user.Email, ErrNoAdminRole)
+		}
+
+		return &Admin{Name: user.Email, Role: role}, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// BearerToken returns the bearer token from the authorization metadata of an incoming request.
+func BearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get("authorization") {
+		if bearer, ok := strings.CutPrefix(value, "Bearer "); ok && bearer != "" {
+			return bearer, true
+		}
+	}
+
+	return "", false
+}
+
+// ParseAdminKeys parses static admin credentials on the format 'username:passwordhash[:role]'.
+// Password hashes are created with 'controlplane-cli passhash', and keys without a role get RoleAdmin.
+func ParseAdminKeys(entries []string) (map[string]AdminKey, error) {
+	keys := make(map[string]AdminKey)
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid admin credential, should be on format 'username:passwordhash[:role]'")
+		}
+
+		if !strings.HasPrefix(parts[1], "$1$") {
+			return nil, fmt.Errorf("admin credential for %q is not a password hash, create one with 'controlplane-cli passhash'", parts[0])
+		}
+
+		key := AdminKey{PasswordHash: parts[1], Role: RoleAdmin}
+		if len(parts) == 3 {
+			role, err := ParseRole(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("admin credential for %q: %w", parts[0], err)
+			}
+			key.Role = role
+		}
+
+		keys[parts[0]] = key
+	}
+
+	return keys, nil
+}
+
+// ParseGroupRoles parses a mapping from group claims to roles on the format 'group:role'.
+func ParseGroupRoles(entries []string) (map[string]Role, error) {
+	return parseRoles(entries, "group")
+}
+
+// ParseCertificateRoles parses a mapping from client certificate common names to roles on the format 'commonName:role'.
+func ParseCertificateRoles(entries []string) (map[string]Role, error) {
+	return parseRoles(entries, "commonName")
+}
+
+func parseRoles(entries []string, subject string) (map[string]Role, error) {
+	roles := make(map[string]Role)
+	for _, entry := range entries {
+		name, roleName, ok := strings.Cut(entry, ":")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid admin role %q, should be on format '%s:role'", entry, subject)
+		}
+
+		role, err := ParseRole(roleName)
+		if err != nil {
+			return nil, fmt.Errorf("admin %s %q: %w", subject, name, err)
+		}
+		roles[name] = role
+	}
+
+	return roles, nil
+}
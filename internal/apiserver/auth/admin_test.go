@@ -0,0 +1,107 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/nais/device/internal/apiserver/auth"
+	"github.com/nais/device/internal/passwordhash"
+	"github.com/nais/device/internal/token"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type staticParser map[string]*token.User
+
+func (p staticParser) ParseString(str string) (*token.User, error) {
+	if user, ok := p[str]; ok {
+		return user, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+func (p staticParser) ParseHeader(http.Header, string) (*token.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestAdminAuthenticator(t *testing.T) {
+	salt := []byte("salt")
+	hash := string(passwordhash.FormatHash(passwordhash.HashPassword([]byte("secret"), salt), salt))
+
+	keys, err := auth.ParseAdminKeys([]string{"admin:" + hash, "grafana:" + hash + ":viewer"})
+	assert.NoError(t, err)
+
+	groupRoles, err := auth.ParseGroupRoles([]string{"ops-group:operator", "admin-group:admin"})
+	assert.NoError(t, err)
+
+	parser := staticParser{
+		"operator-token": {Email: "operator@example.com", Groups: []string{"allUsers", "ops-group"}},
+		"admin-token":    {Email: "admin@example.com", Groups: []string{"ops-group", "admin-group"}},
+		"user-token":     {Email: "user@example.com", Groups: []string{"allUsers"}},
+	}
+	certificateRoles, err := auth.ParseCertificateRoles([]string{"deploy-bot:operator"})
+	assert.NoError(t, err)
+
+	authenticator := auth.NewAdminAuthenticator(keys, groupRoles, certificateRoles, parser)
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	withCertificate := func(commonName string) context.Context {
+		certificate := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{certificate}}}},
+		})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		username string
+		password string
+		want     *auth.Admin
+	}{
+		{"static key", context.Background(), "admin", "secret", &auth.Admin{Name: "admin", Role: auth.RoleAdmin}},
+		{"static key with role", context.Background(), "grafana", "secret", &auth.Admin{Name: "grafana", Role: auth.RoleViewer}},
+		{"wrong password", context.Background(), "admin", "wrong", nil},
+		{"unknown user", context.Background(), "unknown", "secret", nil},
+		{"token", withToken("operator-token"), "", "", &auth.Admin{Name: "operator@example.com", Role: auth.RoleOperator}},
+		{"token with several roles", withToken("admin-token"), "", "", &auth.Admin{Name: "admin@example.com", Role: auth.RoleAdmin}},
+		{"token without admin groups", withToken("user-token"), "", "", nil},
+		{"invalid token ignores static key", withToken("invalid"), "admin", "secret", nil},
+		{"client certificate", withCertificate("deploy-bot"), "", "", &auth.Admin{Name: "deploy-bot", Role: auth.RoleOperator}},
+		{"client certificate without role", withCertificate("someone"), "", "", nil},
+		{"client certificate without role falls back to static key", withCertificate("someone"), "admin", "secret", &auth.Admin{Name: "admin", Role: auth.RoleAdmin}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin, err := authenticator.AuthenticateAdmin(tt.ctx, tt.username, tt.password)
+			if tt.want == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, admin)
+		})
+	}
+}
+
+func TestParseAdminKeys(t *testing.T) {
+	_, err := auth.ParseAdminKeys([]string{"admin:plaintext"})
+	assert.ErrorContains(t, err, "not a password hash")
+
+	_, err = auth.ParseAdminKeys([]string{"admin:$1$c2FsdA==$a2V5:superuser"})
+	assert.ErrorContains(t, err, "unknown role")
+
+	_, err = auth.ParseAdminKeys([]string{"admin"})
+	assert.Error(t, err)
+}
@@ -11,3 +11,14 @@ func NewMockAPIKeyAuthenticator() UsernamePasswordAuthenticator {
 func (a *mockApikeyAuthenticator) Authenticate(_ context.Context, username, password string) error {
 	return nil
 }
+
+type mockAdminAuthenticator struct{}
+
+// NewMockAdminAuthenticator authenticates every request as an admin with RoleAdmin.
+func NewMockAdminAuthenticator() AdminAuthenticator {
+	return &mockAdminAuthenticator{}
+}
+
+func (a *mockAdminAuthenticator) AuthenticateAdmin(_ context.Context, username, _ string) (*Admin, error) {
+	return &Admin{Name: username, Role: RoleAdmin}, nil
+}
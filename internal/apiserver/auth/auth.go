@@ -2,16 +2,62 @@ package auth
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/random"
+	"github.com/nais/device/internal/token"
 	"github.com/nais/device/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	SessionDuration = time.Hour * 10
+	// SessionRenewalWindow is how long before expiry devices are asked to renew their session
+	SessionRenewalWindow = time.Hour
 )
 
 type Authenticator interface {
 	Login(ctx context.Context, token, serial, platform string) (*pb.Session, error)
 	ValidateJita(session *pb.Session, token string) error
 }
+
+// SessionRenewer is implemented by authenticators of tenants where devices can get a fresh token without the user
+// logging in again. Google tenants have no refresh tokens, so their sessions are not renewed.
+type SessionRenewer interface {
+	// Renew replaces a session with a new session for the same user and device, given a fresh token for the user.
+	Renew(ctx context.Context, session *pb.Session, token string) (*pb.Session, error)
+}
+
+// renewSession replaces session with a new session for user, who must be the user of the session and own the device.
+// Groups are taken from the fresh token, so that changed group memberships take effect.
+func renewSession(ctx context.Context, db database.Database, store SessionStore, session *pb.Session, user *token.User) (*pb.Session, error) {
+	if !strings.EqualFold(user.ID, session.GetObjectID()) {
+		return nil, fmt.Errorf("token user ID (%s) does not match session user ID (%s)", user.ID, session.GetObjectID())
+	}
+
+	device, err := db.ReadDeviceByID(ctx, session.GetDevice().GetId())
+	if err != nil {
+		return nil, fmt.Errorf("read device %d, user: %s, err: %v", session.GetDevice().GetId(), user.Email, err)
+	}
+
+	if !strings.EqualFold(user.Email, device.Username) {
+		return nil, fmt.Errorf("username (%s) does not match device username (%s)", user.Email, device.Username)
+	}
+
+	renewed := &pb.Session{
+		Key:      random.RandomString(20, random.LettersAndNumbers),
+		Expiry:   timestamppb.New(time.Now().Add(SessionDuration)),
+		Groups:   user.Groups,
+		ObjectID: user.ID,
+		Device:   device,
+	}
+
+	if err := store.Renew(ctx, session, renewed); err != nil {
+		return nil, fmt.Errorf("persist session: %w", err)
+	}
+
+	return renewed, nil
+}
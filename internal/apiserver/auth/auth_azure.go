@@ -23,6 +23,8 @@ type azureAuth struct {
 	log   logrus.FieldLogger
 }
 
+var _ SessionRenewer = &azureAuth{}
+
 func NewAuthenticator(azure token.Parser, jita token.Parser, db database.Database, store SessionStore, log logrus.FieldLogger) Authenticator {
 	return &azureAuth{
 		db:    db,
@@ -77,3 +79,12 @@ func (s *azureAuth) Login(ctx context.Context, token, serial, platform string) (
 
 	return session, nil
 }
+
+func (s *azureAuth) Renew(ctx context.Context, session *pb.Session, token string) (*pb.Session, error) {
+	user, err := s.azure.ParseString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	return renewSession(ctx, s.db, s.store, session, user)
+}
@@ -25,6 +25,8 @@ type mockAuthenticator struct {
 	store SessionStore
 }
 
+var _ SessionRenewer = &mockAuthenticator{}
+
 // ValidateJita implements Authenticator.
 func (g *mockAuthenticator) ValidateJita(session *pb.Session, token string) error {
 	return fmt.Errorf("unimplemented for mock auth")
@@ -47,6 +49,23 @@ func (m *mockAuthenticator) Login(ctx context.Context, _, _, _ string) (*pb.Sess
 	return session, nil
 }
 
+func (m *mockAuthenticator) Renew(ctx context.Context, session *pb.Session, _ string) (*pb.Session, error) {
+	renewed := &pb.Session{
+		Key:      random.RandomString(20, random.LettersAndNumbers),
+		Expiry:   timestamppb.New(time.Now().Add(SessionDuration)),
+		Groups:   session.GetGroups(),
+		ObjectID: session.GetObjectID(),
+		Device:   session.GetDevice(),
+	}
+
+	err := m.store.Renew(ctx, session, renewed)
+	if err != nil {
+		return nil, err
+	}
+
+	return renewed, nil
+}
+
 func (m *mockAuthenticator) Validator() func(http.Handler) http.Handler {
 	// not used by current versions of device-agent.
 	return func(handler http.Handler) http.Handler {
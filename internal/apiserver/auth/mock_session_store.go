@@ -193,6 +193,313 @@ func (_c *MockSessionStore_RefreshDevice_Call) RunAndReturn(run func(device *pb.
 	return _c
 }
 
+// RemoveDevice provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) RemoveDevice(n int64) {
+	_mock.Called(n)
+	return
+}
+
+// MockSessionStore_RemoveDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDevice'
+type MockSessionStore_RemoveDevice_Call struct {
+	*mock.Call
+}
+
+// RemoveDevice is a helper method to define mock.On call
+//   - n int64
+func (_e *MockSessionStore_Expecter) RemoveDevice(n interface{}) *MockSessionStore_RemoveDevice_Call {
+	return &MockSessionStore_RemoveDevice_Call{Call: _e.mock.On("RemoveDevice", n)}
+}
+
+func (_c *MockSessionStore_RemoveDevice_Call) Run(run func(n int64)) *MockSessionStore_RemoveDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_RemoveDevice_Call) Return() *MockSessionStore_RemoveDevice_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSessionStore_RemoveDevice_Call) RunAndReturn(run func(n int64)) *MockSessionStore_RemoveDevice_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Renew provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) Renew(ctx context.Context, old *pb.Session, renewed *pb.Session) error {
+	ret := _mock.Called(ctx, old, renewed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renew")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.Session, *pb.Session) error); ok {
+		r0 = returnFunc(ctx, old, renewed)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStore_Renew_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Renew'
+type MockSessionStore_Renew_Call struct {
+	*mock.Call
+}
+
+// Renew is a helper method to define mock.On call
+//   - ctx context.Context
+//   - old *pb.Session
+//   - renewed *pb.Session
+func (_e *MockSessionStore_Expecter) Renew(ctx interface{}, old interface{}, renewed interface{}) *MockSessionStore_Renew_Call {
+	return &MockSessionStore_Renew_Call{Call: _e.mock.On("Renew", ctx, old, renewed)}
+}
+
+func (_c *MockSessionStore_Renew_Call) Run(run func(ctx context.Context, old *pb.Session, renewed *pb.Session)) *MockSessionStore_Renew_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *pb.Session
+		if args[1] != nil {
+			arg1 = args[1].(*pb.Session)
+		}
+		var arg2 *pb.Session
+		if args[2] != nil {
+			arg2 = args[2].(*pb.Session)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_Renew_Call) Return(err error) *MockSessionStore_Renew_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStore_Renew_Call) RunAndReturn(run func(ctx context.Context, old *pb.Session, renewed *pb.Session) error) *MockSessionStore_Renew_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByDeviceID provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) RevokeByDeviceID(context1 context.Context, n int64) ([]*pb.Session, error) {
+	ret := _mock.Called(context1, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByDeviceID")
+	}
+
+	var r0 []*pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]*pb.Session, error)); ok {
+		return returnFunc(context1, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []*pb.Session); ok {
+		r0 = returnFunc(context1, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(context1, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionStore_RevokeByDeviceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByDeviceID'
+type MockSessionStore_RevokeByDeviceID_Call struct {
+	*mock.Call
+}
+
+// RevokeByDeviceID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - n int64
+func (_e *MockSessionStore_Expecter) RevokeByDeviceID(context1 interface{}, n interface{}) *MockSessionStore_RevokeByDeviceID_Call {
+	return &MockSessionStore_RevokeByDeviceID_Call{Call: _e.mock.On("RevokeByDeviceID", context1, n)}
+}
+
+func (_c *MockSessionStore_RevokeByDeviceID_Call) Run(run func(context1 context.Context, n int64)) *MockSessionStore_RevokeByDeviceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByDeviceID_Call) Return(sessions []*pb.Session, err error) *MockSessionStore_RevokeByDeviceID_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByDeviceID_Call) RunAndReturn(run func(context1 context.Context, n int64) ([]*pb.Session, error)) *MockSessionStore_RevokeByDeviceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByKey provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) RevokeByKey(context1 context.Context, s string) ([]*pb.Session, error) {
+	ret := _mock.Called(context1, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByKey")
+	}
+
+	var r0 []*pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*pb.Session, error)); ok {
+		return returnFunc(context1, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*pb.Session); ok {
+		r0 = returnFunc(context1, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(context1, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionStore_RevokeByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByKey'
+type MockSessionStore_RevokeByKey_Call struct {
+	*mock.Call
+}
+
+// RevokeByKey is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+func (_e *MockSessionStore_Expecter) RevokeByKey(context1 interface{}, s interface{}) *MockSessionStore_RevokeByKey_Call {
+	return &MockSessionStore_RevokeByKey_Call{Call: _e.mock.On("RevokeByKey", context1, s)}
+}
+
+func (_c *MockSessionStore_RevokeByKey_Call) Run(run func(context1 context.Context, s string)) *MockSessionStore_RevokeByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByKey_Call) Return(sessions []*pb.Session, err error) *MockSessionStore_RevokeByKey_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByKey_Call) RunAndReturn(run func(context1 context.Context, s string) ([]*pb.Session, error)) *MockSessionStore_RevokeByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByObjectID provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) RevokeByObjectID(context1 context.Context, s string) ([]*pb.Session, error) {
+	ret := _mock.Called(context1, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByObjectID")
+	}
+
+	var r0 []*pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*pb.Session, error)); ok {
+		return returnFunc(context1, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*pb.Session); ok {
+		r0 = returnFunc(context1, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(context1, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionStore_RevokeByObjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByObjectID'
+type MockSessionStore_RevokeByObjectID_Call struct {
+	*mock.Call
+}
+
+// RevokeByObjectID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+func (_e *MockSessionStore_Expecter) RevokeByObjectID(context1 interface{}, s interface{}) *MockSessionStore_RevokeByObjectID_Call {
+	return &MockSessionStore_RevokeByObjectID_Call{Call: _e.mock.On("RevokeByObjectID", context1, s)}
+}
+
+func (_c *MockSessionStore_RevokeByObjectID_Call) Run(run func(context1 context.Context, s string)) *MockSessionStore_RevokeByObjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByObjectID_Call) Return(sessions []*pb.Session, err error) *MockSessionStore_RevokeByObjectID_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockSessionStore_RevokeByObjectID_Call) RunAndReturn(run func(context1 context.Context, s string) ([]*pb.Session, error)) *MockSessionStore_RevokeByObjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function for the type MockSessionStore
 func (_mock *MockSessionStore) Set(context1 context.Context, session *pb.Session) error {
 	ret := _mock.Called(context1, session)
@@ -249,3 +556,66 @@ func (_c *MockSessionStore_Set_Call) RunAndReturn(run func(context1 context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateGroups provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) UpdateGroups(ctx context.Context, key string, groups []string) error {
+	ret := _mock.Called(ctx, key, groups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroups")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, key, groups)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStore_UpdateGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroups'
+type MockSessionStore_UpdateGroups_Call struct {
+	*mock.Call
+}
+
+// UpdateGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - groups []string
+func (_e *MockSessionStore_Expecter) UpdateGroups(ctx interface{}, key interface{}, groups interface{}) *MockSessionStore_UpdateGroups_Call {
+	return &MockSessionStore_UpdateGroups_Call{Call: _e.mock.On("UpdateGroups", ctx, key, groups)}
+}
+
+func (_c *MockSessionStore_UpdateGroups_Call) Run(run func(ctx context.Context, key string, groups []string)) *MockSessionStore_UpdateGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_UpdateGroups_Call) Return(err error) *MockSessionStore_UpdateGroups_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStore_UpdateGroups_Call) RunAndReturn(run func(ctx context.Context, key string, groups []string) error) *MockSessionStore_UpdateGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"strings"
+
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type principalKey int
+
+const (
+	principalAdmin principalKey = iota
+	principalGateway
+	principalPrometheus
+	principalSession
+)
+
+func WithAdmin(ctx context.Context, admin *Admin) context.Context {
+	return context.WithValue(ctx, principalAdmin, admin)
+}
+
+// AdminFromContext returns the admin authenticated for the request, or nil.
+func AdminFromContext(ctx context.Context) *Admin {
+	admin, _ := ctx.Value(principalAdmin).(*Admin)
+	return admin
+}
+
+func WithGateway(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, principalGateway, name)
+}
+
+// GatewayFromContext returns the name of the gateway authenticated for the request.
+func GatewayFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(principalGateway).(string)
+	return name, ok
+}
+
+func WithPrometheus(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, principalPrometheus, username)
+}
+
+// PrometheusFromContext returns the username of the prometheus agent authenticated for the request.
+func PrometheusFromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(principalPrometheus).(string)
+	return username, ok
+}
+
+func WithSession(ctx context.Context, session *pb.Session) context.Context {
+	return context.WithValue(ctx, principalSession, session)
+}
+
+// SessionFromContext returns the device session authenticated for the request, or nil.
+func SessionFromContext(ctx context.Context) *pb.Session {
+	session, _ := ctx.Value(principalSession).(*pb.Session)
+	return session
+}
+
+// BasicAuth returns the username and password from basic authorization metadata of an incoming request.
+func BasicAuth(ctx context.Context) (username, password string, ok bool) {
+	for _, value := range incomingMetadata(ctx, "authorization") {
+		encoded, found := strings.CutPrefix(value, "Basic ")
+		if !found {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		if username, password, ok = strings.Cut(string(decoded), ":"); ok {
+			return username, password, true
+		}
+	}
+
+	return "", "", false
+}
+
+// SessionKey returns the session key from the metadata of an incoming request.
+func SessionKey(ctx context.Context) (string, bool) {
+	for _, key := range incomingMetadata(ctx, pb.MetadataSessionKey) {
+		if key != "" {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+// ClientCertificate returns the client certificate of an incoming request, if the client presented one that was verified
+// against the client CA of the server.
+func ClientCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0], true
+}
+
+func incomingMetadata(ctx context.Context, key string) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return md.Get(key)
+}
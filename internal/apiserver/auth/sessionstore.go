@@ -8,6 +8,7 @@ import (
 
 	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/pkg/pb"
+	"google.golang.org/protobuf/proto"
 )
 
 var ErrNoSession = errors.New("no active session")
@@ -25,8 +26,14 @@ type sessionStore struct {
 type SessionStore interface {
 	Get(context.Context, string) (*pb.Session, error)
 	Set(context.Context, *pb.Session) error
+	Renew(ctx context.Context, old, renewed *pb.Session) error
 	All() []*pb.Session
 	RefreshDevice(*pb.Device)
+	UpdateGroups(ctx context.Context, key string, groups []string) error
+	RemoveDevice(int64)
+	RevokeByKey(context.Context, string) ([]*pb.Session, error)
+	RevokeByDeviceID(context.Context, int64) ([]*pb.Session, error)
+	RevokeByObjectID(context.Context, string) ([]*pb.Session, error)
 }
 
 func NewSessionStore(db database.Database) *sessionStore {
@@ -89,24 +96,56 @@ func (store *sessionStore) Set(ctx context.Context, session *pb.Session) error {
 	return nil
 }
 
+// Renew replaces a session with a renewed session for the same device. The device keeps a session throughout,
+// so gateways keep its peer, and the old session key stops working.
+func (store *sessionStore) Renew(ctx context.Context, old, renewed *pb.Session) error {
+	if old.GetDevice().GetId() != renewed.GetDevice().GetId() {
+		return fmt.Errorf("renewed session is for device %d, not %d", renewed.GetDevice().GetId(), old.GetDevice().GetId())
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if err := store.db.RenewSessionInfo(ctx, old.GetKey(), renewed); err != nil {
+		return fmt.Errorf("store renewed session in database: %w", err)
+	}
+
+	delete(store.byKey, old.GetKey())
+	store.byKey[renewed.Key] = renewed
+	store.byDeviceID[renewed.Device.Id] = renewed
+
+	return nil
+}
+
 func (store *sessionStore) Warmup(ctx context.Context) error {
 	err := store.db.RemoveExpiredSessions(ctx)
 	if err != nil {
 		return err
 	}
 
+	return store.Reload(ctx)
+}
+
+// Reload replaces the cache with the sessions in the database.
+// A standby apiserver reloads periodically, so that its cache reflects logins and revocations made by the active instance.
+func (store *sessionStore) Reload(ctx context.Context) error {
 	sessions, err := store.db.ReadSessionInfos(ctx)
 	if err != nil {
 		return fmt.Errorf("warm cache from database: %w", err)
 	}
 
+	byKey := make(map[string]*pb.Session, len(sessions))
+	byDeviceID := make(map[int64]*pb.Session, len(sessions))
+	for _, session := range sessions {
+		byKey[session.Key] = session
+		byDeviceID[session.Device.Id] = session
+	}
+
 	store.lock.Lock()
 	defer store.lock.Unlock()
 
-	for _, session := range sessions {
-		store.byKey[session.Key] = session
-		store.byDeviceID[session.Device.Id] = session
-	}
+	store.byKey = byKey
+	store.byDeviceID = byDeviceID
 
 	return nil
 }
@@ -137,3 +176,82 @@ func (store *sessionStore) RefreshDevice(device *pb.Device) {
 		d.Device = device
 	}
 }
+
+// UpdateGroups replaces the access groups of a session, in the database and the cache.
+// The cached session is replaced rather than modified, as it may be read by streams without holding the lock.
+func (store *sessionStore) UpdateGroups(ctx context.Context, key string, groups []string) error {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	session, exists := store.byKey[key]
+	if !exists {
+		return ErrNoSession
+	}
+
+	if err := store.db.UpdateSessionGroups(ctx, key, groups); err != nil {
+		return fmt.Errorf("store session groups in database: %w", err)
+	}
+
+	updated := proto.Clone(session).(*pb.Session)
+	updated.Groups = groups
+	store.byKey[key] = updated
+	store.byDeviceID[updated.GetDevice().GetId()] = updated
+
+	return nil
+}
+
+// RemoveDevice evicts any cached session for a device that has been removed from the database.
+func (store *sessionStore) RemoveDevice(deviceID int64) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	store.deleteSessionsForDeviceIDWithAssumedLock(deviceID)
+}
+
+// RevokeByKey deletes a single session from the database and the cache.
+func (store *sessionStore) RevokeByKey(ctx context.Context, key string) ([]*pb.Session, error) {
+	return store.revoke(ctx, func(ctx context.Context) error {
+		return store.db.RemoveSession(ctx, key)
+	}, func(session *pb.Session) bool {
+		return session.GetKey() == key
+	})
+}
+
+// RevokeByDeviceID deletes all sessions for a device from the database and the cache.
+func (store *sessionStore) RevokeByDeviceID(ctx context.Context, deviceID int64) ([]*pb.Session, error) {
+	return store.revoke(ctx, func(ctx context.Context) error {
+		return store.db.RemoveSessionsForDevice(ctx, deviceID)
+	}, func(session *pb.Session) bool {
+		return session.GetDevice().GetId() == deviceID
+	})
+}
+
+// RevokeByObjectID deletes all sessions for a user from the database and the cache.
+func (store *sessionStore) RevokeByObjectID(ctx context.Context, objectID string) ([]*pb.Session, error) {
+	return store.revoke(ctx, func(ctx context.Context) error {
+		return store.db.RemoveSessionsForUser(ctx, objectID)
+	}, func(session *pb.Session) bool {
+		return session.GetObjectID() == objectID
+	})
+}
+
+// revoke removes sessions from the database, then evicts the matching sessions from the cache.
+// The evicted sessions are returned so that the caller can tear down any streams belonging to them.
+func (store *sessionStore) revoke(ctx context.Context, remove func(context.Context) error, match func(*pb.Session) bool) ([]*pb.Session, error) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	if err := remove(ctx); err != nil {
+		return nil, fmt.Errorf("remove sessions from database: %w", err)
+	}
+
+	revoked := make([]*pb.Session, 0)
+	for id, session := range store.byDeviceID {
+		if match(session) {
+			store.deleteSessionsForDeviceIDWithAssumedLock(id)
+			revoked = append(revoked, session)
+		}
+	}
+
+	return revoked, nil
+}
@@ -181,3 +181,191 @@ func TestSessionStore_ReplaceOnSet(t *testing.T) {
 	assert.Equal(t, int64(2), session.GetDevice().GetId())
 	assert.Equal(t, "old_key_2", session.GetKey())
 }
+
+func TestSessionStore_Revoke(t *testing.T) {
+	ctx := context.Background()
+	db := testdatabase.Setup(t, false)
+	store := auth.NewSessionStore(db)
+
+	for i := range 3 {
+		deviceID := int64(i + 1)
+		device := &pb.Device{
+			Serial:    fmt.Sprintf("device-%v", deviceID),
+			PublicKey: fmt.Sprintf("device-%v", deviceID),
+			Platform:  "linux",
+		}
+		if err := db.AddDevice(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sessions := []*pb.Session{
+		{Key: "key-1", ObjectID: "alice", Expiry: timestamppb.New(time.Now().Add(time.Hour)), Device: &pb.Device{Id: 1}},
+		{Key: "key-2", ObjectID: "alice", Expiry: timestamppb.New(time.Now().Add(time.Hour)), Device: &pb.Device{Id: 2}},
+		{Key: "key-3", ObjectID: "bob", Expiry: timestamppb.New(time.Now().Add(time.Hour)), Device: &pb.Device{Id: 3}},
+	}
+	for _, session := range sessions {
+		assert.NoError(t, store.Set(ctx, session))
+	}
+
+	revoked, err := store.RevokeByKey(ctx, "key-3")
+	assert.NoError(t, err)
+	assert.Len(t, revoked, 1)
+	_, err = store.Get(ctx, "key-3")
+	assert.Error(t, err)
+
+	revoked, err = store.RevokeByObjectID(ctx, "alice")
+	assert.NoError(t, err)
+	assert.Len(t, revoked, 2)
+	assert.Empty(t, store.All())
+
+	// revoked sessions must not be loaded from the database again
+	_, err = store.Get(ctx, "key-1")
+	assert.Error(t, err)
+
+	revoked, err = store.RevokeByDeviceID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, revoked)
+}
+
+// Test that reloading picks up sessions added and removed by another apiserver instance.
+func TestSessionStore_Reload(t *testing.T) {
+	ctx := context.Background()
+	db := testdatabase.Setup(t, false)
+	store := auth.NewSessionStore(db)
+
+	for i := range 2 {
+		deviceID := int64(i + 1)
+		device := &pb.Device{
+			Serial:    fmt.Sprintf("device-%v", deviceID),
+			PublicKey: fmt.Sprintf("device-%v", deviceID),
+			Platform:  "linux",
+		}
+		if err := db.AddDevice(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.NoError(t, store.Set(ctx, &pb.Session{
+		Key:    "revoked",
+		Expiry: timestamppb.New(time.Now().Add(2 * time.Hour)),
+		Device: &pb.Device{Id: 1},
+	}))
+
+	// another instance revokes one session and adds another
+	assert.NoError(t, db.RemoveSession(ctx, "revoked"))
+	assert.NoError(t, db.AddSessionInfo(ctx, &pb.Session{
+		Key:    "added",
+		Expiry: timestamppb.New(time.Now().Add(2 * time.Hour)),
+		Device: &pb.Device{Id: 2},
+	}))
+
+	assert.NoError(t, store.Reload(ctx))
+
+	all := store.All()
+	assert.Len(t, all, 1)
+	assert.Equal(t, "added", all[0].GetKey())
+
+	_, err := store.Get(ctx, "revoked")
+	assert.Error(t, err)
+}
+
+func TestSessionStore_Renew(t *testing.T) {
+	ctx := context.Background()
+	db := testdatabase.Setup(t, false)
+	store := auth.NewSessionStore(db)
+
+	for i := range 2 {
+		deviceID := int64(i + 1)
+		device := &pb.Device{
+			Serial:    fmt.Sprintf("device-%v", deviceID),
+			PublicKey: fmt.Sprintf("device-%v", deviceID),
+			Platform:  "linux",
+		}
+		if err := db.AddDevice(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	old := &pb.Session{
+		Key:      "old",
+		Groups:   []string{"group1"},
+		ObjectID: "alice",
+		Expiry:   timestamppb.New(time.Now().Add(time.Minute)),
+		Device:   &pb.Device{Id: 1},
+	}
+	assert.NoError(t, store.Set(ctx, old))
+
+	renewed := &pb.Session{
+		Key:      "renewed",
+		Groups:   []string{"group1", "group2"},
+		ObjectID: "alice",
+		Expiry:   timestamppb.New(time.Now().Add(2 * time.Hour)),
+		Device:   &pb.Device{Id: 1},
+	}
+	assert.NoError(t, store.Renew(ctx, old, renewed))
+
+	all := store.All()
+	assert.Len(t, all, 1)
+	assert.Equal(t, "renewed", all[0].GetKey())
+
+	// the old key must not be loaded from the database again
+	_, err := store.Get(ctx, "old")
+	assert.Error(t, err)
+
+	sessions, err := db.ReadSessionInfos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "renewed", sessions[0].GetKey())
+	assert.Equal(t, []string{"group1", "group2"}, sessions[0].GetGroups())
+
+	// sessions are only renewed for the same device
+	err = store.Renew(ctx, renewed, &pb.Session{
+		Key:    "other-device",
+		Expiry: timestamppb.New(time.Now().Add(2 * time.Hour)),
+		Device: &pb.Device{Id: 2},
+	})
+	assert.Error(t, err)
+	_, err = store.Get(ctx, "renewed")
+	assert.NoError(t, err)
+}
+
+func TestSessionStore_UpdateGroups(t *testing.T) {
+	ctx := context.Background()
+	db := testdatabase.Setup(t, false)
+	store := auth.NewSessionStore(db)
+
+	device := &pb.Device{
+		Serial:    "device-1",
+		PublicKey: "device-1",
+		Platform:  "linux",
+	}
+	if err := db.AddDevice(ctx, device); err != nil {
+		t.Fatal(err)
+	}
+
+	session := &pb.Session{
+		Key:      "key",
+		Groups:   []string{"group1", "group2"},
+		ObjectID: "alice",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   &pb.Device{Id: 1},
+	}
+	assert.NoError(t, store.Set(ctx, session))
+
+	assert.NoError(t, store.UpdateGroups(ctx, "key", []string{"group2", "group3"}))
+
+	cached, err := store.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"group2", "group3"}, cached.GetGroups())
+
+	// sessions handed out before the update are left as they were
+	assert.Equal(t, []string{"group1", "group2"}, session.GetGroups())
+
+	sessions, err := db.ReadSessionInfos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.ElementsMatch(t, []string{"group2", "group3"}, sessions[0].GetGroups())
+
+	assert.ErrorIs(t, store.UpdateGroups(ctx, "unknown", []string{"group1"}), auth.ErrNoSession)
+}
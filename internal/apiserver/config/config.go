@@ -3,8 +3,11 @@ package config
 import (
 	"fmt"
 	"net/netip"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/nais/device/internal/apiserver/agentversion"
 	"github.com/nais/device/internal/token"
 	"github.com/nais/device/internal/token/azure"
 	"github.com/nais/device/internal/token/google"
@@ -14,28 +17,63 @@ import (
 )
 
 type Config struct {
+	AgentLatestVersion                string
+	AgentMinimumVersions              []string
+	AgentReleaseCheckInterval         time.Duration
+	AgentReleasesURL                  string
+	AgentVersionGracePeriod           time.Duration
 	AutoEnrollEnabled                 bool
 	AutoEnrollmentsURL                string
 	Azure                             token.Config
 	JITA                              token.Config
+	JITAApproverGroups                []string
 	BindAddress                       string
 	ControlPlaneAuthenticationEnabled bool
 	AdminCredentialEntries            []string
+	AdminGroupRoles                   []string
+	AdminCertificateRoles             []string
 	PrometheusCredentialEntries       []string
+	DBDriver                          string
 	DBPath                            string
+	DBURL                             string
+	DBSnapshotDir                     string
+	DBSnapshotInterval                time.Duration
+	DBSnapshotRetention               int
 	DeviceAuthenticationProvider      string
 	Endpoint                          string
 	GRPCBindAddress                   string
+	GRPCTLSCertFile                   string
+	GRPCTLSKeyFile                    string
+	GRPCTLSClientCAFile               string
+	GRPCTLSReloadInterval             time.Duration
 	GatewayConfigBucketName           string
 	GatewayConfigBucketObjectName     string
+	GatewayConfigFilePath             string
 	Google                            token.Config
+	GraphClientID                     string
+	GraphClientSecret                 string
+	GraphTenantID                     string
+	GroupRefreshEnabled               bool
+	GroupRefreshInterval              time.Duration
+	HAEnabled                         bool
+	HAInstanceID                      string
+	HALeaseDuration                   time.Duration
 	KolideIntegrationEnabled          bool
 	KolideAPIToken                    string
 	KolideEventHandlerAddress         string
 	KolideEventHandlerEnabled         bool
 	KolideEventHandlerToken           string
 	KolideEventHandlerSecure          bool
+	KolideEventCoalesceWindow         time.Duration
+	KolideEventWorkers                int
+	KolideWebhookEnabled              bool
+	KolideWebhookSigningSecret        string
+	KolideWebhookMaxAge               time.Duration
 	LogLevel                          string
+	PostureFeedSource                 string
+	PostureFeedToken                  string
+	PostureFeedInterval               time.Duration
+	PosturePolicyPath                 string
 	PrometheusAddr                    string
 	PrometheusPublicKey               string
 	PrometheusTunnelIP                string
@@ -67,15 +105,29 @@ func Credentials(entries []string) (map[string]string, error) {
 
 func DefaultConfig() Config {
 	return Config{
+		AgentReleaseCheckInterval:     time.Hour,
+		AgentReleasesURL:              agentversion.GitHubLatestRelease,
+		AgentVersionGracePeriod:       7 * 24 * time.Hour,
 		Azure:                         azure.APIServerConfig,
 		JITA:                          azure.JITAConfig,
 		Google:                        google.APIServerConfig,
 		BindAddress:                   "127.0.0.1:8080",
+		DBDriver:                      "sqlite",
 		DBPath:                        "/tmp/naisdevice.db",
+		DBSnapshotInterval:            time.Hour,
+		DBSnapshotRetention:           24,
 		GRPCBindAddress:               "127.0.0.1:8099",
+		GRPCTLSReloadInterval:         time.Minute,
 		GatewayConfigBucketName:       "gatewayconfig",
 		GatewayConfigBucketObjectName: "gatewayconfig.json",
+		GatewayConfigFilePath:         "/etc/apiserver/gatewayconfig.json",
+		GroupRefreshInterval:          5 * time.Minute,
+		HALeaseDuration:               15 * time.Second,
+		KolideEventCoalesceWindow:     5 * time.Second,
+		KolideEventWorkers:            4,
+		KolideWebhookMaxAge:           5 * time.Minute,
 		LogLevel:                      "info",
+		PostureFeedInterval:           time.Minute,
 		PrometheusAddr:                "127.0.0.1:3000",
 		WireGuardNetworkAddress:       "10.255.240.0/21",
 		WireGuardIP:                   "10.255.240.1",
@@ -122,6 +174,18 @@ func (cfg *Config) Parse() error {
 		}
 	}
 
+	// the lease keeping only one instance active lives in the database, a SQLite file can not be shared between instances
+	if cfg.HAEnabled && cfg.DBDriver != "postgres" {
+		return fmt.Errorf("high availability requires the postgres database driver, not %q", cfg.DBDriver)
+	}
+
+	if cfg.HAEnabled && len(cfg.HAInstanceID) == 0 {
+		cfg.HAInstanceID, err = os.Hostname()
+		if err != nil {
+			return fmt.Errorf("default HA instance id to hostname: %w", err)
+		}
+	}
+
 	if len(cfg.LogLevel) != 0 {
 		level, err := logrus.ParseLevel(cfg.LogLevel)
 		if err != nil {
@@ -133,6 +197,23 @@ func (cfg *Config) Parse() error {
 	return nil
 }
 
+// WireGuardPrefixes returns the networks used for WireGuard tunnel addresses, which gateway routes must not overlap.
+func (cfg *Config) WireGuardPrefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	if cfg.WireGuardIPv4Prefix != nil {
+		prefixes = append(prefixes, cfg.WireGuardIPv4Prefix.Masked())
+	}
+	if cfg.WireGuardIPv6Prefix != nil {
+		prefixes = append(prefixes, cfg.WireGuardIPv6Prefix.Masked())
+	}
+	return prefixes
+}
+
+// KolideEventsEnabled reports whether device updates are received from Kolide, either from the external event handler or as webhooks.
+func (cfg *Config) KolideEventsEnabled() bool {
+	return cfg.KolideEventHandlerEnabled || cfg.KolideWebhookEnabled
+}
+
 func (cfg *Config) APIServerPeer() *pb.Gateway {
 	ipv6 := ""
 	if cfg.WireGuardIPv6Prefix != nil {
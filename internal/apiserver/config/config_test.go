@@ -25,3 +25,25 @@ func TestParseIPOrPrefix(t *testing.T) {
 		assert.Equal(t, tt.expected, out.String())
 	}
 }
+
+func TestWireGuardPrefixes(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.WireGuardIPv6 = "fd75:568f:0:2::1"
+	assert.NoError(t, cfg.Parse())
+
+	var prefixes []string
+	for _, prefix := range cfg.WireGuardPrefixes() {
+		prefixes = append(prefixes, prefix.String())
+	}
+	assert.Equal(t, []string{"10.255.240.0/21", "fd75:568f:0:2::/64"}, prefixes)
+}
+
+func TestHARequiresPostgres(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.HAEnabled = true
+	cfg.HAInstanceID = "a"
+	assert.ErrorContains(t, cfg.Parse(), "high availability requires the postgres database driver")
+
+	cfg.DBDriver = "postgres"
+	assert.NoError(t, cfg.Parse())
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/sqlc"
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Actions recorded in the audit log
+const (
+	AuditActionGatewayEnroll    = "gateway.enroll"
+	AuditActionGatewayUpdate    = "gateway.update"
+	AuditActionGatewayDelete    = "gateway.delete"
+	AuditActionDeviceDelete     = "device.delete"
+	AuditActionDeviceReassign   = "device.reassign"
+	AuditActionDeviceLogin      = "device.login"
+	AuditActionDeviceRenew      = "device.renew"
+	AuditActionSessionRevoke    = "session.revoke"
+	AuditActionJitaGrant        = "jita.grant"
+	AuditActionJitaRevoke       = "jita.revoke"
+	AuditActionJitaRequest      = "jita.request"
+	AuditActionJitaApprove      = "jita.approve"
+	AuditActionJitaDeny         = "jita.deny"
+	AuditActionDatabaseSnapshot = "database.snapshot"
+	AuditActionExemptionCreate  = "exemption.create"
+	AuditActionExemptionRevoke  = "exemption.revoke"
+)
+
+type AuditEventFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Since  time.Time
+	Until  time.Time
+	// Only return events with an id lower than this, used for paging
+	BeforeID int64
+	// Maximum number of events to return, 0 means no limit
+	PageSize int
+}
+
+func (db *database) AddAuditEvent(ctx context.Context, actor, action, target, reason string) error {
+	err := db.queries.AddAuditEvent(ctx, sqlc.AddAuditEventParams{
+		Created: timeToString(time.Now()),
+		Actor:   actor,
+		Action:  action,
+		Target:  target,
+		Reason:  reason,
+	})
+	if err != nil {
+		return fmt.Errorf("add audit event: %w", err)
+	}
+	return nil
+}
+
+func (db *database) ReadAuditEvents(ctx context.Context, filter AuditEventFilter) ([]*pb.AuditEvent, error) {
+	pageSize := int64(filter.PageSize)
+	if pageSize <= 0 {
+		// a negative limit means no limit in sqlite
+		pageSize = -1
+	}
+
+	params := sqlc.GetAuditEventsParams{
+		Actor:    filter.Actor,
+		Action:   filter.Action,
+		Target:   filter.Target,
+		BeforeID: filter.BeforeID,
+		PageSize: pageSize,
+	}
+	if !filter.Since.IsZero() {
+		params.Since = timeToString(filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		params.Until = timeToString(filter.Until)
+	}
+
+	rows, err := db.queries.GetAuditEvents(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("read audit events: %w", err)
+	}
+
+	events := make([]*pb.AuditEvent, len(rows))
+	for i, row := range rows {
+		events[i] = &pb.AuditEvent{
+			Id:      row.ID,
+			Created: timestamppb.New(stringToTime(row.Created)),
+			Actor:   row.Actor,
+			Action:  row.Action,
+			Target:  row.Target,
+			Reason:  row.Reason,
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,189 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// copyTables lists all tables in the apiserver database, in an order that satisfies foreign keys.
+var copyTables = []string{
+	"devices",
+	"gateways",
+	"gateway_access_group_ids",
+	"gateway_routes",
+	"sessions",
+	"session_access_group_ids",
+	"kolide_checks",
+	"kolide_issues",
+	"acceptances",
+	"gateway_jita_grants",
+	"audit_events",
+	"issue_exemptions",
+}
+
+// identityTables lists the tables with generated ids, whose sequences must be moved past the copied rows.
+var identityTables = []string{
+	"devices",
+	"gateway_jita_grants",
+	"audit_events",
+	"issue_exemptions",
+}
+
+type column struct {
+	name     string
+	dataType string
+}
+
+// CopySQLiteToPostgres copies all data from the SQLite database at sqlitePath into the PostgreSQL database at postgresURL.
+// Both databases are migrated to the latest schema first. The copy is done in a single transaction,
+// and is refused if any of the tables in the PostgreSQL database already contain data.
+func CopySQLiteToPostgres(ctx context.Context, sqlitePath, postgresURL string, log logrus.FieldLogger) error {
+	if err := runMigrations(sqlitePath, log); err != nil {
+		return fmt.Errorf("migrating sqlite database: %w", err)
+	}
+
+	source, err := sql.Open("sqlite3", "file:"+sqlitePath+"?_foreign_keys=1&_busy_timeout=5000")
+	if err != nil {
+		return fmt.Errorf("open sqlite database: %w", err)
+	}
+	defer source.Close()
+
+	target, err := sql.Open("pgx", postgresURL)
+	if err != nil {
+		return fmt.Errorf("open postgres database: %w", err)
+	}
+	defer target.Close()
+
+	if err := runPostgresMigrations(target, log); err != nil {
+		return fmt.Errorf("migrating postgres database: %w", err)
+	}
+
+	tx, err := target.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			log.Errorf("transaction rollback error: %v", err)
+		}
+	}()
+
+	for _, table := range copyTables {
+		n, err := copyTable(ctx, source, tx, table)
+		if err != nil {
+			return fmt.Errorf("copy table %s: %w", table, err)
+		}
+		log.WithField("table", table).WithField("rows", n).Info("copied table")
+	}
+
+	for _, table := range identityTables {
+		query := fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%[1]s', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM %[1]s", table)
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("reset id sequence for table %s: %w", table, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func copyTable(ctx context.Context, source *sql.DB, target *sql.Tx, table string) (int, error) {
+	var hasRows bool
+	if err := target.QueryRowContext(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", table)).Scan(&hasRows); err != nil {
+		return 0, err
+	}
+	if hasRows {
+		return 0, fmt.Errorf("table is not empty in postgres database")
+	}
+
+	columns, err := postgresColumns(ctx, target, table)
+	if err != nil {
+		return 0, err
+	}
+
+	names := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = `"` + c.name + `"`
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	rows, err := source.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(names, ", "), table))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(names, ", "), strings.Join(placeholders, ", "))
+
+	n := 0
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return n, err
+		}
+
+		for i, c := range columns {
+			values[i] = convertValue(values[i], c.dataType)
+		}
+
+		if _, err := target.ExecContext(ctx, insert, values...); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, rows.Err()
+}
+
+func postgresColumns(ctx context.Context, db *sql.Tx, table string) ([]column, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT column_name, data_type FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []column
+	for rows.Next() {
+		var c column
+		if err := rows.Scan(&c.name, &c.dataType); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table not found in postgres database")
+	}
+
+	return columns, rows.Err()
+}
+
+// convertValue converts a value read from SQLite into the type expected by the PostgreSQL column.
+// SQLite stores booleans as integers, and may return text as byte slices.
+func convertValue(value any, dataType string) any {
+	switch v := value.(type) {
+	case int64:
+		if dataType == "boolean" {
+			return v != 0
+		}
+	case []byte:
+		if dataType == "text" {
+			return string(v)
+		}
+	}
+	return value
+}
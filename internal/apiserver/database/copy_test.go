@@ -0,0 +1,79 @@
+package database_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/ip"
+	"github.com/nais/device/internal/apiserver/testdatabase"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCopySQLiteToPostgres(t *testing.T) {
+	postgresURL := testdatabase.PostgresURL(t)
+	source, sqlitePath := testdatabase.SetupSQLite(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	gateway := &pb.Gateway{Name: "gateway", Endpoint: "1.2.3.4:56789", PublicKey: "gatewayPublicKey", RequiresPrivilegedAccess: true}
+	assert.NoError(t, source.AddGateway(ctx, gateway))
+
+	device := &pb.Device{Username: "username", PublicKey: "devicePublicKey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, source.AddDevice(ctx, device))
+	device, err := source.ReadDevice(ctx, device.PublicKey)
+	assert.NoError(t, err)
+
+	assert.NoError(t, source.AddSessionInfo(ctx, &pb.Session{
+		Key:      "sessionKey",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   device,
+		ObjectID: "objectID",
+		Groups:   []string{"group"},
+	}))
+	assert.NoError(t, source.GrantPrivilegedGatewayAccess(ctx, "objectID", gateway.Name, time.Now().Add(time.Hour), "reason"))
+	assert.NoError(t, source.AddAuditEvent(ctx, "admin", database.AuditActionGatewayEnroll, "gateway:gateway", ""))
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	assert.NoError(t, database.CopySQLiteToPostgres(ctx, sqlitePath, postgresURL, log))
+
+	prefix := netip.MustParsePrefix("fd00::/64")
+	target, err := database.NewPostgres(postgresURL, ip.NewV4Allocator(netip.MustParsePrefix("10.255.240.1/21"), []string{"10.255.240.1"}), ip.NewV6Allocator(&prefix), false, log)
+	assert.NoError(t, err)
+
+	copiedGateway, err := target.ReadGateway(ctx, gateway.Name)
+	assert.NoError(t, err)
+	assert.True(t, copiedGateway.RequiresPrivilegedAccess)
+
+	copiedDevice, err := target.ReadDevice(ctx, device.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, device.Id, copiedDevice.Id)
+
+	sessions, err := target.ReadSessionInfos(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, []string{"group"}, sessions[0].GetGroups())
+
+	hasAccess, err := target.UserHasAccessToPrivilegedGateway(ctx, "objectID", gateway.Name)
+	assert.NoError(t, err)
+	assert.True(t, hasAccess)
+
+	events, err := target.ReadAuditEvents(ctx, database.AuditEventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	// ids continue after the copied rows
+	assert.NoError(t, target.AddDevice(ctx, &pb.Device{Username: "other", PublicKey: "otherPublicKey", Serial: "other", Platform: "linux"}))
+	otherDevice, err := target.ReadDevice(ctx, "otherPublicKey")
+	assert.NoError(t, err)
+	assert.Greater(t, otherDevice.Id, device.Id)
+
+	// copying into a database with data is refused
+	assert.ErrorContains(t, database.CopySQLiteToPostgres(ctx, sqlitePath, postgresURL, log), "not empty")
+}
@@ -2,14 +2,19 @@ package database_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/agentversion"
+	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/internal/apiserver/kolide"
 	"github.com/nais/device/internal/apiserver/testdatabase"
 	"github.com/nais/device/pkg/pb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -202,3 +207,531 @@ func TestReadPeers(t *testing.T) {
 	assert.Equal(t, dbdevice2.Ipv4+"/32", peers[1].GetAllowedIPs()[0])
 	assert.Equal(t, d2.PublicKey, peers[1].GetPublicKey())
 }
+
+func TestDeleteGateway(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	g := &pb.Gateway{
+		Endpoint:       "1.2.3.4:56789",
+		PublicKey:      "publicKey",
+		Name:           "gateway",
+		PasswordHash:   "hunter2",
+		RoutesIPv4:     []string{"1.2.3.4/32"},
+		RoutesIPv6:     []string{"fb32::1/128"},
+		AccessGroupIDs: []string{"group"},
+	}
+
+	assert.NoError(t, db.AddGateway(ctx, g))
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "user", g.Name, time.Now().Add(time.Hour), "reason"))
+
+	created, err := db.ReadGateway(ctx, g.Name)
+	assert.NoError(t, err)
+
+	t.Run("deleting existing gateway removes it and its grants", func(t *testing.T) {
+		assert.NoError(t, db.DeleteGateway(ctx, g.Name))
+
+		_, err := db.ReadGateway(ctx, g.Name)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		grants, err := db.GetGatewayJitaGrantsForUser(ctx, "user")
+		assert.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+
+	t.Run("deleting unknown gateway returns ErrNoRows", func(t *testing.T) {
+		assert.ErrorIs(t, db.DeleteGateway(ctx, g.Name), sql.ErrNoRows)
+	})
+
+	t.Run("tunnel ip of deleted gateway is released", func(t *testing.T) {
+		g.PublicKey = "otherPublicKey"
+		g.Name = "other"
+		assert.NoError(t, db.AddGateway(ctx, g))
+
+		gateway, err := db.ReadGateway(ctx, g.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, created.Ipv4, gateway.Ipv4)
+	})
+}
+
+func TestDeleteDevice(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	d := &pb.Device{Username: "username", PublicKey: "publickey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, db.AddDevice(ctx, d))
+
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+
+	session := &pb.Session{
+		Key:      "key",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   device,
+		Groups:   []string{"group"},
+		ObjectID: "oid",
+	}
+	assert.NoError(t, db.AddSessionInfo(ctx, session))
+
+	t.Run("deleting existing device removes it and its sessions", func(t *testing.T) {
+		assert.NoError(t, db.DeleteDevice(ctx, device.Id))
+
+		_, err := db.ReadDeviceByID(ctx, device.Id)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = db.ReadSessionInfo(ctx, session.Key)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("deleting unknown device returns ErrNoRows", func(t *testing.T) {
+		assert.ErrorIs(t, db.DeleteDevice(ctx, device.Id), sql.ErrNoRows)
+	})
+
+	t.Run("tunnel ip of deleted device is released", func(t *testing.T) {
+		assert.NoError(t, db.AddDevice(ctx, &pb.Device{Username: "other", PublicKey: "otherkey", Serial: "other", Platform: "linux"}))
+
+		other, err := db.ReadDevice(ctx, "otherkey")
+		assert.NoError(t, err)
+		assert.Equal(t, device.Ipv4, other.Ipv4)
+	})
+}
+
+func TestReassignDevice(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	d := &pb.Device{Username: "alice@example.com", PublicKey: "publickey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, db.AddDevice(ctx, d))
+
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+
+	session := &pb.Session{
+		Key:      "key",
+		Expiry:   timestamppb.New(time.Now().Add(time.Hour)),
+		Device:   device,
+		ObjectID: "alice",
+	}
+	assert.NoError(t, db.AddSessionInfo(ctx, session))
+
+	assert.NoError(t, db.ReassignDevice(ctx, device.Id, "bob@example.com"))
+
+	reassigned, err := db.ReadDeviceByID(ctx, device.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob@example.com", reassigned.Username)
+	assert.Equal(t, device.Ipv4, reassigned.Ipv4)
+
+	_, err = db.ReadSessionInfo(ctx, session.Key)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	assert.ErrorIs(t, db.ReassignDevice(ctx, 1337, "bob@example.com"), sql.ErrNoRows)
+}
+
+func TestAuditEvents(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	assert.NoError(t, db.AddAuditEvent(ctx, "admin", database.AuditActionGatewayEnroll, "gateway:gw-1", ""))
+	assert.NoError(t, db.AddAuditEvent(ctx, "admin", database.AuditActionGatewayDelete, "gateway:gw-1", ""))
+	assert.NoError(t, db.AddAuditEvent(ctx, "alice@example.com", database.AuditActionJitaGrant, "gateway:gw-2", "incident"))
+
+	events, err := db.ReadAuditEvents(ctx, database.AuditEventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	// newest first
+	assert.Equal(t, database.AuditActionJitaGrant, events[0].Action)
+	assert.Equal(t, "incident", events[0].Reason)
+	assert.WithinDuration(t, start, events[0].Created.AsTime(), time.Minute)
+
+	events, err = db.ReadAuditEvents(ctx, database.AuditEventFilter{Actor: "admin"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = db.ReadAuditEvents(ctx, database.AuditEventFilter{Target: "gateway:gw-1", Action: database.AuditActionGatewayDelete})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	events, err = db.ReadAuditEvents(ctx, database.AuditEventFilter{Until: start.Add(-time.Hour)})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = db.ReadAuditEvents(ctx, database.AuditEventFilter{Since: start.Add(-time.Hour)})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	page, err := db.ReadAuditEvents(ctx, database.AuditEventFilter{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+
+	page, err = db.ReadAuditEvents(ctx, database.AuditEventFilter{PageSize: 2, BeforeID: page[1].Id})
+	assert.NoError(t, err)
+	assert.Len(t, page, 1)
+	assert.Equal(t, database.AuditActionGatewayEnroll, page[0].Action)
+}
+
+func TestReadAndRevokeGatewayJitaGrants(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for _, name := range []string{"gw-1", "gw-2"} {
+		assert.NoError(t, db.AddGateway(ctx, &pb.Gateway{Name: name, PublicKey: name, Endpoint: name}))
+	}
+
+	expires := time.Now().Add(time.Hour)
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "alice", "gw-1", expires, "incident"))
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "bob", "gw-1", expires, "deploy"))
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "bob", "gw-2", time.Now().Add(-time.Hour), "expired"))
+
+	grants, err := db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, grants, 3)
+
+	grants, err = db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{UserID: "bob"})
+	assert.NoError(t, err)
+	assert.Len(t, grants, 2)
+
+	grants, err = db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{ActiveOnly: true})
+	assert.NoError(t, err)
+	assert.Len(t, grants, 2)
+
+	grants, err = db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{Gateway: "gw-1", Until: time.Now().Add(-time.Hour)})
+	assert.NoError(t, err)
+	assert.Empty(t, grants)
+
+	grants, err = db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{Gateway: "gw-1", UserID: "alice"})
+	assert.NoError(t, err)
+	assert.Len(t, grants, 1)
+
+	revoked, err := db.RevokeGatewayJitaGrant(ctx, grants[0].Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", revoked.UserID)
+	assert.NotNil(t, revoked.Revoked)
+
+	hasAccess, err := db.UserHasAccessToPrivilegedGateway(ctx, "alice", "gw-1")
+	assert.NoError(t, err)
+	assert.False(t, hasAccess)
+
+	hasAccess, err = db.UserHasAccessToPrivilegedGateway(ctx, "bob", "gw-1")
+	assert.NoError(t, err)
+	assert.True(t, hasAccess)
+
+	_, err = db.RevokeGatewayJitaGrant(ctx, grants[0].Id)
+	assert.ErrorIs(t, err, database.ErrGrantNotActive)
+
+	expired, err := db.ReadGatewayJitaGrants(ctx, database.GatewayJitaGrantFilter{Gateway: "gw-2"})
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	_, err = db.RevokeGatewayJitaGrant(ctx, expired[0].Id)
+	assert.ErrorIs(t, err, database.ErrGrantNotActive)
+
+	_, err = db.RevokeGatewayJitaGrant(ctx, 1337)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestGatewayJitaGrantApproval(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	assert.NoError(t, db.AddGateway(ctx, &pb.Gateway{Name: "gw", PublicKey: "gw", Endpoint: "gw", RequiresPrivilegedAccess: true, RequiresApproval: true}))
+
+	gateway, err := db.ReadGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.True(t, gateway.RequiresApproval)
+
+	assert.NoError(t, db.RequestPrivilegedGatewayAccess(ctx, "alice", "gw", time.Now().Add(2*time.Hour), "incident"))
+	assert.NoError(t, db.RequestPrivilegedGatewayAccess(ctx, "bob", "gw", time.Now().Add(time.Hour), "deploy"))
+
+	// pending grants do not give access
+	hasAccess, err := db.UserHasAccessToPrivilegedGateway(ctx, "alice", "gw")
+	assert.NoError(t, err)
+	assert.False(t, hasAccess)
+
+	users, err := db.UsersWithAccessToPrivilegedGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+
+	pending, err := db.ReadPendingGatewayJitaGrants(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, pending, 2)
+	assert.Equal(t, pb.JitaApproval_JitaApprovalPending, pending[0].Approval)
+
+	approved, err := db.ReviewGatewayJitaGrant(ctx, pending[0].Id, "carol@example.com", true)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JitaApproval_JitaApprovalApproved, approved.Approval)
+	assert.Equal(t, "carol@example.com", approved.Approver)
+	assert.NotNil(t, approved.Decided)
+	// the requested duration starts when approved
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), approved.Expires.AsTime(), time.Minute)
+
+	denied, err := db.ReviewGatewayJitaGrant(ctx, pending[1].Id, "carol@example.com", false)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JitaApproval_JitaApprovalDenied, denied.Approval)
+
+	users, err = db.UsersWithAccessToPrivilegedGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, users)
+
+	_, err = db.ReviewGatewayJitaGrant(ctx, pending[1].Id, "carol@example.com", true)
+	assert.ErrorIs(t, err, database.ErrGrantNotPending)
+
+	_, err = db.ReviewGatewayJitaGrant(ctx, 1337, "carol@example.com", true)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	pending, err = db.ReadPendingGatewayJitaGrants(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestGatewayJitaPolicy(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	assert.NoError(t, db.AddGateway(ctx, &pb.Gateway{Name: "gw", PublicKey: "gw", Endpoint: "gw"}))
+
+	gateway, err := db.ReadGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.Nil(t, gateway.JitaPolicy)
+
+	policy := &pb.JitaPolicy{
+		MaxDuration:     durationpb.New(4 * time.Hour),
+		MinReasonLength: 10,
+		ReasonPattern:   `INC-\d+`,
+		MaxGrantsPerDay: 2,
+	}
+	assert.NoError(t, db.UpdateGatewayDynamicFields(ctx, &pb.Gateway{Name: "gw", RequiresPrivilegedAccess: true, JitaPolicy: policy}))
+
+	gateway, err = db.ReadGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(policy, gateway.JitaPolicy))
+
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "alice", "gw", time.Now().Add(time.Hour), "INC-1234 fix"))
+	assert.NoError(t, db.RevokePrivilegedGatewayAccess(ctx, "alice", "gw"))
+	assert.NoError(t, db.GrantPrivilegedGatewayAccess(ctx, "alice", "gw", time.Now().Add(time.Hour), "INC-1234 fix"))
+
+	count, err := db.CountGatewayJitaGrantsForUserSince(ctx, "alice", "gw", time.Now().Add(-24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = db.CountGatewayJitaGrantsForUserSince(ctx, "alice", "gw", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestUpdateGatewaysDynamicFieldsIsAtomic(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	assert.NoError(t, db.AddGateway(ctx, &pb.Gateway{Name: "gw", PublicKey: "gw", Endpoint: "gw"}))
+	assert.NoError(t, db.UpdateGatewayDynamicFields(ctx, &pb.Gateway{Name: "gw", AccessGroupIDs: []string{"before"}}))
+
+	// the missing gateway fails on its access groups, after gw has been updated in the same transaction
+	err := db.UpdateGatewaysDynamicFields(ctx, []*pb.Gateway{
+		{Name: "gw", AccessGroupIDs: []string{"after"}},
+		{Name: "missing", AccessGroupIDs: []string{"after"}},
+	})
+	assert.ErrorContains(t, err, "gateway missing")
+
+	gateway, err := db.ReadGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before"}, gateway.GetAccessGroupIDs())
+
+	assert.NoError(t, db.UpdateGatewaysDynamicFields(ctx, []*pb.Gateway{{Name: "gw", AccessGroupIDs: []string{"after"}}}))
+
+	gateway, err = db.ReadGateway(ctx, "gw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"after"}, gateway.GetAccessGroupIDs())
+}
+
+func TestLeases(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	acquired, err := db.AcquireLease(ctx, "lease", "a", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	t.Run("holder renews its lease", func(t *testing.T) {
+		acquired, err := db.AcquireLease(ctx, "lease", "a", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("other holder can not take a valid lease", func(t *testing.T) {
+		acquired, err := db.AcquireLease(ctx, "lease", "b", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("other holder takes an expired lease", func(t *testing.T) {
+		acquired, err := db.AcquireLease(ctx, "lease", "a", -2*time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = db.AcquireLease(ctx, "lease", "b", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+
+		acquired, err = db.AcquireLease(ctx, "lease", "a", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("released lease can be taken", func(t *testing.T) {
+		// releasing a lease held by someone else does nothing
+		assert.NoError(t, db.ReleaseLease(ctx, "lease", "a"))
+		acquired, err := db.AcquireLease(ctx, "lease", "a", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+
+		assert.NoError(t, db.ReleaseLease(ctx, "lease", "b"))
+		acquired, err = db.AcquireLease(ctx, "lease", "a", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
+
+type staticPosture []*pb.DeviceIssue
+
+func (s staticPosture) Name() string {
+	return "static"
+}
+
+func (s staticPosture) DeviceIssues(context.Context, *pb.Device) ([]*pb.DeviceIssue, error) {
+	return s, nil
+}
+
+func TestPostureProviders(t *testing.T) {
+	issue := &pb.DeviceIssue{Title: "from provider", Severity: pb.Severity_Warning}
+	db := testdatabase.Setup(t, true, database.WithPostureProviders(staticPosture{issue}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	d := &pb.Device{Username: "username", PublicKey: "publickey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, db.AddDevice(ctx, d))
+
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+
+	// the device is not linked to Kolide, so Kolide reports a missing device, merged with the issue from the provider
+	assert.Len(t, device.Issues, 2)
+	assert.Equal(t, "No Kolide device ID found for device", device.Issues[0].Title)
+	assert.Equal(t, issue.Title, device.Issues[1].Title)
+}
+
+func TestAgentVersionPosture(t *testing.T) {
+	policy, err := agentversion.ParsePolicy([]string{"v1.4.0"}, "", time.Hour)
+	assert.NoError(t, err)
+	db := testdatabase.Setup(t, false, database.WithPostureProviders(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	d := &pb.Device{Username: "username", PublicKey: "publickey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, db.AddDevice(ctx, d))
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+	assert.NoError(t, db.UpdateDeviceAgentVersion(ctx, device.Id, "v1.3.0"))
+
+	// the grace period starts when the outdated version is first seen
+	device, err = db.ReadDeviceByID(ctx, device.Id)
+	assert.NoError(t, err)
+	assert.Empty(t, device.Issues)
+
+	assert.NoError(t, db.UpdateDeviceAgentVersionOutdatedSince(ctx, device.Id, time.Now().Add(-2*time.Hour)))
+	device, err = db.ReadDeviceByID(ctx, device.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, device.Issues, 1) {
+		assert.Equal(t, pb.IssueTitleAgentOutdated, device.Issues[0].Title)
+	}
+	assert.False(t, device.Healthy())
+}
+
+func TestIssueExemptions(t *testing.T) {
+	db := testdatabase.Setup(t, true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	d := &pb.Device{Username: "username", PublicKey: "publickey", Serial: "serial", Platform: "darwin"}
+	assert.NoError(t, db.AddDevice(ctx, d))
+
+	// not linked to Kolide, which is a critical issue
+	device, err := db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+	assert.Len(t, device.Issues, 1)
+	assert.False(t, device.Healthy())
+
+	_, err = db.CreateIssueExemption(ctx, &pb.IssueExemption{Reason: "no scope", Expires: timestamppb.New(time.Now().Add(time.Hour))})
+	assert.ErrorIs(t, err, database.ErrExemptionWithoutScope)
+
+	// exemptions for other checks, users or expired exemptions do not apply
+	for _, exemption := range []*pb.IssueExemption{
+		{CheckID: 42, Reason: "other check", Expires: timestamppb.New(time.Now().Add(time.Hour))},
+		{Username: "someone else", Reason: "other user", Expires: timestamppb.New(time.Now().Add(time.Hour))},
+		{DeviceID: device.Id, Reason: "expired", Expires: timestamppb.New(time.Now().Add(-time.Hour))},
+	} {
+		exemption.CreatedBy = "admin"
+		_, err := db.CreateIssueExemption(ctx, exemption)
+		assert.NoError(t, err)
+	}
+
+	device, err = db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+	assert.Nil(t, device.Issues[0].Exemption)
+	assert.False(t, device.Healthy())
+
+	exemption, err := db.CreateIssueExemption(ctx, &pb.IssueExemption{
+		Username:  d.Username,
+		Reason:    "waiting for Kolide licenses",
+		CreatedBy: "admin",
+		Expires:   timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	assert.NoError(t, err)
+
+	device, err = db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+	assert.Len(t, device.Issues, 1)
+	assert.True(t, device.Issues[0].Suppressed())
+	assert.Equal(t, exemption.Id, device.Issues[0].Exemption.Id)
+	assert.True(t, device.Healthy())
+
+	active, err := db.ReadIssueExemptions(ctx, true)
+	assert.NoError(t, err)
+	assert.Len(t, active, 3)
+	assert.Equal(t, exemption.Id, active[0].Id)
+
+	revoked, err := db.RevokeIssueExemption(ctx, exemption.Id)
+	assert.NoError(t, err)
+	assert.NotNil(t, revoked.Revoked)
+
+	device, err = db.ReadDevice(ctx, d.PublicKey)
+	assert.NoError(t, err)
+	assert.False(t, device.Healthy())
+
+	all, err := db.ReadIssueExemptions(ctx, false)
+	assert.NoError(t, err)
+	assert.Len(t, all, 4)
+
+	_, err = db.RevokeIssueExemption(ctx, 1000)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -8,27 +8,28 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/posture"
 	"github.com/nais/device/internal/apiserver/sqlc"
 	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// Devices are allowed to connect this long after a failed check is triggered.
-const (
-	DurationCritical  = 0
-	DurationDanger    = time.Hour
-	DurationWarning   = time.Hour * 24 * 2
-	DurationAttention = time.Hour * 24 * 3
-	DurationNotice    = time.Hour * 24 * 7
-	DurationUnknown   = time.Hour * 24 * 30
-)
+// kolidePosture is the posture provider for the Kolide issues stored in the database.
+type kolidePosture struct {
+	queries Querier
+	policy  *posture.PolicyFile
+	log     logrus.FieldLogger
+}
 
-func (db *database) getDeviceIssues(ctx context.Context, device *sqlc.Device) ([]*pb.DeviceIssue, error) {
-	if !db.kolideEnabled {
-		return nil, nil
-	}
+var _ posture.Provider = &kolidePosture{}
 
-	if device.ExternalID.String == "" {
+func (k *kolidePosture) Name() string {
+	return "kolide"
+}
+
+func (k *kolidePosture) DeviceIssues(ctx context.Context, device *pb.Device) ([]*pb.DeviceIssue, error) {
+	if device.GetExternalID() == "" {
 		return []*pb.DeviceIssue{{
 			Title:         "No Kolide device ID found for device",
 			Message:       "Make sure you've installed Kolide according to the documentation at https://doc.nais.io/operate/naisdevice/how-to/install",
@@ -39,29 +40,31 @@ func (db *database) getDeviceIssues(ctx context.Context, device *sqlc.Device) ([
 		}}, nil
 	}
 
-	issues, err := db.queries.GetKolideIssuesForDevice(ctx, device.ExternalID.String)
+	issues, err := k.queries.GetKolideIssuesForDevice(ctx, device.GetExternalID())
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, fmt.Errorf("getting kolide issues: %w", err)
 	}
 
+	policy := k.policy.Policy()
 	deviceIssues := make([]*pb.DeviceIssue, 0)
 
 	for _, issue := range issues {
 		checkTags := strings.Split(issue.Tags, ",")
-		checkSeverity := kolideCheckSeverity(checkTags, db.log)
+		rating := kolideCheckRating(policy, issue.CheckID, checkTags, k.log)
 
-		if checkSeverity == pb.Severity_Info || issue.ResolvedAt.String != "" || issue.Ignored {
+		if rating.Severity == pb.Severity_Info || issue.ResolvedAt.String != "" || issue.Ignored {
 			continue
 		}
 
 		issueDetectedAt := stringToTime(issue.DetectedAt)
 		issueLastUpdated := stringToTime(issue.LastUpdated)
-		issueResolveBefore := issueDetectedAt.Add(graceTime(checkSeverity))
+		issueResolveBefore := issueDetectedAt.Add(rating.GracePeriod)
 
 		deviceIssues = append(deviceIssues, &pb.DeviceIssue{
 			Title:         issue.Title,
 			Message:       issue.Description,
-			Severity:      checkSeverity,
+			Severity:      rating.Severity,
+			CheckID:       issue.CheckID,
 			DetectedAt:    timestamppb.New(issueDetectedAt),
 			LastUpdated:   timestamppb.New(issueLastUpdated),
 			ResolveBefore: timestamppb.New(issueResolveBefore),
@@ -71,19 +74,29 @@ func (db *database) getDeviceIssues(ctx context.Context, device *sqlc.Device) ([
 	return deviceIssues, nil
 }
 
-func graceTime(severity pb.Severity) time.Duration {
-	switch severity {
-	case pb.Severity_Notice:
-		return DurationNotice
-	case pb.Severity_Attention:
-		return DurationAttention
-	case pb.Severity_Warning:
-		return DurationWarning
-	case pb.Severity_Danger:
-		return DurationDanger
-	case pb.Severity_Critical:
-		return DurationCritical
-	default:
-		return DurationUnknown
+// getDeviceIssues merges the issues for a device from all posture providers, and marks the issues covered by an exemption as suppressed.
+func (db *database) getDeviceIssues(ctx context.Context, device *sqlc.Device) ([]*pb.DeviceIssue, error) {
+	if len(db.postureProviders) == 0 {
+		return nil, nil
 	}
+
+	pbDevice, err := db.sqlcDeviceToPbDevice(device, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceIssues := make([]*pb.DeviceIssue, 0)
+	for _, provider := range db.postureProviders {
+		issues, err := provider.DeviceIssues(ctx, pbDevice)
+		if err != nil {
+			return nil, fmt.Errorf("%s posture: %w", provider.Name(), err)
+		}
+		deviceIssues = append(deviceIssues, issues...)
+	}
+
+	if err := db.applyIssueExemptions(ctx, device, deviceIssues); err != nil {
+		return nil, err
+	}
+
+	return deviceIssues, nil
 }
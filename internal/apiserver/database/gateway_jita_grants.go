@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/nais/device/internal/apiserver/sqlc"
@@ -11,6 +13,19 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Approval states of a gateway jita grant, as stored in the database
+const (
+	approvalNotRequired = "not_required"
+	approvalPending     = "pending"
+	approvalApproved    = "approved"
+	approvalDenied      = "denied"
+)
+
+var (
+	ErrGrantNotPending = errors.New("grant is not pending approval")
+	ErrGrantNotActive  = errors.New("grant is already revoked, denied or expired")
+)
+
 func (db *database) GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*pb.GatewayJitaGrant, error) {
 	rows, err := db.queries.GetGatewayJitaGrantsForUser(ctx, userID)
 	if err != nil {
@@ -19,22 +34,87 @@ func (db *database) GetGatewayJitaGrantsForUser(ctx context.Context, userID stri
 
 	ret := make([]*pb.GatewayJitaGrant, len(rows))
 	for i, row := range rows {
-		var revoked *timestamppb.Timestamp
-		if row.Revoked.Valid {
-			revoked = timestamppb.New(stringToTime(row.Revoked.String))
+		ret[i] = sqlcGatewayJitaGrantToPbGatewayJitaGrant(row)
+	}
+
+	return ret, nil
+}
+
+type GatewayJitaGrantFilter struct {
+	Gateway string
+	UserID  string
+	// Only grants created within [Since, Until)
+	Since time.Time
+	Until time.Time
+	// Only grants that are neither expired nor revoked
+	ActiveOnly bool
+}
+
+func (db *database) ReadGatewayJitaGrants(ctx context.Context, filter GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error) {
+	params := sqlc.GetGatewayJitaGrantsParams{
+		GatewayName: filter.Gateway,
+		UserID:      filter.UserID,
+		ActiveOnly:  filter.ActiveOnly,
+	}
+	if !filter.Since.IsZero() {
+		params.Since = filter.Since.UTC().Format(formats.TimeFormat)
+	}
+	if !filter.Until.IsZero() {
+		params.Until = filter.Until.UTC().Format(formats.TimeFormat)
+	}
+
+	rows, err := db.queries.GetGatewayJitaGrants(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("read gateway jita grants: %w", err)
+	}
+
+	ret := make([]*pb.GatewayJitaGrant, len(rows))
+	for i, row := range rows {
+		ret[i] = sqlcGatewayJitaGrantToPbGatewayJitaGrant(row)
+	}
+
+	return ret, nil
+}
+
+// RevokeGatewayJitaGrant revokes a single grant by id and returns it.
+// Grants that are already revoked, denied or expired can not be revoked.
+func (db *database) RevokeGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error) {
+	var grant *pb.GatewayJitaGrant
+	err := db.queries.Transaction(ctx, func(ctx context.Context, qtx sqlc.Querier) error {
+		row, err := qtx.GetGatewayJitaGrant(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if row.Revoked.Valid || row.Approval == approvalDenied || !stringToTime(row.Expires).After(now) {
+			return ErrGrantNotActive
+		}
+
+		err = qtx.RevokeGatewayJitaGrant(ctx, sqlc.RevokeGatewayJitaGrantParams{
+			Revoked: sql.NullString{
+				String: now.UTC().Format(formats.TimeFormat),
+				Valid:  true,
+			},
+			ID: id,
+		})
+		if err != nil {
+			return err
 		}
 
-		ret[i] = &pb.GatewayJitaGrant{
-			Id:      row.ID,
-			Gateway: row.GatewayName,
-			Created: timestamppb.New(stringToTime(row.Created)),
-			Expires: timestamppb.New(stringToTime(row.Expires)),
-			Revoked: revoked,
-			Reason:  row.Reason,
+		row, err = qtx.GetGatewayJitaGrant(ctx, id)
+		if err != nil {
+			return err
 		}
+
+		grant = sqlcGatewayJitaGrantToPbGatewayJitaGrant(row)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("revoke gateway jita grant: %w", err)
 	}
 
-	return ret, nil
+	return grant, nil
 }
 
 func (db *database) UserHasAccessToPrivilegedGateway(ctx context.Context, userID, gatewayName string) (bool, error) {
@@ -49,6 +129,19 @@ func (db *database) UserHasAccessToPrivilegedGateway(ctx context.Context, userID
 	return hasAccess == 1, nil
 }
 
+func (db *database) CountGatewayJitaGrantsForUserSince(ctx context.Context, userID, gatewayName string, since time.Time) (int, error) {
+	count, err := db.queries.CountGatewayJitaGrantsForUserSince(ctx, sqlc.CountGatewayJitaGrantsForUserSinceParams{
+		UserID:      userID,
+		GatewayName: gatewayName,
+		Since:       since.UTC().Format(formats.TimeFormat),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count gateway jita grants: %w", err)
+	}
+
+	return int(count), nil
+}
+
 func (db *database) UsersWithAccessToPrivilegedGateway(ctx context.Context, gatewayName string) ([]string, error) {
 	return db.queries.UsersWithAccessToPrivilegedGateway(ctx, gatewayName)
 }
@@ -60,9 +153,96 @@ func (db *database) GrantPrivilegedGatewayAccess(ctx context.Context, userID, ga
 		Created:     time.Now().UTC().Format(formats.TimeFormat),
 		Expires:     expires.UTC().Format(formats.TimeFormat),
 		Reason:      reason,
+		Approval:    approvalNotRequired,
 	})
 }
 
+// RequestPrivilegedGatewayAccess creates a grant that does not take effect until approved with ReviewGatewayJitaGrant.
+func (db *database) RequestPrivilegedGatewayAccess(ctx context.Context, userID, gatewayName string, expires time.Time, reason string) error {
+	return db.queries.GrantPrivilegedGatewayAccess(ctx, sqlc.GrantPrivilegedGatewayAccessParams{
+		UserID:      userID,
+		GatewayName: gatewayName,
+		Created:     time.Now().UTC().Format(formats.TimeFormat),
+		Expires:     expires.UTC().Format(formats.TimeFormat),
+		Reason:      reason,
+		Approval:    approvalPending,
+	})
+}
+
+func (db *database) ReadGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error) {
+	row, err := db.queries.GetGatewayJitaGrant(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("read gateway jita grant: %w", err)
+	}
+
+	return sqlcGatewayJitaGrantToPbGatewayJitaGrant(row), nil
+}
+
+func (db *database) ReadPendingGatewayJitaGrants(ctx context.Context) ([]*pb.GatewayJitaGrant, error) {
+	rows, err := db.queries.GetPendingGatewayJitaGrants(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read pending gateway jita grants: %w", err)
+	}
+
+	ret := make([]*pb.GatewayJitaGrant, len(rows))
+	for i, row := range rows {
+		ret[i] = sqlcGatewayJitaGrantToPbGatewayJitaGrant(row)
+	}
+
+	return ret, nil
+}
+
+// ReviewGatewayJitaGrant approves or denies a pending grant. The requested duration starts counting when the grant is approved.
+func (db *database) ReviewGatewayJitaGrant(ctx context.Context, id int64, approver string, approved bool) (*pb.GatewayJitaGrant, error) {
+	var grant *pb.GatewayJitaGrant
+	err := db.queries.Transaction(ctx, func(ctx context.Context, qtx sqlc.Querier) error {
+		row, err := qtx.GetGatewayJitaGrant(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if row.Approval != approvalPending || row.Revoked.Valid || !stringToTime(row.Expires).After(now) {
+			return ErrGrantNotPending
+		}
+
+		approval := approvalDenied
+		expires := row.Expires
+		if approved {
+			approval = approvalApproved
+			duration := stringToTime(row.Expires).Sub(stringToTime(row.Created))
+			expires = now.Add(duration).UTC().Format(formats.TimeFormat)
+		}
+
+		decided := sql.NullString{String: now.UTC().Format(formats.TimeFormat), Valid: true}
+		rows, err := qtx.ReviewGatewayJitaGrant(ctx, sqlc.ReviewGatewayJitaGrantParams{
+			Approval: approval,
+			Approver: sql.NullString{String: approver, Valid: true},
+			Decided:  decided,
+			Expires:  expires,
+			ID:       id,
+		})
+		if err != nil {
+			return err
+		} else if rows == 0 {
+			return ErrGrantNotPending
+		}
+
+		row, err = qtx.GetGatewayJitaGrant(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		grant = sqlcGatewayJitaGrantToPbGatewayJitaGrant(row)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("review gateway jita grant: %w", err)
+	}
+
+	return grant, nil
+}
+
 func (db *database) RevokePrivilegedGatewayAccess(ctx context.Context, userID, gatewayName string) error {
 	return db.queries.RevokePrivilegedGatewayAccess(ctx, sqlc.RevokePrivilegedGatewayAccessParams{
 		Revoked: sql.NullString{
@@ -73,3 +253,36 @@ func (db *database) RevokePrivilegedGatewayAccess(ctx context.Context, userID, g
 		GatewayName: gatewayName,
 	})
 }
+
+func sqlcGatewayJitaGrantToPbGatewayJitaGrant(row *sqlc.GatewayJitaGrant) *pb.GatewayJitaGrant {
+	var revoked, decided *timestamppb.Timestamp
+	if row.Revoked.Valid {
+		revoked = timestamppb.New(stringToTime(row.Revoked.String))
+	}
+	if row.Decided.Valid {
+		decided = timestamppb.New(stringToTime(row.Decided.String))
+	}
+
+	var approval pb.JitaApproval
+	switch row.Approval {
+	case approvalPending:
+		approval = pb.JitaApproval_JitaApprovalPending
+	case approvalApproved:
+		approval = pb.JitaApproval_JitaApprovalApproved
+	case approvalDenied:
+		approval = pb.JitaApproval_JitaApprovalDenied
+	}
+
+	return &pb.GatewayJitaGrant{
+		Id:       row.ID,
+		Gateway:  row.GatewayName,
+		Created:  timestamppb.New(stringToTime(row.Created)),
+		Expires:  timestamppb.New(stringToTime(row.Expires)),
+		Revoked:  revoked,
+		Reason:   row.Reason,
+		UserID:   row.UserID,
+		Approval: approval,
+		Approver: row.Approver.String,
+		Decided:  decided,
+	}
+}
@@ -16,8 +16,14 @@ type Database interface {
 	UpdateDevices(ctx context.Context, devices []*pb.Device) error
 	UpdateGateway(ctx context.Context, gateway *pb.Gateway) error
 	UpdateGatewayDynamicFields(ctx context.Context, gateway *pb.Gateway) error
+	UpdateGatewaysDynamicFields(ctx context.Context, gateways []*pb.Gateway) error
 	AddGateway(ctx context.Context, gateway *pb.Gateway) error
+	DeleteGateway(ctx context.Context, name string) error
 	AddDevice(ctx context.Context, device *pb.Device) error
+	DeleteDevice(ctx context.Context, deviceID int64) error
+	ReassignDevice(ctx context.Context, deviceID int64, username string) error
+	UpdateDeviceAgentVersion(ctx context.Context, deviceID int64, version string) error
+	UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, deviceID int64, since time.Time) error
 	ReadDevice(ctx context.Context, publicKey string) (*pb.Device, error)
 	ReadDeviceByID(ctx context.Context, deviceID int64) (*pb.Device, error)
 	ReadDeviceByExternalID(ctx context.Context, externalID string) (*pb.Device, error)
@@ -25,9 +31,14 @@ type Database interface {
 	ReadGateway(ctx context.Context, name string) (*pb.Gateway, error)
 	ReadDeviceBySerialPlatform(ctx context.Context, serial string, platform string) (*pb.Device, error)
 	AddSessionInfo(ctx context.Context, si *pb.Session) error
+	RenewSessionInfo(ctx context.Context, oldKey string, si *pb.Session) error
+	UpdateSessionGroups(ctx context.Context, key string, groups []string) error
 	ReadSessionInfo(ctx context.Context, key string) (*pb.Session, error)
 	ReadSessionInfos(ctx context.Context) ([]*pb.Session, error)
 	RemoveExpiredSessions(ctx context.Context) error
+	RemoveSession(ctx context.Context, key string) error
+	RemoveSessionsForDevice(ctx context.Context, deviceID int64) error
+	RemoveSessionsForUser(ctx context.Context, objectID string) error
 	ReadMostRecentSessionInfo(ctx context.Context, deviceID int64) (*pb.Session, error)
 	LinkKolideDevice(ctx context.Context, externalID, serial, platform string) error
 	UpdateKolideIssues(ctx context.Context, issues []*kolide.Issue) error
@@ -43,4 +54,19 @@ type Database interface {
 	GrantPrivilegedGatewayAccess(ctx context.Context, userID, gatewayName string, expires time.Time, reason string) error
 	RevokePrivilegedGatewayAccess(ctx context.Context, userID, gatewayName string) error
 	UsersWithAccessToPrivilegedGateway(ctx context.Context, gatewayName string) ([]string, error)
+	ReadGatewayJitaGrants(ctx context.Context, filter GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error)
+	RevokeGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error)
+	CountGatewayJitaGrantsForUserSince(ctx context.Context, userID, gatewayName string, since time.Time) (int, error)
+	RequestPrivilegedGatewayAccess(ctx context.Context, userID, gatewayName string, expires time.Time, reason string) error
+	ReadGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error)
+	ReadPendingGatewayJitaGrants(ctx context.Context) ([]*pb.GatewayJitaGrant, error)
+	ReviewGatewayJitaGrant(ctx context.Context, id int64, approver string, approved bool) (*pb.GatewayJitaGrant, error)
+	CreateIssueExemption(ctx context.Context, exemption *pb.IssueExemption) (*pb.IssueExemption, error)
+	ReadIssueExemptions(ctx context.Context, activeOnly bool) ([]*pb.IssueExemption, error)
+	RevokeIssueExemption(ctx context.Context, id int64) (*pb.IssueExemption, error)
+	AddAuditEvent(ctx context.Context, actor, action, target, reason string) error
+	ReadAuditEvents(ctx context.Context, filter AuditEventFilter) ([]*pb.AuditEvent, error)
+	Snapshot(ctx context.Context, path string) error
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
 }
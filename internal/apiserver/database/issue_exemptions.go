@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/sqlc"
+	"github.com/nais/device/internal/formats"
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrExemptionWithoutScope = errors.New("exemption must be scoped to a device, a user or a check")
+
+// CreateIssueExemption stores an exemption suppressing the issues matching its device, user and check until it expires.
+func (db *database) CreateIssueExemption(ctx context.Context, exemption *pb.IssueExemption) (*pb.IssueExemption, error) {
+	if exemption.GetDeviceID() == 0 && exemption.GetUsername() == "" && exemption.GetCheckID() == 0 {
+		return nil, ErrExemptionWithoutScope
+	}
+
+	row, err := db.queries.CreateIssueExemption(ctx, sqlc.CreateIssueExemptionParams{
+		DeviceID:  sql.NullInt64{Int64: exemption.GetDeviceID(), Valid: exemption.GetDeviceID() != 0},
+		Username:  sql.NullString{String: exemption.GetUsername(), Valid: exemption.GetUsername() != ""},
+		CheckID:   sql.NullInt64{Int64: exemption.GetCheckID(), Valid: exemption.GetCheckID() != 0},
+		Reason:    exemption.GetReason(),
+		CreatedBy: exemption.GetCreatedBy(),
+		Created:   time.Now().UTC().Format(formats.TimeFormat),
+		Expires:   exemption.GetExpires().AsTime().UTC().Format(formats.TimeFormat),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create issue exemption: %w", err)
+	}
+
+	return sqlcIssueExemptionToPbIssueExemption(row), nil
+}
+
+func (db *database) ReadIssueExemptions(ctx context.Context, activeOnly bool) ([]*pb.IssueExemption, error) {
+	rows, err := db.queries.GetIssueExemptions(ctx, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("read issue exemptions: %w", err)
+	}
+
+	ret := make([]*pb.IssueExemption, len(rows))
+	for i, row := range rows {
+		ret[i] = sqlcIssueExemptionToPbIssueExemption(row)
+	}
+
+	return ret, nil
+}
+
+// RevokeIssueExemption revokes a single exemption by id and returns it. Revoking an already revoked exemption is a no-op.
+func (db *database) RevokeIssueExemption(ctx context.Context, id int64) (*pb.IssueExemption, error) {
+	err := db.queries.RevokeIssueExemption(ctx, sqlc.RevokeIssueExemptionParams{
+		Revoked: sql.NullString{
+			String: time.Now().UTC().Format(formats.TimeFormat),
+			Valid:  true,
+		},
+		ID: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("revoke issue exemption: %w", err)
+	}
+
+	row, err := db.queries.GetIssueExemption(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("read issue exemption: %w", err)
+	}
+
+	return sqlcIssueExemptionToPbIssueExemption(row), nil
+}
+
+// applyIssueExemptions marks the issues covered by an active exemption for the device as suppressed.
+func (db *database) applyIssueExemptions(ctx context.Context, device *sqlc.Device, issues []*pb.DeviceIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	rows, err := db.queries.GetActiveIssueExemptionsForDevice(ctx, sqlc.GetActiveIssueExemptionsForDeviceParams{
+		DeviceID: sql.NullInt64{Int64: int64(device.ID), Valid: true},
+		Username: sql.NullString{String: device.Username, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("read issue exemptions: %w", err)
+	}
+
+	for _, issue := range issues {
+		for _, row := range rows {
+			if row.CheckID.Valid && row.CheckID.Int64 != issue.GetCheckID() {
+				continue
+			}
+			issue.Exemption = sqlcIssueExemptionToPbIssueExemption(row)
+			break
+		}
+	}
+
+	return nil
+}
+
+func sqlcIssueExemptionToPbIssueExemption(row *sqlc.IssueExemption) *pb.IssueExemption {
+	var revoked *timestamppb.Timestamp
+	if row.Revoked.Valid {
+		revoked = timestamppb.New(stringToTime(row.Revoked.String))
+	}
+
+	return &pb.IssueExemption{
+		Id:        row.ID,
+		DeviceID:  row.DeviceID.Int64,
+		Username:  row.Username.String,
+		CheckID:   row.CheckID.Int64,
+		Reason:    row.Reason,
+		CreatedBy: row.CreatedBy,
+		Created:   timestamppb.New(stringToTime(row.Created)),
+		Expires:   timestamppb.New(stringToTime(row.Expires)),
+		Revoked:   revoked,
+	}
+}
@@ -8,45 +8,19 @@ import (
 	"strings"
 
 	"github.com/nais/device/internal/apiserver/kolide"
+	"github.com/nais/device/internal/apiserver/posture"
 	"github.com/nais/device/internal/apiserver/sqlc"
 	"github.com/nais/device/internal/formats"
-	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
 )
 
-func kolideCheckSeverity(tags []string, log logrus.FieldLogger) pb.Severity {
-	highest := pb.Severity(-1)
-
-	for _, tag := range tags {
-		severity := pb.Severity(-1)
-
-		switch {
-		case strings.EqualFold(tag, pb.Severity_Info.String()):
-			severity = pb.Severity_Info
-		case strings.EqualFold(tag, pb.Severity_Notice.String()):
-			severity = pb.Severity_Notice
-		case strings.EqualFold(tag, pb.Severity_Attention.String()):
-			severity = pb.Severity_Attention
-		case strings.EqualFold(tag, pb.Severity_Warning.String()):
-			severity = pb.Severity_Warning
-		case strings.EqualFold(tag, pb.Severity_Danger.String()):
-			severity = pb.Severity_Danger
-		case strings.EqualFold(tag, pb.Severity_Critical.String()):
-			severity = pb.Severity_Critical
-		default:
-			log.WithField("tag", tag).Warn("Kolide severity parser: failed to parse tag")
-		}
-
-		if severity > highest {
-			highest = severity
-		}
-	}
-
-	if highest == -1 {
-		highest = pb.Severity_Warning
+func kolideCheckRating(policy *posture.Policy, checkID int64, tags []string, log logrus.FieldLogger) posture.Rating {
+	rating := policy.RateCheck(checkID, tags)
+	for _, tag := range rating.UnknownTags {
+		log.WithField("tag", tag).Warn("Kolide severity parser: failed to parse tag")
 	}
 
-	return highest
+	return rating
 }
 
 func (db *database) UpdateKolideChecks(ctx context.Context, checks []*kolide.Check) error {
@@ -72,7 +46,7 @@ func (db *database) UpdateKolideChecks(ctx context.Context, checks []*kolide.Che
 }
 
 func (db *database) UpdateKolideIssues(ctx context.Context, issues []*kolide.Issue) error {
-	return db.queries.Transaction(ctx, func(ctx context.Context, qtx *sqlc.Queries) error {
+	return db.queries.Transaction(ctx, func(ctx context.Context, qtx sqlc.Querier) error {
 		if err := qtx.TruncateKolideIssues(ctx); err != nil {
 			return fmt.Errorf("truncate Kolide issues: %w", err)
 		}
@@ -124,7 +98,7 @@ func (db *database) UpdateKolideIssuesForDevice(ctx context.Context, externalID
 		return fmt.Errorf("updating kolide issues for device: external ID is empty")
 	}
 
-	err := db.queries.Transaction(ctx, func(ctx context.Context, queries *sqlc.Queries) error {
+	err := db.queries.Transaction(ctx, func(ctx context.Context, queries sqlc.Querier) error {
 		if err := db.queries.DeleteKolideIssuesForDevice(ctx, externalID); err != nil {
 			return err
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/posture"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -16,23 +17,23 @@ func TestCheck(t *testing.T) {
 		severity pb.Severity
 		duration time.Duration
 	}{
-		{[]string{}, pb.Severity_Warning, DurationWarning},
-		{[]string{"foo", "bar"}, pb.Severity_Warning, DurationWarning},
-		{[]string{"foo", "notice"}, pb.Severity_Notice, DurationNotice},
-		{[]string{"warning", "notice", "danger"}, pb.Severity_Danger, DurationDanger},
-		{[]string{"notice"}, pb.Severity_Notice, DurationNotice},
-		{[]string{"warning"}, pb.Severity_Warning, DurationWarning},
-		{[]string{"danger"}, pb.Severity_Danger, DurationDanger},
-		{[]string{"critical"}, pb.Severity_Critical, DurationCritical},
-		{[]string{"attention"}, pb.Severity_Attention, DurationAttention},
+		{[]string{}, pb.Severity_Warning, posture.DurationWarning},
+		{[]string{"foo", "bar"}, pb.Severity_Warning, posture.DurationWarning},
+		{[]string{"foo", "notice"}, pb.Severity_Notice, posture.DurationNotice},
+		{[]string{"warning", "notice", "danger"}, pb.Severity_Danger, posture.DurationDanger},
+		{[]string{"notice"}, pb.Severity_Notice, posture.DurationNotice},
+		{[]string{"warning"}, pb.Severity_Warning, posture.DurationWarning},
+		{[]string{"danger"}, pb.Severity_Danger, posture.DurationDanger},
+		{[]string{"critical"}, pb.Severity_Critical, posture.DurationCritical},
+		{[]string{"attention"}, pb.Severity_Attention, posture.DurationAttention},
 	}
 
 	for _, tt := range tagTests {
 		t.Run(strings.Join(tt.tags, ", "), func(t *testing.T) {
-			severity := kolideCheckSeverity(tt.tags, logrus.New())
+			rating := kolideCheckRating(posture.DefaultPolicy(), 1, tt.tags, logrus.New())
 
-			assert.Equal(t, tt.severity, severity)
-			assert.Equal(t, tt.duration, graceTime(severity))
+			assert.Equal(t, tt.severity, rating.Severity)
+			assert.Equal(t, tt.duration, rating.GracePeriod)
 		})
 	}
 }
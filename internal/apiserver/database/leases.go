@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/sqlc"
+)
+
+// AcquireLease takes or renews the lease with the given name for holder, valid for ttl.
+// It returns false if the lease is held by someone else and has not yet expired.
+func (db *database) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	expires := timeToString(now.Add(ttl))
+
+	// the update only matches a lease we hold or one that has expired, and the insert only succeeds
+	// if there is no lease at all, so two holders can never both acquire it
+	n, err := db.queries.RenewLease(ctx, sqlc.RenewLeaseParams{
+		Name:    name,
+		Holder:  holder,
+		Expires: expires,
+		Now:     timeToString(now),
+	})
+	if err != nil {
+		return false, fmt.Errorf("renew lease: %w", err)
+	}
+
+	if n > 0 {
+		return true, nil
+	}
+
+	n, err = db.queries.CreateLease(ctx, sqlc.CreateLeaseParams{
+		Name:    name,
+		Holder:  holder,
+		Expires: expires,
+	})
+	if err != nil {
+		return false, fmt.Errorf("create lease: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+func (db *database) ReleaseLease(ctx context.Context, name, holder string) error {
+	return db.queries.ReleaseLease(ctx, sqlc.ReleaseLeaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+}
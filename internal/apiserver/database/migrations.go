@@ -1,14 +1,18 @@
 package database
 
 import (
+	"database/sql"
 	"errors"
 
 	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/nais/device/internal/apiserver/database/schema"
 	"github.com/nais/device/internal/ioconvenience"
 	"github.com/sirupsen/logrus"
+
+	postgresschema "github.com/nais/device/internal/apiserver/database/postgres/schema"
 )
 
 func runMigrations(dbPath string, log logrus.FieldLogger) error {
@@ -23,7 +27,31 @@ func runMigrations(dbPath string, log logrus.FieldLogger) error {
 		return err
 	}
 
-	err = m.Up()
+	return up(m)
+}
+
+func runPostgresMigrations(db *sql.DB, log logrus.FieldLogger) error {
+	sourceDriver, err := iofs.New(postgresschema.FS, ".")
+	if err != nil {
+		return err
+	}
+	defer ioconvenience.CloseWithLog(sourceDriver, log)
+
+	databaseDriver, err := pgxmigrate.WithInstance(db, &pgxmigrate.Config{})
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", sourceDriver, "pgx5", databaseDriver)
+	if err != nil {
+		return err
+	}
+
+	return up(m)
+}
+
+func up(m *migrate.Migrate) error {
+	err := m.Up()
 	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		return err
 	}
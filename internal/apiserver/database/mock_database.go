@@ -100,6 +100,159 @@ func (_c *MockDatabase_AcceptAcceptableUse_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// AcquireLease provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	ret := _mock.Called(ctx, name, holder, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireLease")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return returnFunc(ctx, name, holder, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = returnFunc(ctx, name, holder, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, name, holder, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_AcquireLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireLease'
+type MockDatabase_AcquireLease_Call struct {
+	*mock.Call
+}
+
+// AcquireLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - holder string
+//   - ttl time.Duration
+func (_e *MockDatabase_Expecter) AcquireLease(ctx interface{}, name interface{}, holder interface{}, ttl interface{}) *MockDatabase_AcquireLease_Call {
+	return &MockDatabase_AcquireLease_Call{Call: _e.mock.On("AcquireLease", ctx, name, holder, ttl)}
+}
+
+func (_c *MockDatabase_AcquireLease_Call) Run(run func(ctx context.Context, name string, holder string, ttl time.Duration)) *MockDatabase_AcquireLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_AcquireLease_Call) Return(b bool, err error) *MockDatabase_AcquireLease_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockDatabase_AcquireLease_Call) RunAndReturn(run func(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)) *MockDatabase_AcquireLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddAuditEvent provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) AddAuditEvent(ctx context.Context, actor string, action string, target string, reason string) error {
+	ret := _mock.Called(ctx, actor, action, target, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = returnFunc(ctx, actor, action, target, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type MockDatabase_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor string
+//   - action string
+//   - target string
+//   - reason string
+func (_e *MockDatabase_Expecter) AddAuditEvent(ctx interface{}, actor interface{}, action interface{}, target interface{}, reason interface{}) *MockDatabase_AddAuditEvent_Call {
+	return &MockDatabase_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, actor, action, target, reason)}
+}
+
+func (_c *MockDatabase_AddAuditEvent_Call) Run(run func(ctx context.Context, actor string, action string, target string, reason string)) *MockDatabase_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_AddAuditEvent_Call) Return(err error) *MockDatabase_AddAuditEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_AddAuditEvent_Call) RunAndReturn(run func(ctx context.Context, actor string, action string, target string, reason string) error) *MockDatabase_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddDevice provides a mock function for the type MockDatabase
 func (_mock *MockDatabase) AddDevice(ctx context.Context, device *pb.Device) error {
 	ret := _mock.Called(ctx, device)
@@ -271,117 +424,133 @@ func (_c *MockDatabase_AddSessionInfo_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
-// GetAcceptances provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) GetAcceptances(ctx context.Context) (map[string]struct{}, error) {
-	ret := _mock.Called(ctx)
+// CountGatewayJitaGrantsForUserSince provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) CountGatewayJitaGrantsForUserSince(ctx context.Context, userID string, gatewayName string, since time.Time) (int, error) {
+	ret := _mock.Called(ctx, userID, gatewayName, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAcceptances")
+		panic("no return value specified for CountGatewayJitaGrantsForUserSince")
 	}
 
-	var r0 map[string]struct{}
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]struct{}, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (int, error)); ok {
+		return returnFunc(ctx, userID, gatewayName, since)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]struct{}); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) int); ok {
+		r0 = returnFunc(ctx, userID, gatewayName, since)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]struct{})
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, gatewayName, since)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_GetAcceptances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAcceptances'
-type MockDatabase_GetAcceptances_Call struct {
+// MockDatabase_CountGatewayJitaGrantsForUserSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountGatewayJitaGrantsForUserSince'
+type MockDatabase_CountGatewayJitaGrantsForUserSince_Call struct {
 	*mock.Call
 }
 
-// GetAcceptances is a helper method to define mock.On call
+// CountGatewayJitaGrantsForUserSince is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) GetAcceptances(ctx interface{}) *MockDatabase_GetAcceptances_Call {
-	return &MockDatabase_GetAcceptances_Call{Call: _e.mock.On("GetAcceptances", ctx)}
+//   - userID string
+//   - gatewayName string
+//   - since time.Time
+func (_e *MockDatabase_Expecter) CountGatewayJitaGrantsForUserSince(ctx interface{}, userID interface{}, gatewayName interface{}, since interface{}) *MockDatabase_CountGatewayJitaGrantsForUserSince_Call {
+	return &MockDatabase_CountGatewayJitaGrantsForUserSince_Call{Call: _e.mock.On("CountGatewayJitaGrantsForUserSince", ctx, userID, gatewayName, since)}
 }
 
-func (_c *MockDatabase_GetAcceptances_Call) Run(run func(ctx context.Context)) *MockDatabase_GetAcceptances_Call {
+func (_c *MockDatabase_CountGatewayJitaGrantsForUserSince_Call) Run(run func(ctx context.Context, userID string, gatewayName string, since time.Time)) *MockDatabase_CountGatewayJitaGrantsForUserSince_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_GetAcceptances_Call) Return(stringToVal map[string]struct{}, err error) *MockDatabase_GetAcceptances_Call {
-	_c.Call.Return(stringToVal, err)
+func (_c *MockDatabase_CountGatewayJitaGrantsForUserSince_Call) Return(n int, err error) *MockDatabase_CountGatewayJitaGrantsForUserSince_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockDatabase_GetAcceptances_Call) RunAndReturn(run func(ctx context.Context) (map[string]struct{}, error)) *MockDatabase_GetAcceptances_Call {
+func (_c *MockDatabase_CountGatewayJitaGrantsForUserSince_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string, since time.Time) (int, error)) *MockDatabase_CountGatewayJitaGrantsForUserSince_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAcceptedAt provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) GetAcceptedAt(ctx context.Context, userID string) (*timestamppb.Timestamp, error) {
-	ret := _mock.Called(ctx, userID)
+// CreateIssueExemption provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) CreateIssueExemption(ctx context.Context, exemption *pb.IssueExemption) (*pb.IssueExemption, error) {
+	ret := _mock.Called(ctx, exemption)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAcceptedAt")
+		panic("no return value specified for CreateIssueExemption")
 	}
 
-	var r0 *timestamppb.Timestamp
+	var r0 *pb.IssueExemption
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*timestamppb.Timestamp, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.IssueExemption) (*pb.IssueExemption, error)); ok {
+		return returnFunc(ctx, exemption)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *timestamppb.Timestamp); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pb.IssueExemption) *pb.IssueExemption); ok {
+		r0 = returnFunc(ctx, exemption)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*timestamppb.Timestamp)
+			r0 = ret.Get(0).(*pb.IssueExemption)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *pb.IssueExemption) error); ok {
+		r1 = returnFunc(ctx, exemption)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_GetAcceptedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAcceptedAt'
-type MockDatabase_GetAcceptedAt_Call struct {
+// MockDatabase_CreateIssueExemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIssueExemption'
+type MockDatabase_CreateIssueExemption_Call struct {
 	*mock.Call
 }
 
-// GetAcceptedAt is a helper method to define mock.On call
+// CreateIssueExemption is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockDatabase_Expecter) GetAcceptedAt(ctx interface{}, userID interface{}) *MockDatabase_GetAcceptedAt_Call {
-	return &MockDatabase_GetAcceptedAt_Call{Call: _e.mock.On("GetAcceptedAt", ctx, userID)}
+//   - exemption *pb.IssueExemption
+func (_e *MockDatabase_Expecter) CreateIssueExemption(ctx interface{}, exemption interface{}) *MockDatabase_CreateIssueExemption_Call {
+	return &MockDatabase_CreateIssueExemption_Call{Call: _e.mock.On("CreateIssueExemption", ctx, exemption)}
 }
 
-func (_c *MockDatabase_GetAcceptedAt_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_GetAcceptedAt_Call {
+func (_c *MockDatabase_CreateIssueExemption_Call) Run(run func(ctx context.Context, exemption *pb.IssueExemption)) *MockDatabase_CreateIssueExemption_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *pb.IssueExemption
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*pb.IssueExemption)
 		}
 		run(
 			arg0,
@@ -391,65 +560,54 @@ func (_c *MockDatabase_GetAcceptedAt_Call) Run(run func(ctx context.Context, use
 	return _c
 }
 
-func (_c *MockDatabase_GetAcceptedAt_Call) Return(timestamp *timestamppb.Timestamp, err error) *MockDatabase_GetAcceptedAt_Call {
-	_c.Call.Return(timestamp, err)
+func (_c *MockDatabase_CreateIssueExemption_Call) Return(issueExemption *pb.IssueExemption, err error) *MockDatabase_CreateIssueExemption_Call {
+	_c.Call.Return(issueExemption, err)
 	return _c
 }
 
-func (_c *MockDatabase_GetAcceptedAt_Call) RunAndReturn(run func(ctx context.Context, userID string) (*timestamppb.Timestamp, error)) *MockDatabase_GetAcceptedAt_Call {
+func (_c *MockDatabase_CreateIssueExemption_Call) RunAndReturn(run func(ctx context.Context, exemption *pb.IssueExemption) (*pb.IssueExemption, error)) *MockDatabase_CreateIssueExemption_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGatewayJitaGrantsForUser provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*pb.GatewayJitaGrant, error) {
-	ret := _mock.Called(ctx, userID)
+// DeleteDevice provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) DeleteDevice(ctx context.Context, deviceID int64) error {
+	ret := _mock.Called(ctx, deviceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGatewayJitaGrantsForUser")
+		panic("no return value specified for DeleteDevice")
 	}
 
-	var r0 []*pb.GatewayJitaGrant
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*pb.GatewayJitaGrant, error)); ok {
-		return returnFunc(ctx, userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*pb.GatewayJitaGrant); ok {
-		r0 = returnFunc(ctx, userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*pb.GatewayJitaGrant)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, deviceID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_GetGatewayJitaGrantsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayJitaGrantsForUser'
-type MockDatabase_GetGatewayJitaGrantsForUser_Call struct {
+// MockDatabase_DeleteDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDevice'
+type MockDatabase_DeleteDevice_Call struct {
 	*mock.Call
 }
 
-// GetGatewayJitaGrantsForUser is a helper method to define mock.On call
+// DeleteDevice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockDatabase_Expecter) GetGatewayJitaGrantsForUser(ctx interface{}, userID interface{}) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
-	return &MockDatabase_GetGatewayJitaGrantsForUser_Call{Call: _e.mock.On("GetGatewayJitaGrantsForUser", ctx, userID)}
+//   - deviceID int64
+func (_e *MockDatabase_Expecter) DeleteDevice(ctx interface{}, deviceID interface{}) *MockDatabase_DeleteDevice_Call {
+	return &MockDatabase_DeleteDevice_Call{Call: _e.mock.On("DeleteDevice", ctx, deviceID)}
 }
 
-func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
+func (_c *MockDatabase_DeleteDevice_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_DeleteDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -459,49 +617,46 @@ func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) Return(gatewayJitaGrants []*pb.GatewayJitaGrant, err error) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
-	_c.Call.Return(gatewayJitaGrants, err)
+func (_c *MockDatabase_DeleteDevice_Call) Return(err error) *MockDatabase_DeleteDevice_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*pb.GatewayJitaGrant, error)) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
+func (_c *MockDatabase_DeleteDevice_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) error) *MockDatabase_DeleteDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GrantPrivilegedGatewayAccess provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) GrantPrivilegedGatewayAccess(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error {
-	ret := _mock.Called(ctx, userID, gatewayName, expires, reason)
+// DeleteGateway provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) DeleteGateway(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GrantPrivilegedGatewayAccess")
+		panic("no return value specified for DeleteGateway")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) error); ok {
-		r0 = returnFunc(ctx, userID, gatewayName, expires, reason)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockDatabase_GrantPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantPrivilegedGatewayAccess'
-type MockDatabase_GrantPrivilegedGatewayAccess_Call struct {
+// MockDatabase_DeleteGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGateway'
+type MockDatabase_DeleteGateway_Call struct {
 	*mock.Call
 }
 
-// GrantPrivilegedGatewayAccess is a helper method to define mock.On call
+// DeleteGateway is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - gatewayName string
-//   - expires time.Time
-//   - reason string
-func (_e *MockDatabase_Expecter) GrantPrivilegedGatewayAccess(ctx interface{}, userID interface{}, gatewayName interface{}, expires interface{}, reason interface{}) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
-	return &MockDatabase_GrantPrivilegedGatewayAccess_Call{Call: _e.mock.On("GrantPrivilegedGatewayAccess", ctx, userID, gatewayName, expires, reason)}
+//   - name string
+func (_e *MockDatabase_Expecter) DeleteGateway(ctx interface{}, name interface{}) *MockDatabase_DeleteGateway_Call {
+	return &MockDatabase_DeleteGateway_Call{Call: _e.mock.On("DeleteGateway", ctx, name)}
 }
 
-func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string)) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_DeleteGateway_Call) Run(run func(ctx context.Context, name string)) *MockDatabase_DeleteGateway_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -511,149 +666,127 @@ func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) Run(run func(ctx conte
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 time.Time
-		if args[3] != nil {
-			arg3 = args[3].(time.Time)
-		}
-		var arg4 string
-		if args[4] != nil {
-			arg4 = args[4].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) Return(err error) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_DeleteGateway_Call) Return(err error) *MockDatabase_DeleteGateway_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_DeleteGateway_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockDatabase_DeleteGateway_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LinkKolideDevice provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) LinkKolideDevice(ctx context.Context, externalID string, serial string, platform string) error {
-	ret := _mock.Called(ctx, externalID, serial, platform)
+// GetAcceptances provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) GetAcceptances(ctx context.Context) (map[string]struct{}, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LinkKolideDevice")
+		panic("no return value specified for GetAcceptances")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = returnFunc(ctx, externalID, serial, platform)
+	var r0 map[string]struct{}
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]struct{}, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]struct{}); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]struct{})
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockDatabase_LinkKolideDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkKolideDevice'
-type MockDatabase_LinkKolideDevice_Call struct {
+// MockDatabase_GetAcceptances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAcceptances'
+type MockDatabase_GetAcceptances_Call struct {
 	*mock.Call
 }
 
-// LinkKolideDevice is a helper method to define mock.On call
+// GetAcceptances is a helper method to define mock.On call
 //   - ctx context.Context
-//   - externalID string
-//   - serial string
-//   - platform string
-func (_e *MockDatabase_Expecter) LinkKolideDevice(ctx interface{}, externalID interface{}, serial interface{}, platform interface{}) *MockDatabase_LinkKolideDevice_Call {
-	return &MockDatabase_LinkKolideDevice_Call{Call: _e.mock.On("LinkKolideDevice", ctx, externalID, serial, platform)}
+func (_e *MockDatabase_Expecter) GetAcceptances(ctx interface{}) *MockDatabase_GetAcceptances_Call {
+	return &MockDatabase_GetAcceptances_Call{Call: _e.mock.On("GetAcceptances", ctx)}
 }
 
-func (_c *MockDatabase_LinkKolideDevice_Call) Run(run func(ctx context.Context, externalID string, serial string, platform string)) *MockDatabase_LinkKolideDevice_Call {
+func (_c *MockDatabase_GetAcceptances_Call) Run(run func(ctx context.Context)) *MockDatabase_GetAcceptances_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
 		run(
 			arg0,
-			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_LinkKolideDevice_Call) Return(err error) *MockDatabase_LinkKolideDevice_Call {
-	_c.Call.Return(err)
+func (_c *MockDatabase_GetAcceptances_Call) Return(stringToVal map[string]struct{}, err error) *MockDatabase_GetAcceptances_Call {
+	_c.Call.Return(stringToVal, err)
 	return _c
 }
 
-func (_c *MockDatabase_LinkKolideDevice_Call) RunAndReturn(run func(ctx context.Context, externalID string, serial string, platform string) error) *MockDatabase_LinkKolideDevice_Call {
+func (_c *MockDatabase_GetAcceptances_Call) RunAndReturn(run func(ctx context.Context) (map[string]struct{}, error)) *MockDatabase_GetAcceptances_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadDevice provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadDevice(ctx context.Context, publicKey string) (*pb.Device, error) {
-	ret := _mock.Called(ctx, publicKey)
+// GetAcceptedAt provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) GetAcceptedAt(ctx context.Context, userID string) (*timestamppb.Timestamp, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadDevice")
+		panic("no return value specified for GetAcceptedAt")
 	}
 
-	var r0 *pb.Device
+	var r0 *timestamppb.Timestamp
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Device, error)); ok {
-		return returnFunc(ctx, publicKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*timestamppb.Timestamp, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Device); ok {
-		r0 = returnFunc(ctx, publicKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *timestamppb.Timestamp); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Device)
+			r0 = ret.Get(0).(*timestamppb.Timestamp)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, publicKey)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_ReadDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDevice'
-type MockDatabase_ReadDevice_Call struct {
+// MockDatabase_GetAcceptedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAcceptedAt'
+type MockDatabase_GetAcceptedAt_Call struct {
 	*mock.Call
 }
 
-// ReadDevice is a helper method to define mock.On call
+// GetAcceptedAt is a helper method to define mock.On call
 //   - ctx context.Context
-//   - publicKey string
-func (_e *MockDatabase_Expecter) ReadDevice(ctx interface{}, publicKey interface{}) *MockDatabase_ReadDevice_Call {
-	return &MockDatabase_ReadDevice_Call{Call: _e.mock.On("ReadDevice", ctx, publicKey)}
+//   - userID string
+func (_e *MockDatabase_Expecter) GetAcceptedAt(ctx interface{}, userID interface{}) *MockDatabase_GetAcceptedAt_Call {
+	return &MockDatabase_GetAcceptedAt_Call{Call: _e.mock.On("GetAcceptedAt", ctx, userID)}
 }
 
-func (_c *MockDatabase_ReadDevice_Call) Run(run func(ctx context.Context, publicKey string)) *MockDatabase_ReadDevice_Call {
+func (_c *MockDatabase_GetAcceptedAt_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_GetAcceptedAt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -671,57 +804,57 @@ func (_c *MockDatabase_ReadDevice_Call) Run(run func(ctx context.Context, public
 	return _c
 }
 
-func (_c *MockDatabase_ReadDevice_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDevice_Call {
-	_c.Call.Return(device, err)
+func (_c *MockDatabase_GetAcceptedAt_Call) Return(timestamp *timestamppb.Timestamp, err error) *MockDatabase_GetAcceptedAt_Call {
+	_c.Call.Return(timestamp, err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadDevice_Call) RunAndReturn(run func(ctx context.Context, publicKey string) (*pb.Device, error)) *MockDatabase_ReadDevice_Call {
+func (_c *MockDatabase_GetAcceptedAt_Call) RunAndReturn(run func(ctx context.Context, userID string) (*timestamppb.Timestamp, error)) *MockDatabase_GetAcceptedAt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadDeviceByExternalID provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadDeviceByExternalID(ctx context.Context, externalID string) (*pb.Device, error) {
-	ret := _mock.Called(ctx, externalID)
+// GetGatewayJitaGrantsForUser provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadDeviceByExternalID")
+		panic("no return value specified for GetGatewayJitaGrantsForUser")
 	}
 
-	var r0 *pb.Device
+	var r0 []*pb.GatewayJitaGrant
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Device, error)); ok {
-		return returnFunc(ctx, externalID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Device); ok {
-		r0 = returnFunc(ctx, externalID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Device)
+			r0 = ret.Get(0).([]*pb.GatewayJitaGrant)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, externalID)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_ReadDeviceByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceByExternalID'
-type MockDatabase_ReadDeviceByExternalID_Call struct {
+// MockDatabase_GetGatewayJitaGrantsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayJitaGrantsForUser'
+type MockDatabase_GetGatewayJitaGrantsForUser_Call struct {
 	*mock.Call
 }
 
-// ReadDeviceByExternalID is a helper method to define mock.On call
+// GetGatewayJitaGrantsForUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - externalID string
-func (_e *MockDatabase_Expecter) ReadDeviceByExternalID(ctx interface{}, externalID interface{}) *MockDatabase_ReadDeviceByExternalID_Call {
-	return &MockDatabase_ReadDeviceByExternalID_Call{Call: _e.mock.On("ReadDeviceByExternalID", ctx, externalID)}
+//   - userID string
+func (_e *MockDatabase_Expecter) GetGatewayJitaGrantsForUser(ctx interface{}, userID interface{}) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
+	return &MockDatabase_GetGatewayJitaGrantsForUser_Call{Call: _e.mock.On("GetGatewayJitaGrantsForUser", ctx, userID)}
 }
 
-func (_c *MockDatabase_ReadDeviceByExternalID_Call) Run(run func(ctx context.Context, externalID string)) *MockDatabase_ReadDeviceByExternalID_Call {
+func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -739,126 +872,1550 @@ func (_c *MockDatabase_ReadDeviceByExternalID_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceByExternalID_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceByExternalID_Call {
-	_c.Call.Return(device, err)
+func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) Return(gatewayJitaGrants []*pb.GatewayJitaGrant, err error) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
+	_c.Call.Return(gatewayJitaGrants, err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceByExternalID_Call) RunAndReturn(run func(ctx context.Context, externalID string) (*pb.Device, error)) *MockDatabase_ReadDeviceByExternalID_Call {
+func (_c *MockDatabase_GetGatewayJitaGrantsForUser_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*pb.GatewayJitaGrant, error)) *MockDatabase_GetGatewayJitaGrantsForUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadDeviceByID provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadDeviceByID(ctx context.Context, deviceID int64) (*pb.Device, error) {
-	ret := _mock.Called(ctx, deviceID)
+// GrantPrivilegedGatewayAccess provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) GrantPrivilegedGatewayAccess(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error {
+	ret := _mock.Called(ctx, userID, gatewayName, expires, reason)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadDeviceByID")
+		panic("no return value specified for GrantPrivilegedGatewayAccess")
 	}
 
-	var r0 *pb.Device
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.Device, error)); ok {
-		return returnFunc(ctx, deviceID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.Device); ok {
-		r0 = returnFunc(ctx, deviceID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Device)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, deviceID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) error); ok {
+		r0 = returnFunc(ctx, userID, gatewayName, expires, reason)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadDeviceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceByID'
-type MockDatabase_ReadDeviceByID_Call struct {
+// MockDatabase_GrantPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantPrivilegedGatewayAccess'
+type MockDatabase_GrantPrivilegedGatewayAccess_Call struct {
 	*mock.Call
 }
 
-// ReadDeviceByID is a helper method to define mock.On call
+// GrantPrivilegedGatewayAccess is a helper method to define mock.On call
 //   - ctx context.Context
-//   - deviceID int64
-func (_e *MockDatabase_Expecter) ReadDeviceByID(ctx interface{}, deviceID interface{}) *MockDatabase_ReadDeviceByID_Call {
-	return &MockDatabase_ReadDeviceByID_Call{Call: _e.mock.On("ReadDeviceByID", ctx, deviceID)}
+//   - userID string
+//   - gatewayName string
+//   - expires time.Time
+//   - reason string
+func (_e *MockDatabase_Expecter) GrantPrivilegedGatewayAccess(ctx interface{}, userID interface{}, gatewayName interface{}, expires interface{}, reason interface{}) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
+	return &MockDatabase_GrantPrivilegedGatewayAccess_Call{Call: _e.mock.On("GrantPrivilegedGatewayAccess", ctx, userID, gatewayName, expires, reason)}
 }
 
-func (_c *MockDatabase_ReadDeviceByID_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_ReadDeviceByID_Call {
+func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string)) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceByID_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceByID_Call {
-	_c.Call.Return(device, err)
+func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) Return(err error) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceByID_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) (*pb.Device, error)) *MockDatabase_ReadDeviceByID_Call {
+func (_c *MockDatabase_GrantPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error) *MockDatabase_GrantPrivilegedGatewayAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadDeviceBySerialPlatform provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadDeviceBySerialPlatform(ctx context.Context, serial string, platform string) (*pb.Device, error) {
-	ret := _mock.Called(ctx, serial, platform)
+// LinkKolideDevice provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) LinkKolideDevice(ctx context.Context, externalID string, serial string, platform string) error {
+	ret := _mock.Called(ctx, externalID, serial, platform)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkKolideDevice")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, externalID, serial, platform)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_LinkKolideDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkKolideDevice'
+type MockDatabase_LinkKolideDevice_Call struct {
+	*mock.Call
+}
+
+// LinkKolideDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID string
+//   - serial string
+//   - platform string
+func (_e *MockDatabase_Expecter) LinkKolideDevice(ctx interface{}, externalID interface{}, serial interface{}, platform interface{}) *MockDatabase_LinkKolideDevice_Call {
+	return &MockDatabase_LinkKolideDevice_Call{Call: _e.mock.On("LinkKolideDevice", ctx, externalID, serial, platform)}
+}
+
+func (_c *MockDatabase_LinkKolideDevice_Call) Run(run func(ctx context.Context, externalID string, serial string, platform string)) *MockDatabase_LinkKolideDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_LinkKolideDevice_Call) Return(err error) *MockDatabase_LinkKolideDevice_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_LinkKolideDevice_Call) RunAndReturn(run func(ctx context.Context, externalID string, serial string, platform string) error) *MockDatabase_LinkKolideDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAuditEvents provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadAuditEvents(ctx context.Context, filter AuditEventFilter) ([]*pb.AuditEvent, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAuditEvents")
+	}
+
+	var r0 []*pb.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, AuditEventFilter) ([]*pb.AuditEvent, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, AuditEventFilter) []*pb.AuditEvent); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, AuditEventFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAuditEvents'
+type MockDatabase_ReadAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ReadAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter AuditEventFilter
+func (_e *MockDatabase_Expecter) ReadAuditEvents(ctx interface{}, filter interface{}) *MockDatabase_ReadAuditEvents_Call {
+	return &MockDatabase_ReadAuditEvents_Call{Call: _e.mock.On("ReadAuditEvents", ctx, filter)}
+}
+
+func (_c *MockDatabase_ReadAuditEvents_Call) Run(run func(ctx context.Context, filter AuditEventFilter)) *MockDatabase_ReadAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 AuditEventFilter
+		if args[1] != nil {
+			arg1 = args[1].(AuditEventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadAuditEvents_Call) Return(auditEvents []*pb.AuditEvent, err error) *MockDatabase_ReadAuditEvents_Call {
+	_c.Call.Return(auditEvents, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadAuditEvents_Call) RunAndReturn(run func(ctx context.Context, filter AuditEventFilter) ([]*pb.AuditEvent, error)) *MockDatabase_ReadAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDevice provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadDevice(ctx context.Context, publicKey string) (*pb.Device, error) {
+	ret := _mock.Called(ctx, publicKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDevice")
+	}
+
+	var r0 *pb.Device
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Device, error)); ok {
+		return returnFunc(ctx, publicKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Device); ok {
+		r0 = returnFunc(ctx, publicKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Device)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, publicKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDevice'
+type MockDatabase_ReadDevice_Call struct {
+	*mock.Call
+}
+
+// ReadDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publicKey string
+func (_e *MockDatabase_Expecter) ReadDevice(ctx interface{}, publicKey interface{}) *MockDatabase_ReadDevice_Call {
+	return &MockDatabase_ReadDevice_Call{Call: _e.mock.On("ReadDevice", ctx, publicKey)}
+}
+
+func (_c *MockDatabase_ReadDevice_Call) Run(run func(ctx context.Context, publicKey string)) *MockDatabase_ReadDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadDevice_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDevice_Call {
+	_c.Call.Return(device, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadDevice_Call) RunAndReturn(run func(ctx context.Context, publicKey string) (*pb.Device, error)) *MockDatabase_ReadDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDeviceByExternalID provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadDeviceByExternalID(ctx context.Context, externalID string) (*pb.Device, error) {
+	ret := _mock.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDeviceByExternalID")
+	}
+
+	var r0 *pb.Device
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Device, error)); ok {
+		return returnFunc(ctx, externalID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Device); ok {
+		r0 = returnFunc(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Device)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadDeviceByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceByExternalID'
+type MockDatabase_ReadDeviceByExternalID_Call struct {
+	*mock.Call
+}
+
+// ReadDeviceByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID string
+func (_e *MockDatabase_Expecter) ReadDeviceByExternalID(ctx interface{}, externalID interface{}) *MockDatabase_ReadDeviceByExternalID_Call {
+	return &MockDatabase_ReadDeviceByExternalID_Call{Call: _e.mock.On("ReadDeviceByExternalID", ctx, externalID)}
+}
+
+func (_c *MockDatabase_ReadDeviceByExternalID_Call) Run(run func(ctx context.Context, externalID string)) *MockDatabase_ReadDeviceByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceByExternalID_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceByExternalID_Call {
+	_c.Call.Return(device, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceByExternalID_Call) RunAndReturn(run func(ctx context.Context, externalID string) (*pb.Device, error)) *MockDatabase_ReadDeviceByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDeviceByID provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadDeviceByID(ctx context.Context, deviceID int64) (*pb.Device, error) {
+	ret := _mock.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDeviceByID")
+	}
+
+	var r0 *pb.Device
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.Device, error)); ok {
+		return returnFunc(ctx, deviceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.Device); ok {
+		r0 = returnFunc(ctx, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Device)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadDeviceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceByID'
+type MockDatabase_ReadDeviceByID_Call struct {
+	*mock.Call
+}
+
+// ReadDeviceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int64
+func (_e *MockDatabase_Expecter) ReadDeviceByID(ctx interface{}, deviceID interface{}) *MockDatabase_ReadDeviceByID_Call {
+	return &MockDatabase_ReadDeviceByID_Call{Call: _e.mock.On("ReadDeviceByID", ctx, deviceID)}
+}
+
+func (_c *MockDatabase_ReadDeviceByID_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_ReadDeviceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceByID_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceByID_Call {
+	_c.Call.Return(device, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceByID_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) (*pb.Device, error)) *MockDatabase_ReadDeviceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDeviceBySerialPlatform provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadDeviceBySerialPlatform(ctx context.Context, serial string, platform string) (*pb.Device, error) {
+	ret := _mock.Called(ctx, serial, platform)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDeviceBySerialPlatform")
+	}
+
+	var r0 *pb.Device
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*pb.Device, error)); ok {
+		return returnFunc(ctx, serial, platform)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *pb.Device); ok {
+		r0 = returnFunc(ctx, serial, platform)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Device)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, serial, platform)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadDeviceBySerialPlatform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceBySerialPlatform'
+type MockDatabase_ReadDeviceBySerialPlatform_Call struct {
+	*mock.Call
+}
+
+// ReadDeviceBySerialPlatform is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serial string
+//   - platform string
+func (_e *MockDatabase_Expecter) ReadDeviceBySerialPlatform(ctx interface{}, serial interface{}, platform interface{}) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+	return &MockDatabase_ReadDeviceBySerialPlatform_Call{Call: _e.mock.On("ReadDeviceBySerialPlatform", ctx, serial, platform)}
+}
+
+func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) Run(run func(ctx context.Context, serial string, platform string)) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+	_c.Call.Return(device, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) RunAndReturn(run func(ctx context.Context, serial string, platform string) (*pb.Device, error)) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDevices provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadDevices(ctx context.Context) ([]*pb.Device, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDevices")
+	}
+
+	var r0 []*pb.Device
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Device, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Device); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Device)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDevices'
+type MockDatabase_ReadDevices_Call struct {
+	*mock.Call
+}
+
+// ReadDevices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadDevices(ctx interface{}) *MockDatabase_ReadDevices_Call {
+	return &MockDatabase_ReadDevices_Call{Call: _e.mock.On("ReadDevices", ctx)}
+}
+
+func (_c *MockDatabase_ReadDevices_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadDevices_Call) Return(devices []*pb.Device, err error) *MockDatabase_ReadDevices_Call {
+	_c.Call.Return(devices, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadDevices_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Device, error)) *MockDatabase_ReadDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGateway provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadGateway(ctx context.Context, name string) (*pb.Gateway, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGateway")
+	}
+
+	var r0 *pb.Gateway
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Gateway, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Gateway); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Gateway)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGateway'
+type MockDatabase_ReadGateway_Call struct {
+	*mock.Call
+}
+
+// ReadGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockDatabase_Expecter) ReadGateway(ctx interface{}, name interface{}) *MockDatabase_ReadGateway_Call {
+	return &MockDatabase_ReadGateway_Call{Call: _e.mock.On("ReadGateway", ctx, name)}
+}
+
+func (_c *MockDatabase_ReadGateway_Call) Run(run func(ctx context.Context, name string)) *MockDatabase_ReadGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadGateway_Call) Return(gateway *pb.Gateway, err error) *MockDatabase_ReadGateway_Call {
+	_c.Call.Return(gateway, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadGateway_Call) RunAndReturn(run func(ctx context.Context, name string) (*pb.Gateway, error)) *MockDatabase_ReadGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGatewayJitaGrant provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGatewayJitaGrant")
+	}
+
+	var r0 *pb.GatewayJitaGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GatewayJitaGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadGatewayJitaGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGatewayJitaGrant'
+type MockDatabase_ReadGatewayJitaGrant_Call struct {
+	*mock.Call
+}
+
+// ReadGatewayJitaGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockDatabase_Expecter) ReadGatewayJitaGrant(ctx interface{}, id interface{}) *MockDatabase_ReadGatewayJitaGrant_Call {
+	return &MockDatabase_ReadGatewayJitaGrant_Call{Call: _e.mock.On("ReadGatewayJitaGrant", ctx, id)}
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrant_Call) Run(run func(ctx context.Context, id int64)) *MockDatabase_ReadGatewayJitaGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrant_Call) Return(gatewayJitaGrant *pb.GatewayJitaGrant, err error) *MockDatabase_ReadGatewayJitaGrant_Call {
+	_c.Call.Return(gatewayJitaGrant, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrant_Call) RunAndReturn(run func(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error)) *MockDatabase_ReadGatewayJitaGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGatewayJitaGrants provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadGatewayJitaGrants(ctx context.Context, filter GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGatewayJitaGrants")
+	}
+
+	var r0 []*pb.GatewayJitaGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, GatewayJitaGrantFilter) []*pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.GatewayJitaGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, GatewayJitaGrantFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadGatewayJitaGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGatewayJitaGrants'
+type MockDatabase_ReadGatewayJitaGrants_Call struct {
+	*mock.Call
+}
+
+// ReadGatewayJitaGrants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter GatewayJitaGrantFilter
+func (_e *MockDatabase_Expecter) ReadGatewayJitaGrants(ctx interface{}, filter interface{}) *MockDatabase_ReadGatewayJitaGrants_Call {
+	return &MockDatabase_ReadGatewayJitaGrants_Call{Call: _e.mock.On("ReadGatewayJitaGrants", ctx, filter)}
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrants_Call) Run(run func(ctx context.Context, filter GatewayJitaGrantFilter)) *MockDatabase_ReadGatewayJitaGrants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 GatewayJitaGrantFilter
+		if args[1] != nil {
+			arg1 = args[1].(GatewayJitaGrantFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrants_Call) Return(gatewayJitaGrants []*pb.GatewayJitaGrant, err error) *MockDatabase_ReadGatewayJitaGrants_Call {
+	_c.Call.Return(gatewayJitaGrants, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadGatewayJitaGrants_Call) RunAndReturn(run func(ctx context.Context, filter GatewayJitaGrantFilter) ([]*pb.GatewayJitaGrant, error)) *MockDatabase_ReadGatewayJitaGrants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadGateways provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadGateways(ctx context.Context) ([]*pb.Gateway, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadGateways")
+	}
+
+	var r0 []*pb.Gateway
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Gateway, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Gateway); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Gateway)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadGateways_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGateways'
+type MockDatabase_ReadGateways_Call struct {
+	*mock.Call
+}
+
+// ReadGateways is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadGateways(ctx interface{}) *MockDatabase_ReadGateways_Call {
+	return &MockDatabase_ReadGateways_Call{Call: _e.mock.On("ReadGateways", ctx)}
+}
+
+func (_c *MockDatabase_ReadGateways_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadGateways_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadGateways_Call) Return(gateways []*pb.Gateway, err error) *MockDatabase_ReadGateways_Call {
+	_c.Call.Return(gateways, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadGateways_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Gateway, error)) *MockDatabase_ReadGateways_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadIssueExemptions provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadIssueExemptions(ctx context.Context, activeOnly bool) ([]*pb.IssueExemption, error) {
+	ret := _mock.Called(ctx, activeOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadIssueExemptions")
+	}
+
+	var r0 []*pb.IssueExemption
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]*pb.IssueExemption, error)); ok {
+		return returnFunc(ctx, activeOnly)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []*pb.IssueExemption); ok {
+		r0 = returnFunc(ctx, activeOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.IssueExemption)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, activeOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadIssueExemptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadIssueExemptions'
+type MockDatabase_ReadIssueExemptions_Call struct {
+	*mock.Call
+}
+
+// ReadIssueExemptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activeOnly bool
+func (_e *MockDatabase_Expecter) ReadIssueExemptions(ctx interface{}, activeOnly interface{}) *MockDatabase_ReadIssueExemptions_Call {
+	return &MockDatabase_ReadIssueExemptions_Call{Call: _e.mock.On("ReadIssueExemptions", ctx, activeOnly)}
+}
+
+func (_c *MockDatabase_ReadIssueExemptions_Call) Run(run func(ctx context.Context, activeOnly bool)) *MockDatabase_ReadIssueExemptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadIssueExemptions_Call) Return(issueExemptions []*pb.IssueExemption, err error) *MockDatabase_ReadIssueExemptions_Call {
+	_c.Call.Return(issueExemptions, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadIssueExemptions_Call) RunAndReturn(run func(ctx context.Context, activeOnly bool) ([]*pb.IssueExemption, error)) *MockDatabase_ReadIssueExemptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadKolideChecks provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadKolideChecks(ctx context.Context) (map[int64]*sqlc.KolideCheck, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadKolideChecks")
+	}
+
+	var r0 map[int64]*sqlc.KolideCheck
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[int64]*sqlc.KolideCheck, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[int64]*sqlc.KolideCheck); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*sqlc.KolideCheck)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadKolideChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadKolideChecks'
+type MockDatabase_ReadKolideChecks_Call struct {
+	*mock.Call
+}
+
+// ReadKolideChecks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadKolideChecks(ctx interface{}) *MockDatabase_ReadKolideChecks_Call {
+	return &MockDatabase_ReadKolideChecks_Call{Call: _e.mock.On("ReadKolideChecks", ctx)}
+}
+
+func (_c *MockDatabase_ReadKolideChecks_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadKolideChecks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadKolideChecks_Call) Return(int64ToKolideCheck map[int64]*sqlc.KolideCheck, err error) *MockDatabase_ReadKolideChecks_Call {
+	_c.Call.Return(int64ToKolideCheck, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadKolideChecks_Call) RunAndReturn(run func(ctx context.Context) (map[int64]*sqlc.KolideCheck, error)) *MockDatabase_ReadKolideChecks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMostRecentSessionInfo provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadMostRecentSessionInfo(ctx context.Context, deviceID int64) (*pb.Session, error) {
+	ret := _mock.Called(ctx, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMostRecentSessionInfo")
+	}
+
+	var r0 *pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.Session, error)); ok {
+		return returnFunc(ctx, deviceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.Session); ok {
+		r0 = returnFunc(ctx, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadMostRecentSessionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMostRecentSessionInfo'
+type MockDatabase_ReadMostRecentSessionInfo_Call struct {
+	*mock.Call
+}
+
+// ReadMostRecentSessionInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int64
+func (_e *MockDatabase_Expecter) ReadMostRecentSessionInfo(ctx interface{}, deviceID interface{}) *MockDatabase_ReadMostRecentSessionInfo_Call {
+	return &MockDatabase_ReadMostRecentSessionInfo_Call{Call: _e.mock.On("ReadMostRecentSessionInfo", ctx, deviceID)}
+}
+
+func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_ReadMostRecentSessionInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) Return(session *pb.Session, err error) *MockDatabase_ReadMostRecentSessionInfo_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) (*pb.Session, error)) *MockDatabase_ReadMostRecentSessionInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPeers provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadPeers(ctx context.Context) ([]*peer, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPeers")
+	}
+
+	var r0 []*peer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*peer, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*peer); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*peer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadPeers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPeers'
+type MockDatabase_ReadPeers_Call struct {
+	*mock.Call
+}
+
+// ReadPeers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadPeers(ctx interface{}) *MockDatabase_ReadPeers_Call {
+	return &MockDatabase_ReadPeers_Call{Call: _e.mock.On("ReadPeers", ctx)}
+}
+
+func (_c *MockDatabase_ReadPeers_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadPeers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadPeers_Call) Return(peerMoqParams []*peer, err error) *MockDatabase_ReadPeers_Call {
+	_c.Call.Return(peerMoqParams, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadPeers_Call) RunAndReturn(run func(ctx context.Context) ([]*peer, error)) *MockDatabase_ReadPeers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadPendingGatewayJitaGrants provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadPendingGatewayJitaGrants(ctx context.Context) ([]*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadPendingGatewayJitaGrants")
+	}
+
+	var r0 []*pb.GatewayJitaGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.GatewayJitaGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadPendingGatewayJitaGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPendingGatewayJitaGrants'
+type MockDatabase_ReadPendingGatewayJitaGrants_Call struct {
+	*mock.Call
+}
+
+// ReadPendingGatewayJitaGrants is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadPendingGatewayJitaGrants(ctx interface{}) *MockDatabase_ReadPendingGatewayJitaGrants_Call {
+	return &MockDatabase_ReadPendingGatewayJitaGrants_Call{Call: _e.mock.On("ReadPendingGatewayJitaGrants", ctx)}
+}
+
+func (_c *MockDatabase_ReadPendingGatewayJitaGrants_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadPendingGatewayJitaGrants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadPendingGatewayJitaGrants_Call) Return(gatewayJitaGrants []*pb.GatewayJitaGrant, err error) *MockDatabase_ReadPendingGatewayJitaGrants_Call {
+	_c.Call.Return(gatewayJitaGrants, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadPendingGatewayJitaGrants_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.GatewayJitaGrant, error)) *MockDatabase_ReadPendingGatewayJitaGrants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSessionInfo provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadSessionInfo(ctx context.Context, key string) (*pb.Session, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSessionInfo")
+	}
+
+	var r0 *pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Session, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Session); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadSessionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSessionInfo'
+type MockDatabase_ReadSessionInfo_Call struct {
+	*mock.Call
+}
+
+// ReadSessionInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockDatabase_Expecter) ReadSessionInfo(ctx interface{}, key interface{}) *MockDatabase_ReadSessionInfo_Call {
+	return &MockDatabase_ReadSessionInfo_Call{Call: _e.mock.On("ReadSessionInfo", ctx, key)}
+}
+
+func (_c *MockDatabase_ReadSessionInfo_Call) Run(run func(ctx context.Context, key string)) *MockDatabase_ReadSessionInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadSessionInfo_Call) Return(session *pb.Session, err error) *MockDatabase_ReadSessionInfo_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadSessionInfo_Call) RunAndReturn(run func(ctx context.Context, key string) (*pb.Session, error)) *MockDatabase_ReadSessionInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadSessionInfos provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReadSessionInfos(ctx context.Context) ([]*pb.Session, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSessionInfos")
+	}
+
+	var r0 []*pb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Session, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Session); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ReadSessionInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSessionInfos'
+type MockDatabase_ReadSessionInfos_Call struct {
+	*mock.Call
+}
+
+// ReadSessionInfos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ReadSessionInfos(ctx interface{}) *MockDatabase_ReadSessionInfos_Call {
+	return &MockDatabase_ReadSessionInfos_Call{Call: _e.mock.On("ReadSessionInfos", ctx)}
+}
+
+func (_c *MockDatabase_ReadSessionInfos_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadSessionInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReadSessionInfos_Call) Return(sessions []*pb.Session, err error) *MockDatabase_ReadSessionInfos_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *MockDatabase_ReadSessionInfos_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Session, error)) *MockDatabase_ReadSessionInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignDevice provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReassignDevice(ctx context.Context, deviceID int64, username string) error {
+	ret := _mock.Called(ctx, deviceID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignDevice")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, deviceID, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_ReassignDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignDevice'
+type MockDatabase_ReassignDevice_Call struct {
+	*mock.Call
+}
+
+// ReassignDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceID int64
+//   - username string
+func (_e *MockDatabase_Expecter) ReassignDevice(ctx interface{}, deviceID interface{}, username interface{}) *MockDatabase_ReassignDevice_Call {
+	return &MockDatabase_ReassignDevice_Call{Call: _e.mock.On("ReassignDevice", ctx, deviceID, username)}
+}
+
+func (_c *MockDatabase_ReassignDevice_Call) Run(run func(ctx context.Context, deviceID int64, username string)) *MockDatabase_ReassignDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReassignDevice_Call) Return(err error) *MockDatabase_ReassignDevice_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_ReassignDevice_Call) RunAndReturn(run func(ctx context.Context, deviceID int64, username string) error) *MockDatabase_ReassignDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectAcceptableUse provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RejectAcceptableUse(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectAcceptableUse")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_RejectAcceptableUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectAcceptableUse'
+type MockDatabase_RejectAcceptableUse_Call struct {
+	*mock.Call
+}
+
+// RejectAcceptableUse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockDatabase_Expecter) RejectAcceptableUse(ctx interface{}, userID interface{}) *MockDatabase_RejectAcceptableUse_Call {
+	return &MockDatabase_RejectAcceptableUse_Call{Call: _e.mock.On("RejectAcceptableUse", ctx, userID)}
+}
+
+func (_c *MockDatabase_RejectAcceptableUse_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_RejectAcceptableUse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RejectAcceptableUse_Call) Return(err error) *MockDatabase_RejectAcceptableUse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_RejectAcceptableUse_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockDatabase_RejectAcceptableUse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLease provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReleaseLease(ctx context.Context, name string, holder string) error {
+	ret := _mock.Called(ctx, name, holder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseLease")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, holder)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_ReleaseLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLease'
+type MockDatabase_ReleaseLease_Call struct {
+	*mock.Call
+}
+
+// ReleaseLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - holder string
+func (_e *MockDatabase_Expecter) ReleaseLease(ctx interface{}, name interface{}, holder interface{}) *MockDatabase_ReleaseLease_Call {
+	return &MockDatabase_ReleaseLease_Call{Call: _e.mock.On("ReleaseLease", ctx, name, holder)}
+}
+
+func (_c *MockDatabase_ReleaseLease_Call) Run(run func(ctx context.Context, name string, holder string)) *MockDatabase_ReleaseLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ReleaseLease_Call) Return(err error) *MockDatabase_ReleaseLease_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_ReleaseLease_Call) RunAndReturn(run func(ctx context.Context, name string, holder string) error) *MockDatabase_ReleaseLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveExpiredSessions provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RemoveExpiredSessions(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExpiredSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_RemoveExpiredSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExpiredSessions'
+type MockDatabase_RemoveExpiredSessions_Call struct {
+	*mock.Call
+}
+
+// RemoveExpiredSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) RemoveExpiredSessions(ctx interface{}) *MockDatabase_RemoveExpiredSessions_Call {
+	return &MockDatabase_RemoveExpiredSessions_Call{Call: _e.mock.On("RemoveExpiredSessions", ctx)}
+}
+
+func (_c *MockDatabase_RemoveExpiredSessions_Call) Run(run func(ctx context.Context)) *MockDatabase_RemoveExpiredSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RemoveExpiredSessions_Call) Return(err error) *MockDatabase_RemoveExpiredSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_RemoveExpiredSessions_Call) RunAndReturn(run func(ctx context.Context) error) *MockDatabase_RemoveExpiredSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSession provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RemoveSession(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadDeviceBySerialPlatform")
+		panic("no return value specified for RemoveSession")
 	}
 
-	var r0 *pb.Device
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*pb.Device, error)); ok {
-		return returnFunc(ctx, serial, platform)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *pb.Device); ok {
-		r0 = returnFunc(ctx, serial, platform)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Device)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, serial, platform)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadDeviceBySerialPlatform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeviceBySerialPlatform'
-type MockDatabase_ReadDeviceBySerialPlatform_Call struct {
+// MockDatabase_RemoveSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSession'
+type MockDatabase_RemoveSession_Call struct {
 	*mock.Call
 }
 
-// ReadDeviceBySerialPlatform is a helper method to define mock.On call
+// RemoveSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - serial string
-//   - platform string
-func (_e *MockDatabase_Expecter) ReadDeviceBySerialPlatform(ctx interface{}, serial interface{}, platform interface{}) *MockDatabase_ReadDeviceBySerialPlatform_Call {
-	return &MockDatabase_ReadDeviceBySerialPlatform_Call{Call: _e.mock.On("ReadDeviceBySerialPlatform", ctx, serial, platform)}
+//   - key string
+func (_e *MockDatabase_Expecter) RemoveSession(ctx interface{}, key interface{}) *MockDatabase_RemoveSession_Call {
+	return &MockDatabase_RemoveSession_Call{Call: _e.mock.On("RemoveSession", ctx, key)}
 }
 
-func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) Run(run func(ctx context.Context, serial string, platform string)) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+func (_c *MockDatabase_RemoveSession_Call) Run(run func(ctx context.Context, key string)) *MockDatabase_RemoveSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -868,132 +2425,111 @@ func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) Return(device *pb.Device, err error) *MockDatabase_ReadDeviceBySerialPlatform_Call {
-	_c.Call.Return(device, err)
+func (_c *MockDatabase_RemoveSession_Call) Return(err error) *MockDatabase_RemoveSession_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadDeviceBySerialPlatform_Call) RunAndReturn(run func(ctx context.Context, serial string, platform string) (*pb.Device, error)) *MockDatabase_ReadDeviceBySerialPlatform_Call {
+func (_c *MockDatabase_RemoveSession_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockDatabase_RemoveSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadDevices provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadDevices(ctx context.Context) ([]*pb.Device, error) {
-	ret := _mock.Called(ctx)
+// RemoveSessionsForDevice provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RemoveSessionsForDevice(ctx context.Context, deviceID int64) error {
+	ret := _mock.Called(ctx, deviceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadDevices")
+		panic("no return value specified for RemoveSessionsForDevice")
 	}
 
-	var r0 []*pb.Device
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Device, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Device); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*pb.Device)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, deviceID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDevices'
-type MockDatabase_ReadDevices_Call struct {
+// MockDatabase_RemoveSessionsForDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSessionsForDevice'
+type MockDatabase_RemoveSessionsForDevice_Call struct {
 	*mock.Call
 }
 
-// ReadDevices is a helper method to define mock.On call
+// RemoveSessionsForDevice is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) ReadDevices(ctx interface{}) *MockDatabase_ReadDevices_Call {
-	return &MockDatabase_ReadDevices_Call{Call: _e.mock.On("ReadDevices", ctx)}
+//   - deviceID int64
+func (_e *MockDatabase_Expecter) RemoveSessionsForDevice(ctx interface{}, deviceID interface{}) *MockDatabase_RemoveSessionsForDevice_Call {
+	return &MockDatabase_RemoveSessionsForDevice_Call{Call: _e.mock.On("RemoveSessionsForDevice", ctx, deviceID)}
 }
 
-func (_c *MockDatabase_ReadDevices_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadDevices_Call {
+func (_c *MockDatabase_RemoveSessionsForDevice_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_RemoveSessionsForDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadDevices_Call) Return(devices []*pb.Device, err error) *MockDatabase_ReadDevices_Call {
-	_c.Call.Return(devices, err)
+func (_c *MockDatabase_RemoveSessionsForDevice_Call) Return(err error) *MockDatabase_RemoveSessionsForDevice_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadDevices_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Device, error)) *MockDatabase_ReadDevices_Call {
+func (_c *MockDatabase_RemoveSessionsForDevice_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) error) *MockDatabase_RemoveSessionsForDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadGateway provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadGateway(ctx context.Context, name string) (*pb.Gateway, error) {
-	ret := _mock.Called(ctx, name)
+// RemoveSessionsForUser provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RemoveSessionsForUser(ctx context.Context, objectID string) error {
+	ret := _mock.Called(ctx, objectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadGateway")
+		panic("no return value specified for RemoveSessionsForUser")
 	}
 
-	var r0 *pb.Gateway
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Gateway, error)); ok {
-		return returnFunc(ctx, name)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Gateway); ok {
-		r0 = returnFunc(ctx, name)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Gateway)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, objectID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGateway'
-type MockDatabase_ReadGateway_Call struct {
+// MockDatabase_RemoveSessionsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSessionsForUser'
+type MockDatabase_RemoveSessionsForUser_Call struct {
 	*mock.Call
 }
 
-// ReadGateway is a helper method to define mock.On call
+// RemoveSessionsForUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockDatabase_Expecter) ReadGateway(ctx interface{}, name interface{}) *MockDatabase_ReadGateway_Call {
-	return &MockDatabase_ReadGateway_Call{Call: _e.mock.On("ReadGateway", ctx, name)}
+//   - objectID string
+func (_e *MockDatabase_Expecter) RemoveSessionsForUser(ctx interface{}, objectID interface{}) *MockDatabase_RemoveSessionsForUser_Call {
+	return &MockDatabase_RemoveSessionsForUser_Call{Call: _e.mock.On("RemoveSessionsForUser", ctx, objectID)}
 }
 
-func (_c *MockDatabase_ReadGateway_Call) Run(run func(ctx context.Context, name string)) *MockDatabase_ReadGateway_Call {
+func (_c *MockDatabase_RemoveSessionsForUser_Call) Run(run func(ctx context.Context, objectID string)) *MockDatabase_RemoveSessionsForUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1011,181 +2547,197 @@ func (_c *MockDatabase_ReadGateway_Call) Run(run func(ctx context.Context, name
 	return _c
 }
 
-func (_c *MockDatabase_ReadGateway_Call) Return(gateway *pb.Gateway, err error) *MockDatabase_ReadGateway_Call {
-	_c.Call.Return(gateway, err)
+func (_c *MockDatabase_RemoveSessionsForUser_Call) Return(err error) *MockDatabase_RemoveSessionsForUser_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadGateway_Call) RunAndReturn(run func(ctx context.Context, name string) (*pb.Gateway, error)) *MockDatabase_ReadGateway_Call {
+func (_c *MockDatabase_RemoveSessionsForUser_Call) RunAndReturn(run func(ctx context.Context, objectID string) error) *MockDatabase_RemoveSessionsForUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadGateways provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadGateways(ctx context.Context) ([]*pb.Gateway, error) {
-	ret := _mock.Called(ctx)
+// RenewSessionInfo provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RenewSessionInfo(ctx context.Context, oldKey string, si *pb.Session) error {
+	ret := _mock.Called(ctx, oldKey, si)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadGateways")
+		panic("no return value specified for RenewSessionInfo")
 	}
 
-	var r0 []*pb.Gateway
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Gateway, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Gateway); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*pb.Gateway)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *pb.Session) error); ok {
+		r0 = returnFunc(ctx, oldKey, si)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadGateways_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadGateways'
-type MockDatabase_ReadGateways_Call struct {
+// MockDatabase_RenewSessionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewSessionInfo'
+type MockDatabase_RenewSessionInfo_Call struct {
 	*mock.Call
 }
 
-// ReadGateways is a helper method to define mock.On call
+// RenewSessionInfo is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) ReadGateways(ctx interface{}) *MockDatabase_ReadGateways_Call {
-	return &MockDatabase_ReadGateways_Call{Call: _e.mock.On("ReadGateways", ctx)}
+//   - oldKey string
+//   - si *pb.Session
+func (_e *MockDatabase_Expecter) RenewSessionInfo(ctx interface{}, oldKey interface{}, si interface{}) *MockDatabase_RenewSessionInfo_Call {
+	return &MockDatabase_RenewSessionInfo_Call{Call: _e.mock.On("RenewSessionInfo", ctx, oldKey, si)}
 }
 
-func (_c *MockDatabase_ReadGateways_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadGateways_Call {
+func (_c *MockDatabase_RenewSessionInfo_Call) Run(run func(ctx context.Context, oldKey string, si *pb.Session)) *MockDatabase_RenewSessionInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *pb.Session
+		if args[2] != nil {
+			arg2 = args[2].(*pb.Session)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadGateways_Call) Return(gateways []*pb.Gateway, err error) *MockDatabase_ReadGateways_Call {
-	_c.Call.Return(gateways, err)
+func (_c *MockDatabase_RenewSessionInfo_Call) Return(err error) *MockDatabase_RenewSessionInfo_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadGateways_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Gateway, error)) *MockDatabase_ReadGateways_Call {
+func (_c *MockDatabase_RenewSessionInfo_Call) RunAndReturn(run func(ctx context.Context, oldKey string, si *pb.Session) error) *MockDatabase_RenewSessionInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadKolideChecks provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadKolideChecks(ctx context.Context) (map[int64]*sqlc.KolideCheck, error) {
-	ret := _mock.Called(ctx)
+// RequestPrivilegedGatewayAccess provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RequestPrivilegedGatewayAccess(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error {
+	ret := _mock.Called(ctx, userID, gatewayName, expires, reason)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadKolideChecks")
+		panic("no return value specified for RequestPrivilegedGatewayAccess")
 	}
 
-	var r0 map[int64]*sqlc.KolideCheck
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[int64]*sqlc.KolideCheck, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) map[int64]*sqlc.KolideCheck); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[int64]*sqlc.KolideCheck)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) error); ok {
+		r0 = returnFunc(ctx, userID, gatewayName, expires, reason)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadKolideChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadKolideChecks'
-type MockDatabase_ReadKolideChecks_Call struct {
+// MockDatabase_RequestPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPrivilegedGatewayAccess'
+type MockDatabase_RequestPrivilegedGatewayAccess_Call struct {
 	*mock.Call
 }
 
-// ReadKolideChecks is a helper method to define mock.On call
+// RequestPrivilegedGatewayAccess is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) ReadKolideChecks(ctx interface{}) *MockDatabase_ReadKolideChecks_Call {
-	return &MockDatabase_ReadKolideChecks_Call{Call: _e.mock.On("ReadKolideChecks", ctx)}
+//   - userID string
+//   - gatewayName string
+//   - expires time.Time
+//   - reason string
+func (_e *MockDatabase_Expecter) RequestPrivilegedGatewayAccess(ctx interface{}, userID interface{}, gatewayName interface{}, expires interface{}, reason interface{}) *MockDatabase_RequestPrivilegedGatewayAccess_Call {
+	return &MockDatabase_RequestPrivilegedGatewayAccess_Call{Call: _e.mock.On("RequestPrivilegedGatewayAccess", ctx, userID, gatewayName, expires, reason)}
 }
 
-func (_c *MockDatabase_ReadKolideChecks_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadKolideChecks_Call {
+func (_c *MockDatabase_RequestPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string)) *MockDatabase_RequestPrivilegedGatewayAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadKolideChecks_Call) Return(int64ToKolideCheck map[int64]*sqlc.KolideCheck, err error) *MockDatabase_ReadKolideChecks_Call {
-	_c.Call.Return(int64ToKolideCheck, err)
+func (_c *MockDatabase_RequestPrivilegedGatewayAccess_Call) Return(err error) *MockDatabase_RequestPrivilegedGatewayAccess_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadKolideChecks_Call) RunAndReturn(run func(ctx context.Context) (map[int64]*sqlc.KolideCheck, error)) *MockDatabase_ReadKolideChecks_Call {
+func (_c *MockDatabase_RequestPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string, expires time.Time, reason string) error) *MockDatabase_RequestPrivilegedGatewayAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadMostRecentSessionInfo provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadMostRecentSessionInfo(ctx context.Context, deviceID int64) (*pb.Session, error) {
-	ret := _mock.Called(ctx, deviceID)
+// ReviewGatewayJitaGrant provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ReviewGatewayJitaGrant(ctx context.Context, id int64, approver string, approved bool) (*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx, id, approver, approved)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadMostRecentSessionInfo")
+		panic("no return value specified for ReviewGatewayJitaGrant")
 	}
 
-	var r0 *pb.Session
+	var r0 *pb.GatewayJitaGrant
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.Session, error)); ok {
-		return returnFunc(ctx, deviceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, bool) (*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx, id, approver, approved)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.Session); ok {
-		r0 = returnFunc(ctx, deviceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, bool) *pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx, id, approver, approved)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Session)
+			r0 = ret.Get(0).(*pb.GatewayJitaGrant)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, deviceID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, bool) error); ok {
+		r1 = returnFunc(ctx, id, approver, approved)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_ReadMostRecentSessionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMostRecentSessionInfo'
-type MockDatabase_ReadMostRecentSessionInfo_Call struct {
+// MockDatabase_ReviewGatewayJitaGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewGatewayJitaGrant'
+type MockDatabase_ReviewGatewayJitaGrant_Call struct {
 	*mock.Call
 }
 
-// ReadMostRecentSessionInfo is a helper method to define mock.On call
+// ReviewGatewayJitaGrant is a helper method to define mock.On call
 //   - ctx context.Context
-//   - deviceID int64
-func (_e *MockDatabase_Expecter) ReadMostRecentSessionInfo(ctx interface{}, deviceID interface{}) *MockDatabase_ReadMostRecentSessionInfo_Call {
-	return &MockDatabase_ReadMostRecentSessionInfo_Call{Call: _e.mock.On("ReadMostRecentSessionInfo", ctx, deviceID)}
+//   - id int64
+//   - approver string
+//   - approved bool
+func (_e *MockDatabase_Expecter) ReviewGatewayJitaGrant(ctx interface{}, id interface{}, approver interface{}, approved interface{}) *MockDatabase_ReviewGatewayJitaGrant_Call {
+	return &MockDatabase_ReviewGatewayJitaGrant_Call{Call: _e.mock.On("ReviewGatewayJitaGrant", ctx, id, approver, approved)}
 }
 
-func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) Run(run func(ctx context.Context, deviceID int64)) *MockDatabase_ReadMostRecentSessionInfo_Call {
+func (_c *MockDatabase_ReviewGatewayJitaGrant_Call) Run(run func(ctx context.Context, id int64, approver string, approved bool)) *MockDatabase_ReviewGatewayJitaGrant_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1195,135 +2747,151 @@ func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) Return(session *pb.Session, err error) *MockDatabase_ReadMostRecentSessionInfo_Call {
-	_c.Call.Return(session, err)
+func (_c *MockDatabase_ReviewGatewayJitaGrant_Call) Return(gatewayJitaGrant *pb.GatewayJitaGrant, err error) *MockDatabase_ReviewGatewayJitaGrant_Call {
+	_c.Call.Return(gatewayJitaGrant, err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadMostRecentSessionInfo_Call) RunAndReturn(run func(ctx context.Context, deviceID int64) (*pb.Session, error)) *MockDatabase_ReadMostRecentSessionInfo_Call {
+func (_c *MockDatabase_ReviewGatewayJitaGrant_Call) RunAndReturn(run func(ctx context.Context, id int64, approver string, approved bool) (*pb.GatewayJitaGrant, error)) *MockDatabase_ReviewGatewayJitaGrant_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadPeers provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadPeers(ctx context.Context) ([]*peer, error) {
-	ret := _mock.Called(ctx)
+// RevokeGatewayJitaGrant provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RevokeGatewayJitaGrant(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadPeers")
+		panic("no return value specified for RevokeGatewayJitaGrant")
 	}
 
-	var r0 []*peer
+	var r0 *pb.GatewayJitaGrant
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*peer, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.GatewayJitaGrant, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*peer); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.GatewayJitaGrant); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*peer)
+			r0 = ret.Get(0).(*pb.GatewayJitaGrant)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_ReadPeers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadPeers'
-type MockDatabase_ReadPeers_Call struct {
+// MockDatabase_RevokeGatewayJitaGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeGatewayJitaGrant'
+type MockDatabase_RevokeGatewayJitaGrant_Call struct {
 	*mock.Call
 }
 
-// ReadPeers is a helper method to define mock.On call
+// RevokeGatewayJitaGrant is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) ReadPeers(ctx interface{}) *MockDatabase_ReadPeers_Call {
-	return &MockDatabase_ReadPeers_Call{Call: _e.mock.On("ReadPeers", ctx)}
+//   - id int64
+func (_e *MockDatabase_Expecter) RevokeGatewayJitaGrant(ctx interface{}, id interface{}) *MockDatabase_RevokeGatewayJitaGrant_Call {
+	return &MockDatabase_RevokeGatewayJitaGrant_Call{Call: _e.mock.On("RevokeGatewayJitaGrant", ctx, id)}
 }
 
-func (_c *MockDatabase_ReadPeers_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadPeers_Call {
+func (_c *MockDatabase_RevokeGatewayJitaGrant_Call) Run(run func(ctx context.Context, id int64)) *MockDatabase_RevokeGatewayJitaGrant_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadPeers_Call) Return(peerMoqParams []*peer, err error) *MockDatabase_ReadPeers_Call {
-	_c.Call.Return(peerMoqParams, err)
+func (_c *MockDatabase_RevokeGatewayJitaGrant_Call) Return(gatewayJitaGrant *pb.GatewayJitaGrant, err error) *MockDatabase_RevokeGatewayJitaGrant_Call {
+	_c.Call.Return(gatewayJitaGrant, err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadPeers_Call) RunAndReturn(run func(ctx context.Context) ([]*peer, error)) *MockDatabase_ReadPeers_Call {
+func (_c *MockDatabase_RevokeGatewayJitaGrant_Call) RunAndReturn(run func(ctx context.Context, id int64) (*pb.GatewayJitaGrant, error)) *MockDatabase_RevokeGatewayJitaGrant_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadSessionInfo provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadSessionInfo(ctx context.Context, key string) (*pb.Session, error) {
-	ret := _mock.Called(ctx, key)
+// RevokeIssueExemption provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RevokeIssueExemption(ctx context.Context, id int64) (*pb.IssueExemption, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadSessionInfo")
+		panic("no return value specified for RevokeIssueExemption")
 	}
 
-	var r0 *pb.Session
+	var r0 *pb.IssueExemption
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*pb.Session, error)); ok {
-		return returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*pb.IssueExemption, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *pb.Session); ok {
-		r0 = returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *pb.IssueExemption); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.Session)
+			r0 = ret.Get(0).(*pb.IssueExemption)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, key)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockDatabase_ReadSessionInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSessionInfo'
-type MockDatabase_ReadSessionInfo_Call struct {
+// MockDatabase_RevokeIssueExemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeIssueExemption'
+type MockDatabase_RevokeIssueExemption_Call struct {
 	*mock.Call
 }
 
-// ReadSessionInfo is a helper method to define mock.On call
+// RevokeIssueExemption is a helper method to define mock.On call
 //   - ctx context.Context
-//   - key string
-func (_e *MockDatabase_Expecter) ReadSessionInfo(ctx interface{}, key interface{}) *MockDatabase_ReadSessionInfo_Call {
-	return &MockDatabase_ReadSessionInfo_Call{Call: _e.mock.On("ReadSessionInfo", ctx, key)}
+//   - id int64
+func (_e *MockDatabase_Expecter) RevokeIssueExemption(ctx interface{}, id interface{}) *MockDatabase_RevokeIssueExemption_Call {
+	return &MockDatabase_RevokeIssueExemption_Call{Call: _e.mock.On("RevokeIssueExemption", ctx, id)}
 }
 
-func (_c *MockDatabase_ReadSessionInfo_Call) Run(run func(ctx context.Context, key string)) *MockDatabase_ReadSessionInfo_Call {
+func (_c *MockDatabase_RevokeIssueExemption_Call) Run(run func(ctx context.Context, id int64)) *MockDatabase_RevokeIssueExemption_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -1333,108 +2901,109 @@ func (_c *MockDatabase_ReadSessionInfo_Call) Run(run func(ctx context.Context, k
 	return _c
 }
 
-func (_c *MockDatabase_ReadSessionInfo_Call) Return(session *pb.Session, err error) *MockDatabase_ReadSessionInfo_Call {
-	_c.Call.Return(session, err)
+func (_c *MockDatabase_RevokeIssueExemption_Call) Return(issueExemption *pb.IssueExemption, err error) *MockDatabase_RevokeIssueExemption_Call {
+	_c.Call.Return(issueExemption, err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadSessionInfo_Call) RunAndReturn(run func(ctx context.Context, key string) (*pb.Session, error)) *MockDatabase_ReadSessionInfo_Call {
+func (_c *MockDatabase_RevokeIssueExemption_Call) RunAndReturn(run func(ctx context.Context, id int64) (*pb.IssueExemption, error)) *MockDatabase_RevokeIssueExemption_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadSessionInfos provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) ReadSessionInfos(ctx context.Context) ([]*pb.Session, error) {
-	ret := _mock.Called(ctx)
+// RevokePrivilegedGatewayAccess provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) RevokePrivilegedGatewayAccess(ctx context.Context, userID string, gatewayName string) error {
+	ret := _mock.Called(ctx, userID, gatewayName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadSessionInfos")
+		panic("no return value specified for RevokePrivilegedGatewayAccess")
 	}
 
-	var r0 []*pb.Session
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pb.Session, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pb.Session); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*pb.Session)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, gatewayName)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockDatabase_ReadSessionInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSessionInfos'
-type MockDatabase_ReadSessionInfos_Call struct {
+// MockDatabase_RevokePrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePrivilegedGatewayAccess'
+type MockDatabase_RevokePrivilegedGatewayAccess_Call struct {
 	*mock.Call
 }
 
-// ReadSessionInfos is a helper method to define mock.On call
+// RevokePrivilegedGatewayAccess is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) ReadSessionInfos(ctx interface{}) *MockDatabase_ReadSessionInfos_Call {
-	return &MockDatabase_ReadSessionInfos_Call{Call: _e.mock.On("ReadSessionInfos", ctx)}
+//   - userID string
+//   - gatewayName string
+func (_e *MockDatabase_Expecter) RevokePrivilegedGatewayAccess(ctx interface{}, userID interface{}, gatewayName interface{}) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
+	return &MockDatabase_RevokePrivilegedGatewayAccess_Call{Call: _e.mock.On("RevokePrivilegedGatewayAccess", ctx, userID, gatewayName)}
 }
 
-func (_c *MockDatabase_ReadSessionInfos_Call) Run(run func(ctx context.Context)) *MockDatabase_ReadSessionInfos_Call {
+func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, userID string, gatewayName string)) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_ReadSessionInfos_Call) Return(sessions []*pb.Session, err error) *MockDatabase_ReadSessionInfos_Call {
-	_c.Call.Return(sessions, err)
+func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) Return(err error) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_ReadSessionInfos_Call) RunAndReturn(run func(ctx context.Context) ([]*pb.Session, error)) *MockDatabase_ReadSessionInfos_Call {
+func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string) error) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RejectAcceptableUse provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) RejectAcceptableUse(ctx context.Context, userID string) error {
-	ret := _mock.Called(ctx, userID)
+// Snapshot provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) Snapshot(ctx context.Context, path string) error {
+	ret := _mock.Called(ctx, path)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RejectAcceptableUse")
+		panic("no return value specified for Snapshot")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, userID)
+		r0 = returnFunc(ctx, path)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockDatabase_RejectAcceptableUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectAcceptableUse'
-type MockDatabase_RejectAcceptableUse_Call struct {
+// MockDatabase_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockDatabase_Snapshot_Call struct {
 	*mock.Call
 }
 
-// RejectAcceptableUse is a helper method to define mock.On call
+// Snapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockDatabase_Expecter) RejectAcceptableUse(ctx interface{}, userID interface{}) *MockDatabase_RejectAcceptableUse_Call {
-	return &MockDatabase_RejectAcceptableUse_Call{Call: _e.mock.On("RejectAcceptableUse", ctx, userID)}
+//   - path string
+func (_e *MockDatabase_Expecter) Snapshot(ctx interface{}, path interface{}) *MockDatabase_Snapshot_Call {
+	return &MockDatabase_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx, path)}
 }
 
-func (_c *MockDatabase_RejectAcceptableUse_Call) Run(run func(ctx context.Context, userID string)) *MockDatabase_RejectAcceptableUse_Call {
+func (_c *MockDatabase_Snapshot_Call) Run(run func(ctx context.Context, path string)) *MockDatabase_Snapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1452,110 +3021,122 @@ func (_c *MockDatabase_RejectAcceptableUse_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockDatabase_RejectAcceptableUse_Call) Return(err error) *MockDatabase_RejectAcceptableUse_Call {
+func (_c *MockDatabase_Snapshot_Call) Return(err error) *MockDatabase_Snapshot_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_RejectAcceptableUse_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockDatabase_RejectAcceptableUse_Call {
+func (_c *MockDatabase_Snapshot_Call) RunAndReturn(run func(ctx context.Context, path string) error) *MockDatabase_Snapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveExpiredSessions provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) RemoveExpiredSessions(ctx context.Context) error {
-	ret := _mock.Called(ctx)
+// UpdateDeviceAgentVersion provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) UpdateDeviceAgentVersion(ctx context.Context, deviceID int64, version string) error {
+	ret := _mock.Called(ctx, deviceID, version)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveExpiredSessions")
+		panic("no return value specified for UpdateDeviceAgentVersion")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, deviceID, version)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockDatabase_RemoveExpiredSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExpiredSessions'
-type MockDatabase_RemoveExpiredSessions_Call struct {
+// MockDatabase_UpdateDeviceAgentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceAgentVersion'
+type MockDatabase_UpdateDeviceAgentVersion_Call struct {
 	*mock.Call
 }
 
-// RemoveExpiredSessions is a helper method to define mock.On call
+// UpdateDeviceAgentVersion is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabase_Expecter) RemoveExpiredSessions(ctx interface{}) *MockDatabase_RemoveExpiredSessions_Call {
-	return &MockDatabase_RemoveExpiredSessions_Call{Call: _e.mock.On("RemoveExpiredSessions", ctx)}
+//   - deviceID int64
+//   - version string
+func (_e *MockDatabase_Expecter) UpdateDeviceAgentVersion(ctx interface{}, deviceID interface{}, version interface{}) *MockDatabase_UpdateDeviceAgentVersion_Call {
+	return &MockDatabase_UpdateDeviceAgentVersion_Call{Call: _e.mock.On("UpdateDeviceAgentVersion", ctx, deviceID, version)}
 }
 
-func (_c *MockDatabase_RemoveExpiredSessions_Call) Run(run func(ctx context.Context)) *MockDatabase_RemoveExpiredSessions_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersion_Call) Run(run func(ctx context.Context, deviceID int64, version string)) *MockDatabase_UpdateDeviceAgentVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDatabase_RemoveExpiredSessions_Call) Return(err error) *MockDatabase_RemoveExpiredSessions_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersion_Call) Return(err error) *MockDatabase_UpdateDeviceAgentVersion_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_RemoveExpiredSessions_Call) RunAndReturn(run func(ctx context.Context) error) *MockDatabase_RemoveExpiredSessions_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersion_Call) RunAndReturn(run func(ctx context.Context, deviceID int64, version string) error) *MockDatabase_UpdateDeviceAgentVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokePrivilegedGatewayAccess provides a mock function for the type MockDatabase
-func (_mock *MockDatabase) RevokePrivilegedGatewayAccess(ctx context.Context, userID string, gatewayName string) error {
-	ret := _mock.Called(ctx, userID, gatewayName)
+// UpdateDeviceAgentVersionOutdatedSince provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, deviceID int64, since time.Time) error {
+	ret := _mock.Called(ctx, deviceID, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokePrivilegedGatewayAccess")
+		panic("no return value specified for UpdateDeviceAgentVersionOutdatedSince")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, userID, gatewayName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, deviceID, since)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockDatabase_RevokePrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePrivilegedGatewayAccess'
-type MockDatabase_RevokePrivilegedGatewayAccess_Call struct {
+// MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeviceAgentVersionOutdatedSince'
+type MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call struct {
 	*mock.Call
 }
 
-// RevokePrivilegedGatewayAccess is a helper method to define mock.On call
+// UpdateDeviceAgentVersionOutdatedSince is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - gatewayName string
-func (_e *MockDatabase_Expecter) RevokePrivilegedGatewayAccess(ctx interface{}, userID interface{}, gatewayName interface{}) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
-	return &MockDatabase_RevokePrivilegedGatewayAccess_Call{Call: _e.mock.On("RevokePrivilegedGatewayAccess", ctx, userID, gatewayName)}
+//   - deviceID int64
+//   - since time.Time
+func (_e *MockDatabase_Expecter) UpdateDeviceAgentVersionOutdatedSince(ctx interface{}, deviceID interface{}, since interface{}) *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call {
+	return &MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call{Call: _e.mock.On("UpdateDeviceAgentVersionOutdatedSince", ctx, deviceID, since)}
 }
 
-func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, userID string, gatewayName string)) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call) Run(run func(ctx context.Context, deviceID int64, since time.Time)) *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
-		var arg2 string
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -1566,12 +3147,12 @@ func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) Return(err error) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call) Return(err error) *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDatabase_RevokePrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, userID string, gatewayName string) error) *MockDatabase_RevokePrivilegedGatewayAccess_Call {
+func (_c *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call) RunAndReturn(run func(ctx context.Context, deviceID int64, since time.Time) error) *MockDatabase_UpdateDeviceAgentVersionOutdatedSince_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1747,6 +3328,63 @@ func (_c *MockDatabase_UpdateGatewayDynamicFields_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// UpdateGatewaysDynamicFields provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) UpdateGatewaysDynamicFields(ctx context.Context, gateways []*pb.Gateway) error {
+	ret := _mock.Called(ctx, gateways)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGatewaysDynamicFields")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*pb.Gateway) error); ok {
+		r0 = returnFunc(ctx, gateways)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_UpdateGatewaysDynamicFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGatewaysDynamicFields'
+type MockDatabase_UpdateGatewaysDynamicFields_Call struct {
+	*mock.Call
+}
+
+// UpdateGatewaysDynamicFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gateways []*pb.Gateway
+func (_e *MockDatabase_Expecter) UpdateGatewaysDynamicFields(ctx interface{}, gateways interface{}) *MockDatabase_UpdateGatewaysDynamicFields_Call {
+	return &MockDatabase_UpdateGatewaysDynamicFields_Call{Call: _e.mock.On("UpdateGatewaysDynamicFields", ctx, gateways)}
+}
+
+func (_c *MockDatabase_UpdateGatewaysDynamicFields_Call) Run(run func(ctx context.Context, gateways []*pb.Gateway)) *MockDatabase_UpdateGatewaysDynamicFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*pb.Gateway
+		if args[1] != nil {
+			arg1 = args[1].([]*pb.Gateway)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_UpdateGatewaysDynamicFields_Call) Return(err error) *MockDatabase_UpdateGatewaysDynamicFields_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_UpdateGatewaysDynamicFields_Call) RunAndReturn(run func(ctx context.Context, gateways []*pb.Gateway) error) *MockDatabase_UpdateGatewaysDynamicFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateKolideChecks provides a mock function for the type MockDatabase
 func (_mock *MockDatabase) UpdateKolideChecks(ctx context.Context, checks []*kolide.Check) error {
 	ret := _mock.Called(ctx, checks)
@@ -1924,6 +3562,69 @@ func (_c *MockDatabase_UpdateKolideIssuesForDevice_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdateSessionGroups provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) UpdateSessionGroups(ctx context.Context, key string, groups []string) error {
+	ret := _mock.Called(ctx, key, groups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSessionGroups")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, key, groups)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_UpdateSessionGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSessionGroups'
+type MockDatabase_UpdateSessionGroups_Call struct {
+	*mock.Call
+}
+
+// UpdateSessionGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - groups []string
+func (_e *MockDatabase_Expecter) UpdateSessionGroups(ctx interface{}, key interface{}, groups interface{}) *MockDatabase_UpdateSessionGroups_Call {
+	return &MockDatabase_UpdateSessionGroups_Call{Call: _e.mock.On("UpdateSessionGroups", ctx, key, groups)}
+}
+
+func (_c *MockDatabase_UpdateSessionGroups_Call) Run(run func(ctx context.Context, key string, groups []string)) *MockDatabase_UpdateSessionGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_UpdateSessionGroups_Call) Return(err error) *MockDatabase_UpdateSessionGroups_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_UpdateSessionGroups_Call) RunAndReturn(run func(ctx context.Context, key string, groups []string) error) *MockDatabase_UpdateSessionGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UserHasAccessToPrivilegedGateway provides a mock function for the type MockDatabase
 func (_mock *MockDatabase) UserHasAccessToPrivilegedGateway(ctx context.Context, userID string, gatewayName string) (bool, error) {
 	ret := _mock.Called(ctx, userID, gatewayName)
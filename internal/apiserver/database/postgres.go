@@ -0,0 +1,402 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nais/device/internal/apiserver/sqlc"
+	"github.com/nais/device/internal/apiserver/sqlc/postgres"
+	"github.com/sirupsen/logrus"
+)
+
+// postgresQueries adapts the queries generated for PostgreSQL to the sqlc.Querier interface used by the database,
+// so that the same database implementation can run on top of both SQLite and PostgreSQL.
+type postgresQueries struct {
+	queries *postgres.Queries
+	db      *sql.DB
+	log     logrus.FieldLogger
+}
+
+var _ Querier = &postgresQueries{}
+
+func newPostgresQuerier(db *sql.DB, log logrus.FieldLogger) *postgresQueries {
+	return &postgresQueries{
+		queries: postgres.New(db),
+		db:      db,
+		log:     log,
+	}
+}
+
+func (q *postgresQueries) Transaction(ctx context.Context, callback func(ctx context.Context, queries sqlc.Querier) error) error {
+	tx, err := q.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			q.log.Errorf("transaction rollback error: %v", err)
+		}
+	}()
+
+	if err = callback(ctx, &postgresQueries{queries: q.queries.WithTx(tx), log: q.log}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (q *postgresQueries) Snapshot(context.Context, string) error {
+	return ErrSnapshotNotSupported
+}
+
+func (q *postgresQueries) AcceptAcceptableUse(ctx context.Context, arg sqlc.AcceptAcceptableUseParams) error {
+	return q.queries.AcceptAcceptableUse(ctx, postgres.AcceptAcceptableUseParams(arg))
+}
+
+func (q *postgresQueries) AddAuditEvent(ctx context.Context, arg sqlc.AddAuditEventParams) error {
+	return q.queries.AddAuditEvent(ctx, postgres.AddAuditEventParams(arg))
+}
+
+func (q *postgresQueries) AddDevice(ctx context.Context, arg sqlc.AddDeviceParams) error {
+	return q.queries.AddDevice(ctx, postgres.AddDeviceParams(arg))
+}
+
+func (q *postgresQueries) AddGateway(ctx context.Context, arg sqlc.AddGatewayParams) error {
+	return q.queries.AddGateway(ctx, postgres.AddGatewayParams(arg))
+}
+
+func (q *postgresQueries) AddGatewayAccessGroupID(ctx context.Context, arg sqlc.AddGatewayAccessGroupIDParams) error {
+	return q.queries.AddGatewayAccessGroupID(ctx, postgres.AddGatewayAccessGroupIDParams(arg))
+}
+
+func (q *postgresQueries) AddGatewayRoute(ctx context.Context, arg sqlc.AddGatewayRouteParams) error {
+	return q.queries.AddGatewayRoute(ctx, postgres.AddGatewayRouteParams(arg))
+}
+
+func (q *postgresQueries) AddSession(ctx context.Context, arg sqlc.AddSessionParams) error {
+	// SQLite replaces any session with the same key or device, PostgreSQL needs to remove them explicitly
+	err := q.queries.RemoveConflictingSessions(ctx, postgres.RemoveConflictingSessionsParams{
+		Key:      arg.Key,
+		DeviceID: arg.DeviceID,
+	})
+	if err != nil {
+		return err
+	}
+	return q.queries.AddSession(ctx, postgres.AddSessionParams(arg))
+}
+
+func (q *postgresQueries) AddSessionAccessGroupID(ctx context.Context, arg sqlc.AddSessionAccessGroupIDParams) error {
+	return q.queries.AddSessionAccessGroupID(ctx, postgres.AddSessionAccessGroupIDParams(arg))
+}
+
+func (q *postgresQueries) CountGatewayJitaGrantsForUserSince(ctx context.Context, arg sqlc.CountGatewayJitaGrantsForUserSinceParams) (int64, error) {
+	since, _ := arg.Since.(string)
+	return q.queries.CountGatewayJitaGrantsForUserSince(ctx, postgres.CountGatewayJitaGrantsForUserSinceParams{
+		UserID:      arg.UserID,
+		GatewayName: arg.GatewayName,
+		Since:       since,
+	})
+}
+
+func (q *postgresQueries) CreateLease(ctx context.Context, arg sqlc.CreateLeaseParams) (int64, error) {
+	return q.queries.CreateLease(ctx, postgres.CreateLeaseParams(arg))
+}
+
+func (q *postgresQueries) CreateIssueExemption(ctx context.Context, arg sqlc.CreateIssueExemptionParams) (*sqlc.IssueExemption, error) {
+	row, err := q.queries.CreateIssueExemption(ctx, postgres.CreateIssueExemptionParams(arg))
+	return (*sqlc.IssueExemption)(row), err
+}
+
+func (q *postgresQueries) DeleteDevice(ctx context.Context, id int64) (int64, error) {
+	return q.queries.DeleteDevice(ctx, id)
+}
+
+func (q *postgresQueries) DeleteGateway(ctx context.Context, name string) (int64, error) {
+	return q.queries.DeleteGateway(ctx, name)
+}
+
+func (q *postgresQueries) DeleteGatewayAccessGroupIDs(ctx context.Context, gatewayName string) error {
+	return q.queries.DeleteGatewayAccessGroupIDs(ctx, gatewayName)
+}
+
+func (q *postgresQueries) DeleteGatewayJitaGrants(ctx context.Context, gatewayName string) error {
+	return q.queries.DeleteGatewayJitaGrants(ctx, gatewayName)
+}
+
+func (q *postgresQueries) DeleteGatewayRoutes(ctx context.Context, gatewayName string) error {
+	return q.queries.DeleteGatewayRoutes(ctx, gatewayName)
+}
+
+func (q *postgresQueries) DeleteKolideIssuesForDevice(ctx context.Context, deviceID string) error {
+	return q.queries.DeleteKolideIssuesForDevice(ctx, deviceID)
+}
+
+func (q *postgresQueries) GetAcceptance(ctx context.Context, userID string) (*sqlc.Acceptance, error) {
+	row, err := q.queries.GetAcceptance(ctx, userID)
+	return (*sqlc.Acceptance)(row), err
+}
+
+func (q *postgresQueries) GetAcceptances(ctx context.Context) ([]*sqlc.Acceptance, error) {
+	rows, err := q.queries.GetAcceptances(ctx)
+	return convertRows(rows, func(row *postgres.Acceptance) *sqlc.Acceptance { return (*sqlc.Acceptance)(row) }), err
+}
+
+func (q *postgresQueries) GetActiveIssueExemptionsForDevice(ctx context.Context, arg sqlc.GetActiveIssueExemptionsForDeviceParams) ([]*sqlc.IssueExemption, error) {
+	rows, err := q.queries.GetActiveIssueExemptionsForDevice(ctx, postgres.GetActiveIssueExemptionsForDeviceParams(arg))
+	return convertRows(rows, func(row *postgres.IssueExemption) *sqlc.IssueExemption { return (*sqlc.IssueExemption)(row) }), err
+}
+
+func (q *postgresQueries) GetAuditEvents(ctx context.Context, arg sqlc.GetAuditEventsParams) ([]*sqlc.AuditEvent, error) {
+	rows, err := q.queries.GetAuditEvents(ctx, postgres.GetAuditEventsParams(arg))
+	return convertRows(rows, func(row *postgres.AuditEvent) *sqlc.AuditEvent { return (*sqlc.AuditEvent)(row) }), err
+}
+
+func (q *postgresQueries) GetDeviceByExternalID(ctx context.Context, externalID sql.NullString) (*sqlc.Device, error) {
+	row, err := q.queries.GetDeviceByExternalID(ctx, externalID)
+	return (*sqlc.Device)(row), err
+}
+
+func (q *postgresQueries) GetDeviceByID(ctx context.Context, id int64) (*sqlc.Device, error) {
+	row, err := q.queries.GetDeviceByID(ctx, id)
+	return (*sqlc.Device)(row), err
+}
+
+func (q *postgresQueries) GetDeviceByPublicKey(ctx context.Context, publicKey string) (*sqlc.Device, error) {
+	row, err := q.queries.GetDeviceByPublicKey(ctx, publicKey)
+	return (*sqlc.Device)(row), err
+}
+
+func (q *postgresQueries) GetDeviceBySerialAndPlatform(ctx context.Context, arg sqlc.GetDeviceBySerialAndPlatformParams) (*sqlc.Device, error) {
+	row, err := q.queries.GetDeviceBySerialAndPlatform(ctx, postgres.GetDeviceBySerialAndPlatformParams(arg))
+	return (*sqlc.Device)(row), err
+}
+
+func (q *postgresQueries) GetDevices(ctx context.Context) ([]*sqlc.Device, error) {
+	rows, err := q.queries.GetDevices(ctx)
+	return convertRows(rows, func(row *postgres.Device) *sqlc.Device { return (*sqlc.Device)(row) }), err
+}
+
+func (q *postgresQueries) GetGatewayAccessGroupIDs(ctx context.Context, gatewayName string) ([]string, error) {
+	return q.queries.GetGatewayAccessGroupIDs(ctx, gatewayName)
+}
+
+func (q *postgresQueries) GetGatewayByName(ctx context.Context, name string) (*sqlc.Gateway, error) {
+	row, err := q.queries.GetGatewayByName(ctx, name)
+	return (*sqlc.Gateway)(row), err
+}
+
+func (q *postgresQueries) GetGatewayJitaGrant(ctx context.Context, id int64) (*sqlc.GatewayJitaGrant, error) {
+	row, err := q.queries.GetGatewayJitaGrant(ctx, id)
+	return (*sqlc.GatewayJitaGrant)(row), err
+}
+
+func (q *postgresQueries) GetGatewayJitaGrants(ctx context.Context, arg sqlc.GetGatewayJitaGrantsParams) ([]*sqlc.GatewayJitaGrant, error) {
+	rows, err := q.queries.GetGatewayJitaGrants(ctx, postgres.GetGatewayJitaGrantsParams(arg))
+	return convertRows(rows, func(row *postgres.GatewayJitaGrant) *sqlc.GatewayJitaGrant { return (*sqlc.GatewayJitaGrant)(row) }), err
+}
+
+func (q *postgresQueries) GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*sqlc.GatewayJitaGrant, error) {
+	rows, err := q.queries.GetGatewayJitaGrantsForUser(ctx, userID)
+	return convertRows(rows, func(row *postgres.GatewayJitaGrant) *sqlc.GatewayJitaGrant { return (*sqlc.GatewayJitaGrant)(row) }), err
+}
+
+func (q *postgresQueries) GetGatewayRoutes(ctx context.Context, gatewayName string) ([]*sqlc.GetGatewayRoutesRow, error) {
+	rows, err := q.queries.GetGatewayRoutes(ctx, gatewayName)
+	return convertRows(rows, func(row *postgres.GetGatewayRoutesRow) *sqlc.GetGatewayRoutesRow {
+		return (*sqlc.GetGatewayRoutesRow)(row)
+	}), err
+}
+
+func (q *postgresQueries) GetGateways(ctx context.Context) ([]*sqlc.Gateway, error) {
+	rows, err := q.queries.GetGateways(ctx)
+	return convertRows(rows, func(row *postgres.Gateway) *sqlc.Gateway { return (*sqlc.Gateway)(row) }), err
+}
+
+func (q *postgresQueries) GetIssueExemption(ctx context.Context, id int64) (*sqlc.IssueExemption, error) {
+	row, err := q.queries.GetIssueExemption(ctx, id)
+	return (*sqlc.IssueExemption)(row), err
+}
+
+func (q *postgresQueries) GetIssueExemptions(ctx context.Context, activeOnly bool) ([]*sqlc.IssueExemption, error) {
+	rows, err := q.queries.GetIssueExemptions(ctx, activeOnly)
+	return convertRows(rows, func(row *postgres.IssueExemption) *sqlc.IssueExemption { return (*sqlc.IssueExemption)(row) }), err
+}
+
+func (q *postgresQueries) GetKolideCheck(ctx context.Context, id int64) (*sqlc.KolideCheck, error) {
+	row, err := q.queries.GetKolideCheck(ctx, id)
+	return (*sqlc.KolideCheck)(row), err
+}
+
+func (q *postgresQueries) GetKolideChecks(ctx context.Context) ([]*sqlc.KolideCheck, error) {
+	rows, err := q.queries.GetKolideChecks(ctx)
+	return convertRows(rows, func(row *postgres.KolideCheck) *sqlc.KolideCheck { return (*sqlc.KolideCheck)(row) }), err
+}
+
+func (q *postgresQueries) GetKolideIssues(ctx context.Context) ([]*sqlc.GetKolideIssuesRow, error) {
+	rows, err := q.queries.GetKolideIssues(ctx)
+	return convertRows(rows, func(row *postgres.GetKolideIssuesRow) *sqlc.GetKolideIssuesRow {
+		return (*sqlc.GetKolideIssuesRow)(row)
+	}), err
+}
+
+func (q *postgresQueries) GetKolideIssuesForDevice(ctx context.Context, deviceID string) ([]*sqlc.GetKolideIssuesForDeviceRow, error) {
+	rows, err := q.queries.GetKolideIssuesForDevice(ctx, deviceID)
+	return convertRows(rows, func(row *postgres.GetKolideIssuesForDeviceRow) *sqlc.GetKolideIssuesForDeviceRow {
+		return (*sqlc.GetKolideIssuesForDeviceRow)(row)
+	}), err
+}
+
+func (q *postgresQueries) GetLastUsedIPV6(ctx context.Context) (string, error) {
+	return q.queries.GetLastUsedIPV6(ctx)
+}
+
+func (q *postgresQueries) GetMostRecentDeviceSession(ctx context.Context, sessionDeviceID int64) (*sqlc.GetMostRecentDeviceSessionRow, error) {
+	row, err := q.queries.GetMostRecentDeviceSession(ctx, sessionDeviceID)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlc.GetMostRecentDeviceSessionRow{Session: sqlc.Session(row.Session), Device: sqlc.Device(row.Device)}, nil
+}
+
+func (q *postgresQueries) GetPeers(ctx context.Context) ([]*sqlc.GetPeersRow, error) {
+	rows, err := q.queries.GetPeers(ctx)
+	return convertRows(rows, func(row *postgres.GetPeersRow) *sqlc.GetPeersRow { return (*sqlc.GetPeersRow)(row) }), err
+}
+
+func (q *postgresQueries) GetPendingGatewayJitaGrants(ctx context.Context) ([]*sqlc.GatewayJitaGrant, error) {
+	rows, err := q.queries.GetPendingGatewayJitaGrants(ctx)
+	return convertRows(rows, func(row *postgres.GatewayJitaGrant) *sqlc.GatewayJitaGrant { return (*sqlc.GatewayJitaGrant)(row) }), err
+}
+
+func (q *postgresQueries) GetSessionByKey(ctx context.Context, sessionKey string) (*sqlc.GetSessionByKeyRow, error) {
+	row, err := q.queries.GetSessionByKey(ctx, sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlc.GetSessionByKeyRow{Session: sqlc.Session(row.Session), Device: sqlc.Device(row.Device)}, nil
+}
+
+func (q *postgresQueries) GetSessionGroupIDs(ctx context.Context, sessionKey string) ([]string, error) {
+	return q.queries.GetSessionGroupIDs(ctx, sessionKey)
+}
+
+func (q *postgresQueries) GetSessions(ctx context.Context) ([]*sqlc.GetSessionsRow, error) {
+	rows, err := q.queries.GetSessions(ctx)
+	return convertRows(rows, func(row *postgres.GetSessionsRow) *sqlc.GetSessionsRow {
+		return &sqlc.GetSessionsRow{Session: sqlc.Session(row.Session), Device: sqlc.Device(row.Device)}
+	}), err
+}
+
+func (q *postgresQueries) GrantPrivilegedGatewayAccess(ctx context.Context, arg sqlc.GrantPrivilegedGatewayAccessParams) error {
+	return q.queries.GrantPrivilegedGatewayAccess(ctx, postgres.GrantPrivilegedGatewayAccessParams(arg))
+}
+
+func (q *postgresQueries) RejectAcceptableUse(ctx context.Context, userID string) error {
+	return q.queries.RejectAcceptableUse(ctx, userID)
+}
+
+func (q *postgresQueries) ReleaseLease(ctx context.Context, arg sqlc.ReleaseLeaseParams) error {
+	return q.queries.ReleaseLease(ctx, postgres.ReleaseLeaseParams(arg))
+}
+
+func (q *postgresQueries) RemoveExpiredSessions(ctx context.Context) error {
+	return q.queries.RemoveExpiredSessions(ctx)
+}
+
+func (q *postgresQueries) RemoveSession(ctx context.Context, key string) error {
+	return q.queries.RemoveSession(ctx, key)
+}
+
+func (q *postgresQueries) RemoveSessionAccessGroupIDs(ctx context.Context, sessionKey string) error {
+	return q.queries.RemoveSessionAccessGroupIDs(ctx, sessionKey)
+}
+
+func (q *postgresQueries) RemoveSessionsForDevice(ctx context.Context, deviceID int64) error {
+	return q.queries.RemoveSessionsForDevice(ctx, deviceID)
+}
+
+func (q *postgresQueries) RemoveSessionsForUser(ctx context.Context, objectID string) error {
+	return q.queries.RemoveSessionsForUser(ctx, objectID)
+}
+
+func (q *postgresQueries) RenewLease(ctx context.Context, arg sqlc.RenewLeaseParams) (int64, error) {
+	return q.queries.RenewLease(ctx, postgres.RenewLeaseParams(arg))
+}
+
+func (q *postgresQueries) ReviewGatewayJitaGrant(ctx context.Context, arg sqlc.ReviewGatewayJitaGrantParams) (int64, error) {
+	return q.queries.ReviewGatewayJitaGrant(ctx, postgres.ReviewGatewayJitaGrantParams(arg))
+}
+
+func (q *postgresQueries) RevokeGatewayJitaGrant(ctx context.Context, arg sqlc.RevokeGatewayJitaGrantParams) error {
+	return q.queries.RevokeGatewayJitaGrant(ctx, postgres.RevokeGatewayJitaGrantParams(arg))
+}
+
+func (q *postgresQueries) RevokeIssueExemption(ctx context.Context, arg sqlc.RevokeIssueExemptionParams) error {
+	return q.queries.RevokeIssueExemption(ctx, postgres.RevokeIssueExemptionParams(arg))
+}
+
+func (q *postgresQueries) RevokePrivilegedGatewayAccess(ctx context.Context, arg sqlc.RevokePrivilegedGatewayAccessParams) error {
+	return q.queries.RevokePrivilegedGatewayAccess(ctx, postgres.RevokePrivilegedGatewayAccessParams(arg))
+}
+
+func (q *postgresQueries) SetKolideCheck(ctx context.Context, arg sqlc.SetKolideCheckParams) error {
+	return q.queries.SetKolideCheck(ctx, postgres.SetKolideCheckParams(arg))
+}
+
+func (q *postgresQueries) SetKolideIssue(ctx context.Context, arg sqlc.SetKolideIssueParams) error {
+	return q.queries.SetKolideIssue(ctx, postgres.SetKolideIssueParams(arg))
+}
+
+func (q *postgresQueries) TruncateKolideIssues(ctx context.Context) error {
+	return q.queries.TruncateKolideIssues(ctx)
+}
+
+func (q *postgresQueries) UpdateDevice(ctx context.Context, arg sqlc.UpdateDeviceParams) error {
+	return q.queries.UpdateDevice(ctx, postgres.UpdateDeviceParams(arg))
+}
+
+func (q *postgresQueries) UpdateDeviceAgentVersion(ctx context.Context, arg sqlc.UpdateDeviceAgentVersionParams) error {
+	return q.queries.UpdateDeviceAgentVersion(ctx, postgres.UpdateDeviceAgentVersionParams(arg))
+}
+
+func (q *postgresQueries) UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, arg sqlc.UpdateDeviceAgentVersionOutdatedSinceParams) error {
+	return q.queries.UpdateDeviceAgentVersionOutdatedSince(ctx, postgres.UpdateDeviceAgentVersionOutdatedSinceParams(arg))
+}
+
+func (q *postgresQueries) UpdateDeviceUsername(ctx context.Context, arg sqlc.UpdateDeviceUsernameParams) (int64, error) {
+	return q.queries.UpdateDeviceUsername(ctx, postgres.UpdateDeviceUsernameParams(arg))
+}
+
+func (q *postgresQueries) UpdateGateway(ctx context.Context, arg sqlc.UpdateGatewayParams) error {
+	return q.queries.UpdateGateway(ctx, postgres.UpdateGatewayParams(arg))
+}
+
+func (q *postgresQueries) UpdateGatewayDynamicFields(ctx context.Context, arg sqlc.UpdateGatewayDynamicFieldsParams) error {
+	return q.queries.UpdateGatewayDynamicFields(ctx, postgres.UpdateGatewayDynamicFieldsParams(arg))
+}
+
+func (q *postgresQueries) UserHasAccessToPrivilegedGateway(ctx context.Context, arg sqlc.UserHasAccessToPrivilegedGatewayParams) (int64, error) {
+	hasAccess, err := q.queries.UserHasAccessToPrivilegedGateway(ctx, postgres.UserHasAccessToPrivilegedGatewayParams(arg))
+	if err != nil || !hasAccess {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func (q *postgresQueries) UsersWithAccessToPrivilegedGateway(ctx context.Context, gatewayName string) ([]string, error) {
+	return q.queries.UsersWithAccessToPrivilegedGateway(ctx, gatewayName)
+}
+
+func convertRows[From, To any](rows []*From, convert func(*From) *To) []*To {
+	if rows == nil {
+		return nil
+	}
+
+	converted := make([]*To, len(rows))
+	for i, row := range rows {
+		converted[i] = convert(row)
+	}
+	return converted
+}
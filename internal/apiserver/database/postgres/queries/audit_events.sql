@@ -0,0 +1,15 @@
+-- name: AddAuditEvent :exec
+INSERT INTO audit_events (created, actor, action, target, reason)
+VALUES (@created, @actor, @action, @target, @reason);
+
+-- name: GetAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+    (CAST(@actor AS TEXT) = '' OR actor = @actor)
+    AND (CAST(@action AS TEXT) = '' OR action = @action)
+    AND (CAST(@target AS TEXT) = '' OR target = @target)
+    AND (CAST(@since AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) >= CAST(NULLIF(@since, '') AS TIMESTAMPTZ))
+    AND (CAST(@until AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) < CAST(NULLIF(@until, '') AS TIMESTAMPTZ))
+    AND (CAST(@before_id AS BIGINT) = 0 OR id < @before_id)
+ORDER BY id DESC
+LIMIT CAST(@page_size AS BIGINT);
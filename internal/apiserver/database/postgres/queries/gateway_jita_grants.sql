@@ -0,0 +1,99 @@
+-- name: GetGatewayJitaGrantsForUser :many
+SELECT * FROM gateway_jita_grants
+WHERE user_id = @user_id
+ORDER BY id DESC
+LIMIT 10;
+
+-- name: UserHasAccessToPrivilegedGateway :one
+SELECT EXISTS(
+    SELECT id FROM gateway_jita_grants
+    WHERE
+        user_id = @user_id
+        AND gateway_name = @gateway_name
+        AND CAST(expires AS TIMESTAMPTZ) > NOW()
+        AND revoked IS NULL
+        AND approval IN ('not_required', 'approved')
+) AS has_access;
+
+-- name: UsersWithAccessToPrivilegedGateway :many
+SELECT user_id FROM gateway_jita_grants
+WHERE
+    gateway_name = @gateway_name
+    AND CAST(expires AS TIMESTAMPTZ) > NOW()
+    AND revoked IS NULL
+    AND approval IN ('not_required', 'approved');
+
+-- name: GrantPrivilegedGatewayAccess :exec
+INSERT INTO gateway_jita_grants (
+    user_id,
+    gateway_name,
+    created,
+    expires,
+    reason,
+    approval
+)
+VALUES (
+    @user_id,
+    @gateway_name,
+    @created,
+    @expires,
+    @reason,
+    @approval
+);
+
+-- name: RevokePrivilegedGatewayAccess :exec
+UPDATE gateway_jita_grants
+SET revoked = @revoked
+WHERE
+    user_id = @user_id
+    AND gateway_name = @gateway_name
+    AND revoked IS NULL;
+
+-- name: DeleteGatewayJitaGrants :exec
+DELETE FROM gateway_jita_grants WHERE gateway_name = @gateway_name;
+
+-- name: GetGatewayJitaGrants :many
+SELECT * FROM gateway_jita_grants
+WHERE
+    (CAST(@gateway_name AS TEXT) = '' OR gateway_name = @gateway_name)
+    AND (CAST(@user_id AS TEXT) = '' OR user_id = @user_id)
+    AND (CAST(@since AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) >= CAST(NULLIF(@since, '') AS TIMESTAMPTZ))
+    AND (CAST(@until AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) < CAST(NULLIF(@until, '') AS TIMESTAMPTZ))
+    AND (
+        CAST(@active_only AS BOOLEAN) = FALSE
+        OR (CAST(expires AS TIMESTAMPTZ) > NOW() AND revoked IS NULL AND approval IN ('not_required', 'approved'))
+    )
+ORDER BY id DESC;
+
+-- name: GetGatewayJitaGrant :one
+SELECT * FROM gateway_jita_grants WHERE id = @id;
+
+-- name: RevokeGatewayJitaGrant :exec
+UPDATE gateway_jita_grants
+SET revoked = @revoked
+WHERE
+    id = @id
+    AND revoked IS NULL;
+
+-- name: GetPendingGatewayJitaGrants :many
+SELECT * FROM gateway_jita_grants
+WHERE
+    approval = 'pending'
+    AND CAST(expires AS TIMESTAMPTZ) > NOW()
+    AND revoked IS NULL
+ORDER BY id;
+
+-- name: ReviewGatewayJitaGrant :execrows
+UPDATE gateway_jita_grants
+SET approval = @approval, approver = @approver, decided = @decided, expires = @expires
+WHERE
+    id = @id
+    AND approval = 'pending'
+    AND revoked IS NULL;
+
+-- name: CountGatewayJitaGrantsForUserSince :one
+SELECT COUNT(*) FROM gateway_jita_grants
+WHERE
+    user_id = @user_id
+    AND gateway_name = @gateway_name
+    AND CAST(created AS TIMESTAMPTZ) >= CAST(CAST(@since AS TEXT) AS TIMESTAMPTZ);
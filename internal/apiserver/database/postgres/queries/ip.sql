@@ -0,0 +1,3 @@
+-- name: GetLastUsedIPV6 :one
+SELECT CAST(COALESCE(GREATEST(MAX(devices.ipv6), MAX(gateways.ipv6)), '') AS TEXT) AS ipv6
+FROM devices, gateways;
@@ -0,0 +1,30 @@
+-- name: CreateIssueExemption :one
+INSERT INTO issue_exemptions (device_id, username, check_id, reason, created_by, created, expires)
+VALUES (@device_id, @username, @check_id, @reason, @created_by, @created, @expires)
+RETURNING *;
+
+-- name: GetIssueExemption :one
+SELECT * FROM issue_exemptions WHERE id = @id;
+
+-- name: GetIssueExemptions :many
+SELECT * FROM issue_exemptions
+WHERE
+    CAST(@active_only AS BOOLEAN) = FALSE
+    OR (CAST(expires AS TIMESTAMPTZ) > NOW() AND revoked IS NULL)
+ORDER BY id DESC;
+
+-- name: GetActiveIssueExemptionsForDevice :many
+SELECT * FROM issue_exemptions
+WHERE
+    CAST(expires AS TIMESTAMPTZ) > NOW()
+    AND revoked IS NULL
+    AND (device_id IS NULL OR device_id = @device_id)
+    AND (username IS NULL OR username = @username)
+ORDER BY id;
+
+-- name: RevokeIssueExemption :exec
+UPDATE issue_exemptions
+SET revoked = @revoked
+WHERE
+    id = @id
+    AND revoked IS NULL;
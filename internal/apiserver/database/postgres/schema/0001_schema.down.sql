@@ -0,0 +1,11 @@
+DROP TABLE audit_events;
+DROP TABLE gateway_jita_grants;
+DROP TABLE acceptances;
+DROP TABLE kolide_issues;
+DROP TABLE kolide_checks;
+DROP TABLE session_access_group_ids;
+DROP TABLE sessions;
+DROP TABLE gateway_routes;
+DROP TABLE gateway_access_group_ids;
+DROP TABLE gateways;
+DROP TABLE devices;
@@ -0,0 +1,140 @@
+-- PostgreSQL schema, equivalent to the SQLite schema after migration 0012.
+-- Columns are kept in the same order as in SQLite, so that the generated models are interchangeable.
+
+-- tables
+
+CREATE TABLE devices
+(
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    username TEXT NOT NULL,
+    serial TEXT NOT NULL,
+    platform TEXT CHECK(platform IN ('darwin', 'linux', 'windows')) NOT NULL,
+    healthy BOOLEAN NOT NULL DEFAULT FALSE,
+    last_updated TEXT,
+    public_key TEXT NOT NULL,
+    ipv4 TEXT NOT NULL,
+    ipv6 TEXT NOT NULL DEFAULT '',
+    last_seen TEXT,
+    external_id TEXT,
+    UNIQUE (serial, platform),
+    UNIQUE (public_key),
+    UNIQUE (ipv4)
+);
+
+CREATE TABLE gateways
+(
+    name TEXT PRIMARY KEY,
+    endpoint TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    ipv4 TEXT NOT NULL,
+    requires_privileged_access BOOLEAN NOT NULL DEFAULT FALSE,
+    password_hash TEXT NOT NULL,
+    ipv6 TEXT NOT NULL DEFAULT '',
+    requires_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    jita_max_duration_seconds BIGINT NOT NULL DEFAULT 0,
+    jita_min_reason_length BIGINT NOT NULL DEFAULT 0,
+    jita_reason_pattern TEXT NOT NULL DEFAULT '',
+    jita_max_grants_per_day BIGINT NOT NULL DEFAULT 0,
+    UNIQUE (public_key),
+    UNIQUE (ipv4)
+);
+
+CREATE TABLE gateway_access_group_ids
+(
+    gateway_name TEXT NOT NULL,
+    group_id TEXT NOT NULL,
+    PRIMARY KEY(gateway_name, group_id),
+    FOREIGN KEY (gateway_name) REFERENCES gateways(name) ON DELETE CASCADE
+);
+
+CREATE TABLE gateway_routes
+(
+    gateway_name TEXT NOT NULL,
+    route TEXT NOT NULL,
+    family TEXT CHECK(family IN ('IPv4', 'IPv6')) NOT NULL DEFAULT 'IPv4',
+    PRIMARY KEY(gateway_name, route),
+    FOREIGN KEY (gateway_name) REFERENCES gateways(name) ON DELETE CASCADE
+);
+
+CREATE TABLE sessions
+(
+    key TEXT NOT NULL,
+    expiry TEXT NOT NULL,
+    device_id BIGINT NOT NULL,
+    object_id TEXT NOT NULL,
+    UNIQUE (key),
+    FOREIGN KEY (device_id) REFERENCES devices(id) ON DELETE CASCADE
+);
+
+CREATE TABLE session_access_group_ids (
+    session_key TEXT NOT NULL,
+    group_id TEXT NOT NULL,
+    PRIMARY KEY(session_key, group_id),
+    FOREIGN KEY (session_key) REFERENCES sessions(key) ON DELETE CASCADE
+);
+
+CREATE TABLE kolide_checks (
+    id BIGINT PRIMARY KEY,
+    tags TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE kolide_issues (
+    id BIGINT PRIMARY KEY,
+    device_id TEXT NOT NULL,
+    check_id BIGINT NOT NULL,
+    title TEXT NOT NULL,
+    detected_at TEXT NOT NULL,
+    resolved_at TEXT,
+    last_updated TEXT NOT NULL,
+    ignored BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (check_id) REFERENCES kolide_checks(id) ON DELETE CASCADE
+);
+
+CREATE TABLE acceptances (
+    user_id TEXT NOT NULL PRIMARY KEY,
+    accepted_at TEXT NOT NULL
+);
+
+CREATE TABLE gateway_jita_grants (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    gateway_name TEXT NOT NULL,
+    created TEXT NOT NULL,
+    expires TEXT NOT NULL,
+    revoked TEXT,
+    reason TEXT NOT NULL,
+    approval TEXT CHECK(approval IN ('not_required', 'pending', 'approved', 'denied')) NOT NULL DEFAULT 'not_required',
+    approver TEXT,
+    decided TEXT,
+    FOREIGN KEY (gateway_name) REFERENCES gateways(name) ON DELETE CASCADE
+);
+
+CREATE TABLE audit_events (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL,
+    reason TEXT NOT NULL
+);
+
+-- indexes
+
+CREATE INDEX session_expiry_idx ON sessions (expiry);
+CREATE UNIQUE INDEX sessions_device_id_unique ON sessions (device_id);
+CREATE INDEX devices_username_idx ON devices (LOWER(username));
+CREATE INDEX devices_ipv6_idx ON devices (ipv6);
+CREATE UNIQUE INDEX devices_external_id ON devices (external_id);
+CREATE INDEX gateways_ipv6_idx ON gateways (ipv6);
+CREATE INDEX kolide_issues_device_id ON kolide_issues (device_id);
+CREATE INDEX gateway_jita_grants_user_id_idx ON gateway_jita_grants (user_id);
+CREATE INDEX gateway_jita_grants_gateway_idx ON gateway_jita_grants (gateway_name);
+CREATE INDEX gateway_jita_grants_expires_idx ON gateway_jita_grants (expires);
+CREATE INDEX gateway_jita_grants_revoked_idx ON gateway_jita_grants (revoked);
+CREATE INDEX gateway_jita_grants_approval_idx ON gateway_jita_grants (approval);
+CREATE INDEX audit_events_created_idx ON audit_events (created);
+CREATE INDEX audit_events_actor_idx ON audit_events (actor);
+CREATE INDEX audit_events_action_idx ON audit_events (action);
+CREATE INDEX audit_events_target_idx ON audit_events (target);
@@ -0,0 +1,16 @@
+CREATE TABLE issue_exemptions (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    device_id BIGINT,
+    username TEXT,
+    check_id BIGINT,
+    reason TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created TEXT NOT NULL,
+    expires TEXT NOT NULL,
+    revoked TEXT,
+    FOREIGN KEY (device_id) REFERENCES devices(id) ON DELETE CASCADE
+);
+
+CREATE INDEX issue_exemptions_device_id_idx ON issue_exemptions (device_id);
+CREATE INDEX issue_exemptions_username_idx ON issue_exemptions (username);
+CREATE INDEX issue_exemptions_expires_idx ON issue_exemptions (expires);
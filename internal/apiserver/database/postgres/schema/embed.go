@@ -0,0 +1,8 @@
+package schema
+
+import (
+	"embed"
+)
+
+//go:embed *.sql
+var FS embed.FS
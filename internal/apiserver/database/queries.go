@@ -17,7 +17,8 @@ type Queries struct {
 
 type Querier interface {
 	sqlc.Querier
-	Transaction(ctx context.Context, callback func(ctx context.Context, queries *sqlc.Queries) error) error
+	Transaction(ctx context.Context, callback func(ctx context.Context, queries sqlc.Querier) error) error
+	Snapshot(ctx context.Context, path string) error
 }
 
 func NewQuerier(db *sql.DB, log logrus.FieldLogger) *Queries {
@@ -28,7 +29,7 @@ func NewQuerier(db *sql.DB, log logrus.FieldLogger) *Queries {
 	}
 }
 
-func (q *Queries) Transaction(ctx context.Context, callback func(ctx context.Context, queries *sqlc.Queries) error) error {
+func (q *Queries) Transaction(ctx context.Context, callback func(ctx context.Context, queries sqlc.Querier) error) error {
 	tx, err := q.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return err
@@ -46,3 +47,9 @@ func (q *Queries) Transaction(ctx context.Context, callback func(ctx context.Con
 
 	return tx.Commit()
 }
+
+// Snapshot writes a consistent copy of the database to path, which must not exist.
+func (q *Queries) Snapshot(ctx context.Context, path string) error {
+	_, err := q.db.ExecContext(ctx, "VACUUM INTO ?", path)
+	return err
+}
@@ -0,0 +1,15 @@
+-- name: AddAuditEvent :exec
+INSERT INTO audit_events (created, actor, action, target, reason)
+VALUES (@created, @actor, @action, @target, @reason);
+
+-- name: GetAuditEvents :many
+SELECT * FROM audit_events
+WHERE
+    (CAST(@actor AS TEXT) = '' OR actor = @actor)
+    AND (CAST(@action AS TEXT) = '' OR action = @action)
+    AND (CAST(@target AS TEXT) = '' OR target = @target)
+    AND (CAST(@since AS TEXT) = '' OR DATETIME(created) >= DATETIME(@since))
+    AND (CAST(@until AS TEXT) = '' OR DATETIME(created) < DATETIME(@until))
+    AND (CAST(@before_id AS INTEGER) = 0 OR id < @before_id)
+ORDER BY id DESC
+LIMIT @page_size;
@@ -26,3 +26,21 @@ INSERT INTO devices (serial, username, public_key, ipv4, ipv6, healthy, platform
 VALUES (@serial, @username, @public_key, @ipv4, @ipv6, @healthy, @platform)
 ON CONFLICT(serial, platform) DO
     UPDATE SET username = excluded.username, public_key = excluded.public_key, ipv6 = excluded.ipv6;
+
+-- name: UpdateDeviceUsername :execrows
+UPDATE devices
+SET username = @username
+WHERE id = @id;
+
+-- name: DeleteDevice :execrows
+DELETE FROM devices WHERE id = @id;
+
+-- name: UpdateDeviceAgentVersion :exec
+UPDATE devices
+SET agent_version = @agent_version
+WHERE id = @id;
+
+-- name: UpdateDeviceAgentVersionOutdatedSince :exec
+UPDATE devices
+SET agent_version_outdated_since = @agent_version_outdated_since
+WHERE id = @id;
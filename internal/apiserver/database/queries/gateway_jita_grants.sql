@@ -12,6 +12,7 @@ SELECT EXISTS(
         AND gateway_name = @gateway_name
         AND DATETIME(expires) > DATETIME('now')
         AND revoked IS NULL
+        AND approval IN ('not_required', 'approved')
 ) AS has_access;
 
 -- name: UsersWithAccessToPrivilegedGateway :many
@@ -19,7 +20,8 @@ SELECT user_id FROM gateway_jita_grants
 WHERE
     gateway_name = @gateway_name
     AND DATETIME(expires) > DATETIME('now')
-    AND revoked IS NULL;
+    AND revoked IS NULL
+    AND approval IN ('not_required', 'approved');
 
 -- name: GrantPrivilegedGatewayAccess :exec
 INSERT INTO gateway_jita_grants (
@@ -27,14 +29,16 @@ INSERT INTO gateway_jita_grants (
     gateway_name,
     created,
     expires,
-    reason
+    reason,
+    approval
 )
 VALUES (
     @user_id,
     @gateway_name,
     @created,
     @expires,
-    @reason
+    @reason,
+    @approval
 );
 
 -- name: RevokePrivilegedGatewayAccess :exec
@@ -44,3 +48,52 @@ WHERE
     user_id = @user_id
     AND gateway_name = @gateway_name
     AND revoked IS NULL;
+
+-- name: DeleteGatewayJitaGrants :exec
+DELETE FROM gateway_jita_grants WHERE gateway_name = @gateway_name;
+
+-- name: GetGatewayJitaGrants :many
+SELECT * FROM gateway_jita_grants
+WHERE
+    (CAST(@gateway_name AS TEXT) = '' OR gateway_name = @gateway_name)
+    AND (CAST(@user_id AS TEXT) = '' OR user_id = @user_id)
+    AND (CAST(@since AS TEXT) = '' OR DATETIME(created) >= DATETIME(@since))
+    AND (CAST(@until AS TEXT) = '' OR DATETIME(created) < DATETIME(@until))
+    AND (
+        CAST(@active_only AS BOOLEAN) = FALSE
+        OR (DATETIME(expires) > DATETIME('now') AND revoked IS NULL AND approval IN ('not_required', 'approved'))
+    )
+ORDER BY id DESC;
+
+-- name: GetGatewayJitaGrant :one
+SELECT * FROM gateway_jita_grants WHERE id = @id;
+
+-- name: RevokeGatewayJitaGrant :exec
+UPDATE gateway_jita_grants
+SET revoked = @revoked
+WHERE
+    id = @id
+    AND revoked IS NULL;
+
+-- name: GetPendingGatewayJitaGrants :many
+SELECT * FROM gateway_jita_grants
+WHERE
+    approval = 'pending'
+    AND DATETIME(expires) > DATETIME('now')
+    AND revoked IS NULL
+ORDER BY id;
+
+-- name: ReviewGatewayJitaGrant :execrows
+UPDATE gateway_jita_grants
+SET approval = @approval, approver = @approver, decided = @decided, expires = @expires
+WHERE
+    id = @id
+    AND approval = 'pending'
+    AND revoked IS NULL;
+
+-- name: CountGatewayJitaGrantsForUserSince :one
+SELECT COUNT(*) FROM gateway_jita_grants
+WHERE
+    user_id = @user_id
+    AND gateway_name = @gateway_name
+    AND DATETIME(created) >= DATETIME(@since);
@@ -12,20 +12,23 @@ SELECT * FROM gateways WHERE name = @name;
 
 -- name: UpdateGateway :exec
 UPDATE gateways
-SET public_key = @public_key, endpoint = @endpoint, ipv4 = @ipv4, ipv6 = @ipv6, requires_privileged_access = @requires_privileged_access, password_hash = @password_hash
+SET public_key = @public_key, endpoint = @endpoint, ipv4 = @ipv4, ipv6 = @ipv6, requires_privileged_access = @requires_privileged_access, requires_approval = @requires_approval, jita_max_duration_seconds = @jita_max_duration_seconds, jita_min_reason_length = @jita_min_reason_length, jita_reason_pattern = @jita_reason_pattern, jita_max_grants_per_day = @jita_max_grants_per_day, password_hash = @password_hash
 WHERE name = @name;
 
 -- name: UpdateGatewayDynamicFields :exec
 UPDATE gateways
-SET requires_privileged_access = @requires_privileged_access
+SET requires_privileged_access = @requires_privileged_access, requires_approval = @requires_approval, jita_max_duration_seconds = @jita_max_duration_seconds, jita_min_reason_length = @jita_min_reason_length, jita_reason_pattern = @jita_reason_pattern, jita_max_grants_per_day = @jita_max_grants_per_day
 WHERE name = @name;
 
 -- name: AddGateway :exec
-INSERT INTO gateways (name, endpoint, public_key, ipv4, ipv6, password_hash, requires_privileged_access)
-VALUES (@name, @endpoint, @public_key, @ipv4, @ipv6, @password_hash, @requires_privileged_access)
+INSERT INTO gateways (name, endpoint, public_key, ipv4, ipv6, password_hash, requires_privileged_access, requires_approval, jita_max_duration_seconds, jita_min_reason_length, jita_reason_pattern, jita_max_grants_per_day)
+VALUES (@name, @endpoint, @public_key, @ipv4, @ipv6, @password_hash, @requires_privileged_access, @requires_approval, @jita_max_duration_seconds, @jita_min_reason_length, @jita_reason_pattern, @jita_max_grants_per_day)
 ON CONFLICT (name) DO
     UPDATE SET endpoint = excluded.endpoint, public_key = excluded.public_key, password_hash = excluded.password_hash, ipv6 = excluded.ipv6;
 
+-- name: DeleteGateway :execrows
+DELETE FROM gateways WHERE name = @name;
+
 -- name: DeleteGatewayAccessGroupIDs :exec
 DELETE FROM gateway_access_group_ids WHERE gateway_name = @gateway_name;
 
@@ -0,0 +1,14 @@
+-- name: RenewLease :execrows
+UPDATE leases
+SET holder = @holder, expires = @expires
+WHERE
+    name = @name
+    AND (holder = @holder OR DATETIME(expires) < DATETIME(CAST(@now AS TEXT)));
+
+-- name: CreateLease :execrows
+INSERT INTO leases (name, holder, expires)
+VALUES (@name, @holder, @expires)
+ON CONFLICT (name) DO NOTHING;
+
+-- name: ReleaseLease :exec
+DELETE FROM leases WHERE name = @name AND holder = @holder;
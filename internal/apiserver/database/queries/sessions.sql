@@ -23,8 +23,20 @@ VALUES (@key, @expiry, @device_id, @object_id);
 INSERT INTO session_access_group_ids (session_key, group_id)
 VALUES (@session_key, @group_id);
 
+-- name: RemoveSessionAccessGroupIDs :exec
+DELETE FROM session_access_group_ids WHERE session_key = @session_key;
+
 -- name: GetSessionGroupIDs :many
 SELECT group_id FROM session_access_group_ids WHERE session_key = @session_key ORDER BY group_id;
 
 -- name: RemoveExpiredSessions :exec
 DELETE FROM sessions WHERE DATETIME(expiry) < DATETIME('now');
+
+-- name: RemoveSessionsForDevice :exec
+DELETE FROM sessions WHERE device_id = @device_id;
+
+-- name: RemoveSession :exec
+DELETE FROM sessions WHERE key = @key;
+
+-- name: RemoveSessionsForUser :exec
+DELETE FROM sessions WHERE object_id = @object_id;
@@ -0,0 +1,13 @@
+CREATE TABLE audit_events (
+    id INTEGER PRIMARY KEY,
+    created TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target TEXT NOT NULL,
+    reason TEXT NOT NULL
+);
+
+CREATE INDEX audit_events_created_idx ON audit_events (created);
+CREATE INDEX audit_events_actor_idx ON audit_events (actor);
+CREATE INDEX audit_events_action_idx ON audit_events (action);
+CREATE INDEX audit_events_target_idx ON audit_events (target);
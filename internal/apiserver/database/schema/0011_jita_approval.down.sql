@@ -0,0 +1,6 @@
+DROP INDEX gateway_jita_grants_approval_idx;
+ALTER TABLE gateway_jita_grants DROP COLUMN decided;
+ALTER TABLE gateway_jita_grants DROP COLUMN approver;
+ALTER TABLE gateway_jita_grants DROP COLUMN approval;
+
+ALTER TABLE gateways DROP COLUMN requires_approval;
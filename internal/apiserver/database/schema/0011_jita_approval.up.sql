@@ -0,0 +1,6 @@
+ALTER TABLE gateways ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT 0;
+
+ALTER TABLE gateway_jita_grants ADD COLUMN approval TEXT CHECK(approval IN ('not_required', 'pending', 'approved', 'denied')) NOT NULL DEFAULT 'not_required';
+ALTER TABLE gateway_jita_grants ADD COLUMN approver TEXT;
+ALTER TABLE gateway_jita_grants ADD COLUMN decided TEXT;
+CREATE INDEX gateway_jita_grants_approval_idx ON gateway_jita_grants (approval);
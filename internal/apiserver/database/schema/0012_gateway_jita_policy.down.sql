@@ -0,0 +1,4 @@
+ALTER TABLE gateways DROP COLUMN jita_max_grants_per_day;
+ALTER TABLE gateways DROP COLUMN jita_reason_pattern;
+ALTER TABLE gateways DROP COLUMN jita_min_reason_length;
+ALTER TABLE gateways DROP COLUMN jita_max_duration_seconds;
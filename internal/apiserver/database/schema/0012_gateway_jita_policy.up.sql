@@ -0,0 +1,4 @@
+ALTER TABLE gateways ADD COLUMN jita_max_duration_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE gateways ADD COLUMN jita_min_reason_length INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE gateways ADD COLUMN jita_reason_pattern TEXT NOT NULL DEFAULT '';
+ALTER TABLE gateways ADD COLUMN jita_max_grants_per_day INTEGER NOT NULL DEFAULT 0;
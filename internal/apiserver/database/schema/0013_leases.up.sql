@@ -0,0 +1,5 @@
+CREATE TABLE leases (
+    name TEXT NOT NULL PRIMARY KEY,
+    holder TEXT NOT NULL,
+    expires TEXT NOT NULL
+);
@@ -0,0 +1,2 @@
+ALTER TABLE devices DROP COLUMN agent_version_outdated_since;
+ALTER TABLE devices DROP COLUMN agent_version;
@@ -0,0 +1,2 @@
+ALTER TABLE devices ADD COLUMN agent_version TEXT NOT NULL DEFAULT '';
+ALTER TABLE devices ADD COLUMN agent_version_outdated_since TEXT;
@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/nais/device/internal/apiserver/database/schema"
+	"github.com/nais/device/internal/ioconvenience"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	snapshotFilePrefix = "naisdevice-"
+	snapshotFileSuffix = ".db"
+	snapshotTimeFormat = "20060102T150405.000000000Z"
+)
+
+var ErrSnapshotNotSupported = errors.New("database snapshots are only supported for SQLite, use pg_dump for PostgreSQL")
+
+func (db *database) Snapshot(ctx context.Context, path string) error {
+	if err := db.queries.Snapshot(ctx, path); err != nil {
+		return fmt.Errorf("snapshot database: %w", err)
+	}
+
+	return nil
+}
+
+// Snapshotter writes snapshots of the database to a directory, keeping only the most recent ones.
+type Snapshotter struct {
+	db        Database
+	dir       string
+	retention int
+	log       logrus.FieldLogger
+}
+
+// NewSnapshotter creates a snapshotter writing to dir, keeping the newest retention snapshots.
+// A retention of zero or less keeps all snapshots.
+func NewSnapshotter(db Database, dir string, retention int, log logrus.FieldLogger) *Snapshotter {
+	return &Snapshotter{
+		db:        db,
+		dir:       dir,
+		retention: retention,
+		log:       log,
+	}
+}
+
+// Snapshot writes a new snapshot, and removes the snapshots exceeding the retention.
+func (s *Snapshotter) Snapshot(ctx context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("create snapshot directory: %w", err)
+	}
+
+	path := filepath.Join(s.dir, snapshotFilePrefix+time.Now().UTC().Format(snapshotTimeFormat)+snapshotFileSuffix)
+
+	// write to a temporary file first, so that incomplete snapshots are never mistaken for good ones
+	tmpPath := path + ".tmp"
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := s.db.Snapshot(ctx, tmpPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	s.log.WithField("path", path).Info("wrote database snapshot")
+
+	return s.prune()
+}
+
+func (s *Snapshotter) prune() error {
+	if s.retention <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("list snapshots: %w", err)
+	}
+
+	var snapshots []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), snapshotFilePrefix) && strings.HasSuffix(entry.Name(), snapshotFileSuffix) {
+			snapshots = append(snapshots, entry.Name())
+		}
+	}
+
+	if len(snapshots) <= s.retention {
+		return nil
+	}
+
+	// the timestamp format sorts chronologically
+	sort.Strings(snapshots)
+
+	var errs error
+	for _, name := range snapshots[:len(snapshots)-s.retention] {
+		if err := os.Remove(filepath.Join(s.dir, name)); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		s.log.WithField("snapshot", name).Debug("removed old database snapshot")
+	}
+
+	return errs
+}
+
+// SnapshotSchemaVersion returns the schema migration version of a SQLite database file,
+// and whether the last migration failed.
+func SnapshotSchemaVersion(path string) (uint, bool, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, false, err
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return 0, false, err
+	}
+	defer db.Close()
+
+	var version uint
+	var dirty bool
+	if err := db.QueryRow("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty); err != nil {
+		return 0, false, fmt.Errorf("read schema version, is this an apiserver database? %w", err)
+	}
+
+	return version, dirty, nil
+}
+
+// RestoreSnapshot replaces the SQLite database at dbPath with the snapshot at snapshotPath.
+// The snapshot is checked and migrated to the latest schema on a copy before it is swapped in,
+// and the previous database is kept next to it with a timestamped .before-restore suffix. The path of the previous
+// database is returned, or an empty string if there was none.
+// The apiserver must be stopped while restoring.
+func RestoreSnapshot(snapshotPath, dbPath string, log logrus.FieldLogger) (string, error) {
+	version, dirty, err := SnapshotSchemaVersion(snapshotPath)
+	if err != nil {
+		return "", fmt.Errorf("snapshot: %w", err)
+	}
+
+	latest, err := latestSchemaVersion(log)
+	if err != nil {
+		return "", err
+	}
+
+	if dirty {
+		return "", fmt.Errorf("snapshot has a failed migration at schema version %d", version)
+	}
+
+	if version > latest {
+		return "", fmt.Errorf("snapshot schema version %d is newer than the latest known schema version %d, restore with a newer controlplane-cli", version, latest)
+	}
+
+	restorePath := dbPath + ".restore"
+	if err := copyFile(snapshotPath, restorePath); err != nil {
+		return "", fmt.Errorf("copy snapshot: %w", err)
+	}
+
+	if err := runMigrations(restorePath, log); err != nil {
+		_ = os.Remove(restorePath)
+		return "", fmt.Errorf("migrating snapshot from schema version %d: %w", version, err)
+	}
+
+	backupPath := dbPath + ".before-restore-" + time.Now().UTC().Format(snapshotTimeFormat)
+	replaced, err := swapDatabase(restorePath, dbPath, backupPath, os.Rename)
+	if err != nil {
+		_ = os.Remove(restorePath)
+		return "", err
+	}
+	if !replaced {
+		backupPath = ""
+	}
+
+	log.WithField("path", dbPath).WithField("snapshot_version", version).WithField("version", latest).Info("restored database snapshot")
+
+	return backupPath, nil
+}
+
+// swapDatabase moves the database at dbPath aside to backupPath, and moves restorePath in its place.
+// The write-ahead log belongs to the old database, and is moved along with it. If any step fails, whatever was moved
+// aside is moved back, so that dbPath is left as it was. It reports whether there was a database to move aside.
+func swapDatabase(restorePath, dbPath, backupPath string, rename func(oldpath, newpath string) error) (bool, error) {
+	var moved []string
+	rollback := func(err error) error {
+		for _, ext := range slices.Backward(moved) {
+			if rollbackErr := rename(backupPath+ext, dbPath+ext); rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("move %s back: %w", dbPath+ext, rollbackErr))
+			}
+		}
+		return err
+	}
+
+	for _, ext := range []string{"", "-wal", "-shm"} {
+		err := rename(dbPath+ext, backupPath+ext)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return false, rollback(fmt.Errorf("move existing database aside: %w", err))
+		}
+		moved = append(moved, ext)
+	}
+
+	if err := rename(restorePath, dbPath); err != nil {
+		return false, rollback(fmt.Errorf("swap in restored database: %w", err))
+	}
+
+	return slices.Contains(moved, ""), nil
+}
+
+func latestSchemaVersion(log logrus.FieldLogger) (uint, error) {
+	sourceDriver, err := iofs.New(schema.FS, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer ioconvenience.CloseWithLog(sourceDriver, log)
+
+	version, err := sourceDriver.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := sourceDriver.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		} else if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
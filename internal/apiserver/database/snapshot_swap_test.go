@@ -0,0 +1,59 @@
+package database
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapDatabaseRollsBack(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "apiserver.db")
+	restorePath := dbPath + ".restore"
+	backupPath := dbPath + ".before-restore"
+
+	for path, contents := range map[string]string{
+		dbPath:          "old database",
+		dbPath + "-wal": "old wal",
+		restorePath:     "restored database",
+	} {
+		assert.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+
+	// the old database and its write-ahead log are moved aside, but the restored database can not be moved in
+	failSwap := func(oldpath, newpath string) error {
+		if oldpath == restorePath {
+			return errors.New("disk on fire")
+		}
+		return os.Rename(oldpath, newpath)
+	}
+
+	replaced, err := swapDatabase(restorePath, dbPath, backupPath, failSwap)
+	assert.ErrorContains(t, err, "disk on fire")
+	assert.False(t, replaced)
+
+	for path, contents := range map[string]string{
+		dbPath:          "old database",
+		dbPath + "-wal": "old wal",
+	} {
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, contents, string(data))
+	}
+	assert.NoFileExists(t, backupPath)
+	assert.NoFileExists(t, backupPath+"-wal")
+
+	replaced, err = swapDatabase(restorePath, dbPath, backupPath, os.Rename)
+	assert.NoError(t, err)
+	assert.True(t, replaced)
+
+	data, err := os.ReadFile(dbPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "restored database", string(data))
+	assert.FileExists(t, backupPath)
+	assert.FileExists(t, backupPath+"-wal")
+	assert.NoFileExists(t, dbPath+"-wal")
+}
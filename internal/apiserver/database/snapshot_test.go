@@ -0,0 +1,92 @@
+package database_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/testdatabase"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotAndRestore(t *testing.T) {
+	db, _ := testdatabase.SetupSQLite(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	gateway := &pb.Gateway{Name: "gateway", Endpoint: "1.2.3.4:56789", PublicKey: "publicKey"}
+	assert.NoError(t, db.AddGateway(ctx, gateway))
+
+	dir := t.TempDir()
+	snapshotter := database.NewSnapshotter(db, dir, 2, log)
+	for range 3 {
+		assert.NoError(t, snapshotter.Snapshot(ctx))
+	}
+
+	snapshots, err := filepath.Glob(filepath.Join(dir, "*"))
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2, "only the newest snapshots are kept")
+
+	t.Run("restore", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "apiserver.db")
+		assert.NoError(t, os.WriteFile(dbPath, []byte("old database"), 0o600))
+
+		backupPath, err := database.RestoreSnapshot(snapshots[1], dbPath, log)
+		assert.NoError(t, err)
+
+		old, err := os.ReadFile(backupPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "old database", string(old))
+
+		// restoring again keeps the previous backup
+		secondBackupPath, err := database.RestoreSnapshot(snapshots[1], dbPath, log)
+		assert.NoError(t, err)
+		assert.NotEqual(t, backupPath, secondBackupPath)
+		assert.FileExists(t, backupPath)
+		assert.FileExists(t, secondBackupPath)
+
+		restored, err := database.New(dbPath, nil, nil, false, log)
+		assert.NoError(t, err)
+
+		gw, err := restored.ReadGateway(ctx, gateway.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, gateway.Endpoint, gw.Endpoint)
+	})
+
+	t.Run("refuses newer schema", func(t *testing.T) {
+		snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+		assert.NoError(t, db.Snapshot(ctx, snapshot))
+		setSchemaVersion(t, snapshot, 9999)
+
+		dbPath := filepath.Join(t.TempDir(), "apiserver.db")
+		_, err := database.RestoreSnapshot(snapshot, dbPath, log)
+		assert.ErrorContains(t, err, "snapshot schema version 9999 is newer")
+		assert.NoFileExists(t, dbPath)
+	})
+
+	t.Run("refuses other files", func(t *testing.T) {
+		snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+		assert.NoError(t, os.WriteFile(snapshot, []byte("not a database"), 0o600))
+
+		_, err := database.RestoreSnapshot(snapshot, filepath.Join(t.TempDir(), "apiserver.db"), log)
+		assert.ErrorContains(t, err, "is this an apiserver database?")
+	})
+}
+
+func setSchemaVersion(t *testing.T, path string, version int) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", path)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("UPDATE schema_migrations SET version = ?", version)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,102 @@
+package gatewayconfigurer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nais/device/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// GatewayDiff lists the changes applying a gateway config would make to a gateway.
+type GatewayDiff struct {
+	Gateway string
+	Changes []string
+}
+
+// Diff compares gateway configs with the gateways in the database, and returns the changes for every gateway that
+// would be modified. Configs for gateways not in the database are skipped, as they are ignored when applying.
+func Diff(gatewayConfigs map[string]GatewayConfig, gateways []*pb.Gateway) ([]GatewayDiff, error) {
+	wanted, err := toProtobuf(gatewayConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]*pb.Gateway, len(gateways))
+	for _, gw := range gateways {
+		current[gw.GetName()] = gw
+	}
+
+	var diffs []GatewayDiff
+	for _, want := range wanted {
+		have, ok := current[want.GetName()]
+		if !ok {
+			continue
+		}
+
+		var changes []string
+		changes = append(changes, diffList("route", have.GetRoutesIPv4(), want.GetRoutesIPv4())...)
+		changes = append(changes, diffList("IPv6 route", have.GetRoutesIPv6(), want.GetRoutesIPv6())...)
+		changes = append(changes, diffList("access group", have.GetAccessGroupIDs(), want.GetAccessGroupIDs())...)
+
+		if have.GetRequiresPrivilegedAccess() != want.GetRequiresPrivilegedAccess() {
+			changes = append(changes, fmt.Sprintf("requires_privileged_access: %t -> %t", have.GetRequiresPrivilegedAccess(), want.GetRequiresPrivilegedAccess()))
+		}
+
+		if have.GetRequiresApproval() != want.GetRequiresApproval() {
+			changes = append(changes, fmt.Sprintf("requires_approval: %t -> %t", have.GetRequiresApproval(), want.GetRequiresApproval()))
+		}
+
+		if !proto.Equal(have.GetJitaPolicy(), want.GetJitaPolicy()) {
+			changes = append(changes, fmt.Sprintf("jita_policy: %s -> %s", formatJitaPolicy(have.GetJitaPolicy()), formatJitaPolicy(want.GetJitaPolicy())))
+		}
+
+		if len(changes) > 0 {
+			diffs = append(diffs, GatewayDiff{
+				Gateway: want.GetName(),
+				Changes: changes,
+			})
+		}
+	}
+
+	return diffs, nil
+}
+
+// diffList returns a line for every element added to or removed from a list, ignoring order.
+func diffList(kind string, have, want []string) []string {
+	var changes []string
+	for _, w := range want {
+		if !slices.Contains(have, w) {
+			changes = append(changes, fmt.Sprintf("+ %s %s", kind, w))
+		}
+	}
+	for _, h := range have {
+		if !slices.Contains(want, h) {
+			changes = append(changes, fmt.Sprintf("- %s %s", kind, h))
+		}
+	}
+	return changes
+}
+
+func formatJitaPolicy(policy *pb.JitaPolicy) string {
+	if policy == nil {
+		return "none"
+	}
+
+	var fields []string
+	if policy.GetMaxDuration() != nil {
+		fields = append(fields, "max_duration="+policy.GetMaxDuration().AsDuration().String())
+	}
+	if policy.GetMinReasonLength() > 0 {
+		fields = append(fields, fmt.Sprintf("min_reason_length=%d", policy.GetMinReasonLength()))
+	}
+	if policy.GetReasonPattern() != "" {
+		fields = append(fields, fmt.Sprintf("reason_pattern=%q", policy.GetReasonPattern()))
+	}
+	if policy.GetMaxGrantsPerDay() > 0 {
+		fields = append(fields, fmt.Sprintf("max_grants_per_day=%d", policy.GetMaxGrantsPerDay()))
+	}
+
+	return "{" + strings.Join(fields, " ") + "}"
+}
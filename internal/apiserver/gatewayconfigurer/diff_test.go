@@ -0,0 +1,64 @@
+package gatewayconfigurer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
+	"github.com/nais/device/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDiff(t *testing.T) {
+	gateways := []*pb.Gateway{
+		{
+			Name:           "changed",
+			RoutesIPv4:     []string{"10.0.0.0/24", "10.0.1.0/24"},
+			AccessGroupIDs: []string{"old"},
+		},
+		{
+			Name:                     "unchanged",
+			RoutesIPv4:               []string{"10.0.2.0/24"},
+			AccessGroupIDs:           []string{"group"},
+			RequiresPrivilegedAccess: true,
+			JitaPolicy:               &pb.JitaPolicy{MaxDuration: durationpb.New(4 * time.Hour)},
+		},
+	}
+
+	configs := map[string]gatewayconfigurer.GatewayConfig{
+		"changed": {
+			Routes:           []gatewayconfigurer.Route{{CIDR: "10.0.1.0/24"}, {CIDR: "10.0.3.0/24"}},
+			RoutesIPv6:       []gatewayconfigurer.Route{{CIDR: "fd00::/64"}},
+			AccessGroupIds:   []string{"new"},
+			RequiresApproval: true,
+			JitaPolicy:       &gatewayconfigurer.JitaPolicy{MaxDuration: "2h", MaxGrantsPerDay: 1},
+		},
+		"unchanged": {
+			Routes:                   []gatewayconfigurer.Route{{CIDR: "10.0.2.0/24"}},
+			AccessGroupIds:           []string{"group"},
+			RequiresPrivilegedAccess: true,
+			JitaPolicy:               &gatewayconfigurer.JitaPolicy{MaxDuration: "4h"},
+		},
+		"unknown": {
+			Routes: []gatewayconfigurer.Route{{CIDR: "10.0.4.0/24"}},
+		},
+	}
+
+	diffs, err := gatewayconfigurer.Diff(configs, gateways)
+	assert.NoError(t, err)
+	assert.Equal(t, []gatewayconfigurer.GatewayDiff{
+		{
+			Gateway: "changed",
+			Changes: []string{
+				"+ route 10.0.3.0/24",
+				"- route 10.0.0.0/24",
+				"+ IPv6 route fd00::/64",
+				"+ access group new",
+				"- access group old",
+				"requires_approval: false -> true",
+				"jita_policy: none -> {max_duration=2h0m0s max_grants_per_day=1}",
+			},
+		},
+	}, diffs)
+}
@@ -0,0 +1,160 @@
+package gatewayconfigurer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// fileChangeSettleTime is how long to wait after a change event before reading the file,
+// as editors and config map updates usually produce several events in quick succession.
+const fileChangeSettleTime = 250 * time.Millisecond
+
+// File configures gateways from a local file, in the same format as the bucket configurer.
+// Files ending in .yaml or .yml are parsed as YAML, anything else as JSON.
+type File struct {
+	db                database.Database
+	path              string
+	wireguardPrefixes []netip.Prefix
+	trigger           func()
+	lastApplied       []byte
+	log               logrus.FieldLogger
+}
+
+// NewFile creates a configurer that reads gateway configs from path.
+// wireguardPrefixes are checked as for NewGatewayConfigurer.
+// trigger is called after a changed file has been applied, so that gateways receive the new configuration right away.
+func NewFile(log logrus.FieldLogger, db database.Database, path string, wireguardPrefixes []netip.Prefix, trigger func()) *File {
+	return &File{
+		db:                db,
+		path:              path,
+		wireguardPrefixes: wireguardPrefixes,
+		trigger:           trigger,
+		log:               log,
+	}
+}
+
+// ReadFile reads gateway configs from a JSON or YAML file, without validating them.
+func ReadFile(path string) (map[string]GatewayConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read gateway config file: %w", err)
+	}
+
+	return parse(path, data)
+}
+
+// SyncConfig reads, validates and applies the config file. If the file cannot be read or is invalid,
+// nothing is applied and the last good configuration stays in effect.
+func (f *File) SyncConfig(ctx context.Context) error {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("read gateway config file: %w", err)
+	}
+
+	// only update configuration if the file contents have changed
+	if f.lastApplied != nil && bytes.Equal(f.lastApplied, data) {
+		return nil
+	}
+
+	f.log.Info("syncing gateway configuration from file")
+
+	gatewayConfigs, err := parse(f.path, data)
+	if err != nil {
+		return err
+	}
+
+	if err := validate(ctx, f.db, f.log, gatewayConfigs, f.wireguardPrefixes); err != nil {
+		return err
+	}
+
+	gateways, err := toProtobuf(gatewayConfigs)
+	if err != nil {
+		return err
+	}
+
+	if err := updateGateways(ctx, f.db, gateways); err != nil {
+		return err
+	}
+
+	f.lastApplied = data
+	f.trigger()
+
+	return nil
+}
+
+// Run applies the config file, and then re-applies it every time it changes, until ctx is done.
+// Errors from applying the file are logged, and only errors setting up the file watcher are returned.
+func (f *File) Run(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// watch the directory rather than the file, so that files replaced by renaming
+	// or swapped symlinks (as with Kubernetes config maps) are picked up as well
+	if err := watcher.Add(filepath.Dir(f.path)); err != nil {
+		return fmt.Errorf("watch %s: %w", filepath.Dir(f.path), err)
+	}
+
+	f.sync(ctx)
+
+	settle := time.NewTimer(fileChangeSettleTime)
+	settle.Stop()
+	defer settle.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("file watcher closed")
+			}
+			f.log.WithField("event", event.String()).Debug("gateway config directory changed")
+			settle.Reset(fileChangeSettleTime)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("file watcher closed")
+			}
+			f.log.WithError(err).Error("watch gateway config file")
+		case <-settle.C:
+			f.sync(ctx)
+		}
+	}
+}
+
+func (f *File) sync(ctx context.Context) {
+	if err := f.SyncConfig(ctx); err != nil {
+		f.log.WithError(err).Error("sync gateway config file, keeping last good configuration")
+	}
+}
+
+func parse(path string, data []byte) (map[string]GatewayConfig, error) {
+	var gatewayConfigs map[string]GatewayConfig
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &gatewayConfigs); err != nil {
+			return nil, fmt.Errorf("unmarshaling gateway config yaml: %w", err)
+		}
+	default:
+		if err := json.Unmarshal(data, &gatewayConfigs); err != nil {
+			return nil, fmt.Errorf("unmarshaling gateway config json: %w", err)
+		}
+	}
+
+	return gatewayConfigs, nil
+}
@@ -0,0 +1,182 @@
+package gatewayconfigurer_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFile_SyncConfig(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+
+	expected := &pb.Gateway{
+		Name:                     "gw",
+		RoutesIPv4:               []string{"10.0.0.0/24"},
+		RoutesIPv6:               []string{"fd00::/64"},
+		AccessGroupIDs:           []string{"group"},
+		RequiresPrivilegedAccess: true,
+	}
+
+	t.Run("reads json", func(t *testing.T) {
+		path := writeFile(t, "gatewayconfig.json", `{
+			"gw": {
+				"routes": [{"cidr": "10.0.0.0/24"}],
+				"routes_ipv6": [{"cidr": "fd00::/64"}],
+				"access_group_ids": ["group"],
+				"requires_privileged_access": true
+			}
+		}`)
+
+		db := database.NewMockDatabase(t)
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
+		db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, []*pb.Gateway{expected}).Return(nil).Once()
+
+		triggered := 0
+		gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() { triggered++ })
+		assert.NoError(t, gc.SyncConfig(ctx))
+		assert.Equal(t, 1, triggered)
+
+		// unchanged file is not applied again
+		assert.NoError(t, gc.SyncConfig(ctx))
+		assert.Equal(t, 1, triggered)
+	})
+
+	t.Run("reads yaml", func(t *testing.T) {
+		path := writeFile(t, "gatewayconfig.yaml", `
+gw:
+  routes:
+    - cidr: 10.0.0.0/24
+  routes_ipv6:
+    - cidr: fd00::/64
+  access_group_ids:
+    - group
+  requires_privileged_access: true
+`)
+
+		db := database.NewMockDatabase(t)
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
+		db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, []*pb.Gateway{expected}).Return(nil).Once()
+
+		gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() {})
+		assert.NoError(t, gc.SyncConfig(ctx))
+	})
+
+	t.Run("keeps last good config when file is broken", func(t *testing.T) {
+		path := writeFile(t, "gatewayconfig.json", `{"gw": {"routes": [{"cidr": "10.0.0.0/24"}]}}`)
+
+		db := database.NewMockDatabase(t)
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
+		db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, mock.Anything).Return(nil).Once()
+
+		gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() {})
+		assert.NoError(t, gc.SyncConfig(ctx))
+
+		assert.NoError(t, os.WriteFile(path, []byte(`{"gw": `), 0o600))
+		assert.ErrorContains(t, gc.SyncConfig(ctx), "unmarshaling gateway config json")
+	})
+
+	t.Run("applies nothing when the database update fails", func(t *testing.T) {
+		path := writeFile(t, "gatewayconfig.json", `{"gw": {"routes": [{"cidr": "10.0.0.0/24"}]}}`)
+
+		db := database.NewMockDatabase(t)
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Twice()
+		db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, mock.Anything).Return(errors.New("database is locked")).Once()
+		db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, mock.Anything).Return(nil).Once()
+
+		triggered := 0
+		gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() { triggered++ })
+		assert.ErrorContains(t, gc.SyncConfig(ctx), "database is locked")
+		assert.Equal(t, 0, triggered)
+
+		// the same file is applied again on the next sync
+		assert.NoError(t, gc.SyncConfig(ctx))
+		assert.Equal(t, 1, triggered)
+	})
+
+	t.Run("validates all gateways before applying any", func(t *testing.T) {
+		path := writeFile(t, "gatewayconfig.json", `{
+			"a": {"routes": [{"cidr": "10.0.0.0/24"}]},
+			"b": {"routes": [{"cidr": "not a cidr"}]}
+		}`)
+
+		db := database.NewMockDatabase(t)
+		db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{{Name: "a"}, {Name: "b"}}, nil).Once()
+
+		gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() {})
+		assert.ErrorContains(t, gc.SyncConfig(ctx), `gateway b: invalid IPv4 route "not a cidr"`)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		db := database.NewMockDatabase(t)
+
+		gc := gatewayconfigurer.NewFile(log, db, filepath.Join(t.TempDir(), "missing.json"), wireguardPrefixes, func() {})
+		assert.ErrorContains(t, gc.SyncConfig(ctx), "read gateway config file")
+	})
+}
+
+func TestFile_Run(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	path := writeFile(t, "gatewayconfig.json", `{"gw": {"access_group_ids": ["before"]}}`)
+
+	applied := make(chan *pb.Gateway, 1)
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Twice()
+	db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, gateways []*pb.Gateway) error {
+		applied <- gateways[0]
+		return nil
+	}).Twice()
+
+	triggered := make(chan struct{}, 2)
+	gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() { triggered <- struct{}{} })
+	done := make(chan error)
+	go func() {
+		done <- gc.Run(ctx)
+	}()
+
+	select {
+	case gw := <-applied:
+		assert.Equal(t, []string{"before"}, gw.GetAccessGroupIDs())
+	case <-ctx.Done():
+		t.Fatal("initial config not applied")
+	}
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"gw": {"access_group_ids": ["after"]}}`), 0o600))
+
+	select {
+	case gw := <-applied:
+		assert.Equal(t, []string{"after"}, gw.GetAccessGroupIDs())
+	case <-ctx.Done():
+		t.Fatal("changed config not applied")
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Len(t, triggered, 2)
+}
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"net/netip"
+	"regexp"
+	"slices"
 	"time"
 
 	"github.com/nais/device/internal/apiserver/bucket"
@@ -11,32 +15,75 @@ import (
 	"github.com/nais/device/internal/ioconvenience"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type GatewayConfigurer struct {
-	db          database.Database
-	bucket      bucket.Client
-	lastUpdated time.Time
-	log         *logrus.Entry
+	db                database.Database
+	bucket            bucket.Client
+	wireguardPrefixes []netip.Prefix
+	lastUpdated       time.Time
+	log               *logrus.Entry
 }
 
-func NewGatewayConfigurer(log *logrus.Entry, db database.Database, bucket bucket.Client) *GatewayConfigurer {
+// NewGatewayConfigurer creates a configurer that reads gateway configs from a bucket.
+// Configs with routes overlapping any of wireguardPrefixes are rejected.
+func NewGatewayConfigurer(log *logrus.Entry, db database.Database, bucket bucket.Client, wireguardPrefixes []netip.Prefix) *GatewayConfigurer {
 	return &GatewayConfigurer{
-		db:     db,
-		bucket: bucket,
-		log:    log,
+		db:                db,
+		bucket:            bucket,
+		wireguardPrefixes: wireguardPrefixes,
+		log:               log,
 	}
 }
 
 type Route struct {
-	CIDR string `json:"cidr"`
+	CIDR string `json:"cidr" yaml:"cidr"`
 }
 
 type GatewayConfig struct {
-	Routes                   []Route  `json:"routes"`
-	RoutesIPv6               []Route  `json:"routes_ipv6"`
-	AccessGroupIds           []string `json:"access_group_ids"`
-	RequiresPrivilegedAccess bool     `json:"requires_privileged_access"`
+	Routes                   []Route     `json:"routes" yaml:"routes"`
+	RoutesIPv6               []Route     `json:"routes_ipv6" yaml:"routes_ipv6"`
+	AccessGroupIds           []string    `json:"access_group_ids" yaml:"access_group_ids"`
+	RequiresPrivilegedAccess bool        `json:"requires_privileged_access" yaml:"requires_privileged_access"`
+	RequiresApproval         bool        `json:"requires_approval" yaml:"requires_approval"`
+	JitaPolicy               *JitaPolicy `json:"jita_policy" yaml:"jita_policy"`
+}
+
+type JitaPolicy struct {
+	// Go duration string, e.g. "4h"
+	MaxDuration     string `json:"max_duration" yaml:"max_duration"`
+	MinReasonLength int32  `json:"min_reason_length" yaml:"min_reason_length"`
+	ReasonPattern   string `json:"reason_pattern" yaml:"reason_pattern"`
+	MaxGrantsPerDay int32  `json:"max_grants_per_day" yaml:"max_grants_per_day"`
+}
+
+func (p *JitaPolicy) toProtobuf() (*pb.JitaPolicy, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	policy := &pb.JitaPolicy{
+		MinReasonLength: p.MinReasonLength,
+		ReasonPattern:   p.ReasonPattern,
+		MaxGrantsPerDay: p.MaxGrantsPerDay,
+	}
+
+	if p.MaxDuration != "" {
+		maxDuration, err := time.ParseDuration(p.MaxDuration)
+		if err != nil {
+			return nil, fmt.Errorf("parse max_duration: %w", err)
+		}
+		policy.MaxDuration = durationpb.New(maxDuration)
+	}
+
+	if p.ReasonPattern != "" {
+		if _, err := regexp.Compile(p.ReasonPattern); err != nil {
+			return nil, fmt.Errorf("parse reason_pattern: %w", err)
+		}
+	}
+
+	return policy, nil
 }
 
 func (g *GatewayConfigurer) SyncConfig(ctx context.Context) error {
@@ -58,22 +105,55 @@ func (g *GatewayConfigurer) SyncConfig(ctx context.Context) error {
 		return fmt.Errorf("unmarshaling gateway config json: %v", err)
 	}
 
-	for gatewayName, gatewayConfig := range gatewayConfigs {
-		gw := &pb.Gateway{
+	if err := validate(ctx, g.db, g.log, gatewayConfigs, g.wireguardPrefixes); err != nil {
+		return err
+	}
+
+	gateways, err := toProtobuf(gatewayConfigs)
+	if err != nil {
+		return err
+	}
+
+	if err := updateGateways(ctx, g.db, gateways); err != nil {
+		return err
+	}
+
+	g.lastUpdated = lastUpdated
+
+	return nil
+}
+
+// toProtobuf converts all gateway configs, failing if any of them are invalid.
+// The returned gateways are sorted by name.
+func toProtobuf(gatewayConfigs map[string]GatewayConfig) ([]*pb.Gateway, error) {
+	gateways := make([]*pb.Gateway, 0, len(gatewayConfigs))
+	for _, gatewayName := range slices.Sorted(maps.Keys(gatewayConfigs)) {
+		gatewayConfig := gatewayConfigs[gatewayName]
+
+		jitaPolicy, err := gatewayConfig.JitaPolicy.toProtobuf()
+		if err != nil {
+			return nil, fmt.Errorf("gateway %s: jita policy: %w", gatewayName, err)
+		}
+
+		gateways = append(gateways, &pb.Gateway{
 			Name:                     gatewayName,
 			AccessGroupIDs:           gatewayConfig.AccessGroupIds,
 			RequiresPrivilegedAccess: gatewayConfig.RequiresPrivilegedAccess,
+			RequiresApproval:         gatewayConfig.RequiresApproval,
+			JitaPolicy:               jitaPolicy,
 			RoutesIPv4:               ToCIDRStringSlice(gatewayConfig.Routes),
 			RoutesIPv6:               ToCIDRStringSlice(gatewayConfig.RoutesIPv6),
-		}
-
-		err = g.db.UpdateGatewayDynamicFields(ctx, gw)
-		if err != nil {
-			return fmt.Errorf("updating gateway: %s with routes: %s and accessGroupIds: %s: %w", gatewayName, gatewayConfig.Routes, gatewayConfig.AccessGroupIds, err)
-		}
+		})
 	}
 
-	g.lastUpdated = lastUpdated
+	return gateways, nil
+}
+
+// updateGateways applies all gateway configs in a single transaction, so that a failure leaves the previous configuration in place.
+func updateGateways(ctx context.Context, db database.Database, gateways []*pb.Gateway) error {
+	if err := db.UpdateGatewaysDynamicFields(ctx, gateways); err != nil {
+		return fmt.Errorf("updating gateways: %w", err)
+	}
 
 	return nil
 }
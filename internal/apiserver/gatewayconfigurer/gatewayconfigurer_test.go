@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
 	"strings"
 	"testing"
 	"time"
@@ -14,16 +15,21 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
 )
 
 const (
-	gatewayName, route, accessGroupId = "name", "r", "agid"
+	gatewayName, route, accessGroupId = "name", "10.0.0.0/24", "agid"
 	requiresPrivilegedAccess          = true
 )
 
-var errExpected = errors.New("expected error")
+var (
+	errExpected       = errors.New("expected error")
+	wireguardPrefixes = []netip.Prefix{netip.MustParsePrefix("10.255.240.0/21")}
+	knownGateways     = []*pb.Gateway{{Name: gatewayName}, {Name: "gw"}}
+)
 
 func TestGatewayConfigurer_SyncConfig(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -37,18 +43,19 @@ func TestGatewayConfigurer_SyncConfig(t *testing.T) {
 		lastUpdated := time.Now()
 		reader := strings.NewReader(gatewayConfig(gatewayName, route, accessGroupId, requiresPrivilegedAccess))
 
-		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient)
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
 
-		db.On("UpdateGatewayDynamicFields",
+		db.On("UpdateGatewaysDynamicFields",
 			mock.Anything,
-			&pb.Gateway{
+			[]*pb.Gateway{{
 				Name:                     gatewayName,
 				RoutesIPv4:               []string{route},
 				AccessGroupIDs:           []string{accessGroupId},
 				RequiresPrivilegedAccess: requiresPrivilegedAccess,
-			},
+			}},
 		).Return(nil).Once()
 
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
 		mockClient.On("Open", mock.Anything).Return(mockObject, nil).Twice()
 		mockObject.On("LastUpdated").Return(lastUpdated).Twice()
 		mockObject.On("Close").Return(nil).Twice()
@@ -62,11 +69,66 @@ func TestGatewayConfigurer_SyncConfig(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("reads jita policy", func(t *testing.T) {
+		db := database.NewMockDatabase(t)
+		mockClient := bucket.NewMockClient(t)
+		mockObject := bucket.NewMockObject(t)
+		reader := strings.NewReader(`{
+			"gw": {
+				"requires_privileged_access": true,
+				"requires_approval": true,
+				"jita_policy": {"max_duration": "4h", "min_reason_length": 10, "reason_pattern": "^INC-[0-9]+", "max_grants_per_day": 2}
+			}
+		}`)
+
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
+
+		db.On("UpdateGatewaysDynamicFields",
+			mock.Anything,
+			[]*pb.Gateway{{
+				Name:                     "gw",
+				RequiresPrivilegedAccess: true,
+				RequiresApproval:         true,
+				JitaPolicy: &pb.JitaPolicy{
+					MaxDuration:     durationpb.New(4 * time.Hour),
+					MinReasonLength: 10,
+					ReasonPattern:   "^INC-[0-9]+",
+					MaxGrantsPerDay: 2,
+				},
+			}},
+		).Return(nil).Once()
+
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
+		mockClient.On("Open", mock.Anything).Return(mockObject, nil).Once()
+		mockObject.On("LastUpdated").Return(time.Now()).Once()
+		mockObject.On("Close").Return(nil).Once()
+		mockObject.On("Reader").Return(reader).Once()
+
+		assert.NoError(t, gc.SyncConfig(ctx))
+	})
+
+	t.Run("rejects invalid jita policy", func(t *testing.T) {
+		db := database.NewMockDatabase(t)
+		mockClient := bucket.NewMockClient(t)
+		mockObject := bucket.NewMockObject(t)
+		reader := strings.NewReader(`{"gw": {"jita_policy": {"max_duration": "four hours"}}}`)
+
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
+
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
+		mockClient.On("Open", mock.Anything).Return(mockObject, nil).Once()
+		mockObject.On("LastUpdated").Return(time.Now()).Once()
+		mockObject.On("Close").Return(nil).Once()
+		mockObject.On("Reader").Return(reader).Once()
+
+		assert.ErrorContains(t, gc.SyncConfig(ctx), "gateway gw: jita policy: parse max_duration")
+	})
+
 	t.Run("handles errors from bucket interface", func(t *testing.T) {
 		db := database.NewMockDatabase(t)
 		mockClient := bucket.NewMockClient(t)
 
-		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient)
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
 
 		mockClient.On("Open", mock.Anything).Return(nil, errExpected).Once()
 
@@ -82,7 +144,7 @@ func TestGatewayConfigurer_SyncConfig(t *testing.T) {
 		lastUpdated := time.Now()
 		reader := strings.NewReader(`this is not valid json`)
 
-		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient)
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
 
 		mockClient.On("Open", mock.Anything).Return(mockObject, nil).Once()
 		mockObject.On("LastUpdated").Return(lastUpdated).Once()
@@ -101,12 +163,13 @@ func TestGatewayConfigurer_SyncConfig(t *testing.T) {
 		lastUpdated := time.Now()
 		reader := strings.NewReader(gatewayConfig(gatewayName, route, accessGroupId, requiresPrivilegedAccess))
 
-		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient)
+		gc := gatewayconfigurer.NewGatewayConfigurer(log, db, mockClient, wireguardPrefixes)
 
-		db.On("UpdateGatewayDynamicFields",
+		db.On("UpdateGatewaysDynamicFields",
 			mock.Anything,
 			mock.Anything,
 		).Return(errExpected).Once()
+		db.EXPECT().ReadGateways(mock.Anything).Return(knownGateways, nil).Once()
 		mockClient.On("Open", mock.Anything).Return(mockObject, nil).Once()
 		mockObject.On("LastUpdated").Return(lastUpdated).Once()
 		mockObject.On("Close").Return(nil).Once()
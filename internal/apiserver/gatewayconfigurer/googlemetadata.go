@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/netip"
 
 	"github.com/nais/device/internal/apiserver/database"
 	"github.com/nais/device/internal/ioconvenience"
@@ -12,20 +13,25 @@ import (
 )
 
 type GoogleMetadata struct {
-	db  database.Database
-	log logrus.FieldLogger
+	db                database.Database
+	wireguardPrefixes []netip.Prefix
+	log               logrus.FieldLogger
 }
 
 type GatewayMetadata struct {
 	Routes                   []string `json:"routes"`
 	AccessGroupIDs           []string `json:"access_group_ids"`
 	RequiresPrivilegedAccess bool     `json:"requires_privileged_access"`
+	RequiresApproval         bool     `json:"requires_approval"`
 }
 
-func NewGoogleMetadata(db database.Database, log logrus.FieldLogger) *GoogleMetadata {
+// NewGoogleMetadata creates a configurer that reads gateway configs from the instance metadata.
+// wireguardPrefixes are checked as for NewGatewayConfigurer.
+func NewGoogleMetadata(db database.Database, log logrus.FieldLogger, wireguardPrefixes []netip.Prefix) *GoogleMetadata {
 	return &GoogleMetadata{
-		db:  db,
-		log: log,
+		db:                db,
+		wireguardPrefixes: wireguardPrefixes,
+		log:               log,
 	}
 }
 
@@ -34,6 +40,16 @@ func (g *GoogleMetadata) SyncConfig(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	gatewayConfigs := make(map[string]GatewayConfig, len(gatewayRoutes))
+	for name, gatewayMetadata := range gatewayRoutes {
+		gatewayConfigs[name] = gatewayMetadata.toGatewayConfig()
+	}
+
+	if err := validate(ctx, g.db, g.log, gatewayConfigs, g.wireguardPrefixes); err != nil {
+		return err
+	}
+
 	for name, gatewayMetadata := range gatewayRoutes {
 		gateway, err := g.db.ReadGateway(ctx, name)
 		if err != nil {
@@ -44,6 +60,7 @@ func (g *GoogleMetadata) SyncConfig(ctx context.Context) error {
 		gateway.RoutesIPv4 = gatewayMetadata.Routes
 		gateway.AccessGroupIDs = gatewayMetadata.AccessGroupIDs
 		gateway.RequiresPrivilegedAccess = gatewayMetadata.RequiresPrivilegedAccess
+		gateway.RequiresApproval = gatewayMetadata.RequiresApproval
 
 		err = g.db.UpdateGateway(ctx, gateway)
 		if err != nil {
@@ -53,6 +70,20 @@ func (g *GoogleMetadata) SyncConfig(ctx context.Context) error {
 	return nil
 }
 
+func (m *GatewayMetadata) toGatewayConfig() GatewayConfig {
+	routes := make([]Route, len(m.Routes))
+	for i, cidr := range m.Routes {
+		routes[i] = Route{CIDR: cidr}
+	}
+
+	return GatewayConfig{
+		Routes:                   routes,
+		AccessGroupIds:           m.AccessGroupIDs,
+		RequiresPrivilegedAccess: m.RequiresPrivilegedAccess,
+		RequiresApproval:         m.RequiresApproval,
+	}
+}
+
 func getGatewayMetadatas(ctx context.Context, log logrus.FieldLogger) (map[string]*GatewayMetadata, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://metadata.google.internal/computeMetadata/v1/instance/attributes/gateway-routes", nil)
 	if err != nil {
@@ -0,0 +1,185 @@
+package gatewayconfigurer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+type Severity int
+
+const (
+	// SeverityWarning problems are reported, but do not prevent the config from being applied.
+	SeverityWarning Severity = iota
+	// SeverityError problems prevent the config from being applied.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Problem is a single finding from validating gateway configs.
+type Problem struct {
+	Severity Severity
+	Gateway  string
+	Message  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: gateway %s: %s", p.Severity, p.Gateway, p.Message)
+}
+
+type Problems []Problem
+
+// Err returns all problems of SeverityError joined together, or nil if there are none.
+func (p Problems) Err() error {
+	var errs []error
+	for _, problem := range p {
+		if problem.Severity == SeverityError {
+			errs = append(errs, errors.New(problem.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Warnings returns all problems of SeverityWarning.
+func (p Problems) Warnings() Problems {
+	var warnings Problems
+	for _, problem := range p {
+		if problem.Severity == SeverityWarning {
+			warnings = append(warnings, problem)
+		}
+	}
+	return warnings
+}
+
+// Validate checks gateway configs for mistakes before they are applied.
+//
+// Errors are invalid CIDRs, routes overlapping the WireGuard network and invalid JITA policies.
+// Warnings are routes that are not network addresses, overlapping routes within or across gateways,
+// gateways not known to the apiserver and gateways without access groups.
+func Validate(gatewayConfigs map[string]GatewayConfig, gateways []*pb.Gateway, wireguardPrefixes []netip.Prefix) Problems {
+	var problems Problems
+	report := func(severity Severity, gateway, format string, args ...any) {
+		problems = append(problems, Problem{
+			Severity: severity,
+			Gateway:  gateway,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	known := make(map[string]struct{}, len(gateways))
+	for _, gw := range gateways {
+		known[gw.GetName()] = struct{}{}
+	}
+
+	type route struct {
+		gateway string
+		prefix  netip.Prefix
+	}
+	var allRoutes []route
+
+	for _, name := range slices.Sorted(maps.Keys(gatewayConfigs)) {
+		gatewayConfig := gatewayConfigs[name]
+
+		if _, ok := known[name]; !ok {
+			report(SeverityWarning, name, "not enrolled, config will be ignored")
+		}
+
+		if len(gatewayConfig.AccessGroupIds) == 0 {
+			report(SeverityWarning, name, "no access groups, no devices will be able to connect")
+		}
+
+		if _, err := gatewayConfig.JitaPolicy.toProtobuf(); err != nil {
+			report(SeverityError, name, "jita policy: %v", err)
+		}
+
+		var routes []netip.Prefix
+		families := []struct {
+			name   string
+			routes []Route
+		}{
+			{name: "IPv4", routes: gatewayConfig.Routes},
+			{name: "IPv6", routes: gatewayConfig.RoutesIPv6},
+		}
+		for _, family := range families {
+			for _, r := range family.routes {
+				prefix, err := netip.ParsePrefix(r.CIDR)
+				if err != nil {
+					report(SeverityError, name, "invalid %s route %q, expected CIDR notation", family.name, r.CIDR)
+					continue
+				}
+				if (family.name == "IPv4") != prefix.Addr().Is4() {
+					report(SeverityError, name, "route %s is not an %s route", r.CIDR, family.name)
+					continue
+				}
+				if prefix != prefix.Masked() {
+					report(SeverityWarning, name, "route %s is not a network address, did you mean %s?", r.CIDR, prefix.Masked())
+				}
+				routes = append(routes, prefix)
+			}
+		}
+		slices.SortFunc(routes, netip.Prefix.Compare)
+
+		for i, prefix := range routes {
+			for _, wireguardPrefix := range wireguardPrefixes {
+				if prefix.Overlaps(wireguardPrefix) {
+					report(SeverityError, name, "route %s overlaps the WireGuard network %s", prefix, wireguardPrefix)
+				}
+			}
+
+			for _, other := range routes[i+1:] {
+				if prefix.Overlaps(other) {
+					report(SeverityWarning, name, "routes %s and %s overlap", prefix, other)
+				}
+			}
+
+			for _, other := range allRoutes {
+				if prefix.Overlaps(other.prefix) {
+					report(SeverityWarning, name, "route %s overlaps route %s of gateway %s", prefix, other.prefix, other.gateway)
+				}
+			}
+		}
+
+		for _, prefix := range routes {
+			allRoutes = append(allRoutes, route{gateway: name, prefix: prefix})
+		}
+	}
+
+	return problems
+}
+
+// validate checks gateway configs against the gateways in the database. Warnings are logged, and an error is returned
+// if the config must not be applied. Configs for gateways not in the database are removed from gatewayConfigs.
+func validate(ctx context.Context, db database.Database, log logrus.FieldLogger, gatewayConfigs map[string]GatewayConfig, wireguardPrefixes []netip.Prefix) error {
+	gateways, err := db.ReadGateways(ctx)
+	if err != nil {
+		return fmt.Errorf("read gateways: %w", err)
+	}
+
+	problems := Validate(gatewayConfigs, gateways, wireguardPrefixes)
+	for _, warning := range problems.Warnings() {
+		log.WithField("gateway", warning.Gateway).Warn(warning.Message)
+	}
+
+	if err := problems.Err(); err != nil {
+		return fmt.Errorf("invalid gateway config: %w", err)
+	}
+
+	maps.DeleteFunc(gatewayConfigs, func(name string, _ GatewayConfig) bool {
+		return !slices.ContainsFunc(gateways, func(gw *pb.Gateway) bool { return gw.GetName() == name })
+	})
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package gatewayconfigurer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidate(t *testing.T) {
+	gateways := []*pb.Gateway{{Name: "a"}, {Name: "b"}}
+	groups := []string{"group"}
+
+	for _, tt := range []struct {
+		name     string
+		configs  map[string]gatewayconfigurer.GatewayConfig
+		expected []string
+	}{
+		{
+			name: "valid config",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.0.0/24"}}, RoutesIPv6: []gatewayconfigurer.Route{{CIDR: "fd00::/64"}}, AccessGroupIds: groups},
+				"b": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.1.0/24"}}, AccessGroupIds: groups},
+			},
+		},
+		{
+			name: "invalid cidr",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.0.0/33"}}, AccessGroupIds: groups},
+			},
+			expected: []string{`error: gateway a: invalid IPv4 route "10.0.0.0/33", expected CIDR notation`},
+		},
+		{
+			name: "wrong address family",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "fd00::/64"}}, RoutesIPv6: []gatewayconfigurer.Route{{CIDR: "10.0.0.0/24"}}, AccessGroupIds: groups},
+			},
+			expected: []string{
+				"error: gateway a: route fd00::/64 is not an IPv4 route",
+				"error: gateway a: route 10.0.0.0/24 is not an IPv6 route",
+			},
+		},
+		{
+			name: "host bits set",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.0.1/24"}}, AccessGroupIds: groups},
+			},
+			expected: []string{"warning: gateway a: route 10.0.0.1/24 is not a network address, did you mean 10.0.0.0/24?"},
+		},
+		{
+			name: "overlaps wireguard network",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.255.0.0/16"}}, AccessGroupIds: groups},
+			},
+			expected: []string{"error: gateway a: route 10.255.0.0/16 overlaps the WireGuard network 10.255.240.0/21"},
+		},
+		{
+			name: "overlap within gateway",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.0.0/24"}, {CIDR: "10.0.0.0/16"}}, AccessGroupIds: groups},
+			},
+			expected: []string{"warning: gateway a: routes 10.0.0.0/16 and 10.0.0.0/24 overlap"},
+		},
+		{
+			name: "overlap across gateways",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.0.0/16"}}, AccessGroupIds: groups},
+				"b": {Routes: []gatewayconfigurer.Route{{CIDR: "10.0.5.0/24"}}, AccessGroupIds: groups},
+			},
+			expected: []string{"warning: gateway b: route 10.0.5.0/24 overlaps route 10.0.0.0/16 of gateway a"},
+		},
+		{
+			name: "unknown gateway and empty access groups",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"c": {},
+			},
+			expected: []string{
+				"warning: gateway c: not enrolled, config will be ignored",
+				"warning: gateway c: no access groups, no devices will be able to connect",
+			},
+		},
+		{
+			name: "invalid jita policy",
+			configs: map[string]gatewayconfigurer.GatewayConfig{
+				"a": {AccessGroupIds: groups, JitaPolicy: &gatewayconfigurer.JitaPolicy{ReasonPattern: "("}},
+			},
+			expected: []string{"error: gateway a: jita policy: parse reason_pattern: error parsing regexp: missing closing ): `(`"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := gatewayconfigurer.Validate(tt.configs, gateways, wireguardPrefixes)
+
+			var actual []string
+			for _, problem := range problems {
+				actual = append(actual, problem.String())
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestFile_SkipsUnknownGateways(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log := logrus.StandardLogger().WithField("component", "test")
+	path := writeFile(t, "gatewayconfig.json", `{
+		"known": {"access_group_ids": ["group"]},
+		"unknown": {"access_group_ids": ["group"]}
+	}`)
+
+	db := database.NewMockDatabase(t)
+	db.EXPECT().ReadGateways(mock.Anything).Return([]*pb.Gateway{{Name: "known"}}, nil).Once()
+	db.EXPECT().UpdateGatewaysDynamicFields(mock.Anything, []*pb.Gateway{{Name: "known", AccessGroupIDs: []string{"group"}}}).Return(nil).Once()
+
+	gc := gatewayconfigurer.NewFile(log, db, path, wireguardPrefixes, func() {})
+	assert.NoError(t, gc.SyncConfig(ctx))
+}
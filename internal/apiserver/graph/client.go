@@ -0,0 +1,109 @@
+// Package graph looks up group memberships of users in the identity provider, through the Microsoft Graph API.
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nais/device/internal/ioconvenience"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// ErrUserNotFound is returned for users that no longer exist in the identity provider.
+var ErrUserNotFound = errors.New("user not found")
+
+type Client interface {
+	// MemberGroups returns the IDs of all groups the user is a member of, directly or through other groups.
+	MemberGroups(ctx context.Context, objectID string) ([]string, error)
+}
+
+type client struct {
+	baseURL  string
+	tokenURL string
+	client   *http.Client
+
+	log logrus.FieldLogger
+}
+
+type ClientOption func(*client)
+
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *client) {
+		c.baseURL = baseURL
+	}
+}
+
+func WithTokenURL(tokenURL string) ClientOption {
+	return func(c *client) {
+		c.tokenURL = tokenURL
+	}
+}
+
+// New returns a client authenticating as an application with client credentials.
+// The application needs the GroupMember.Read.All permission.
+func New(ctx context.Context, tenantID, clientID, clientSecret string, log logrus.FieldLogger, opts ...ClientOption) *client {
+	c := &client{
+		baseURL:  "https://graph.microsoft.com/v1.0",
+		tokenURL: "https://login.microsoftonline.com/" + url.PathEscape(tenantID) + "/oauth2/v2.0/token",
+		log:      log,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	credentials := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     c.tokenURL,
+		Scopes:       []string{"https://graph.microsoft.com/.default"},
+	}
+	c.client = credentials.Client(ctx)
+
+	return c
+}
+
+var _ Client = &client{}
+
+func (c *client) MemberGroups(ctx context.Context, objectID string) ([]string, error) {
+	// security groups only would leave out groups that may still be in the groups claim of tokens
+	body, err := json.Marshal(map[string]bool{"securityEnabledOnly": false})
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL := c.baseURL + "/users/" + url.PathEscape(objectID) + "/getMemberGroups"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get member groups: %w", err)
+	}
+	defer ioconvenience.CloseWithLog(resp.Body, c.log)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrUserNotFound
+	default:
+		return nil, fmt.Errorf("get member groups: %s", resp.Status)
+	}
+
+	var memberGroups struct {
+		Value []string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&memberGroups); err != nil {
+		return nil, fmt.Errorf("decode member groups: %w", err)
+	}
+
+	return memberGroups.Value, nil
+}
@@ -0,0 +1,58 @@
+package graph_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nais/device/internal/apiserver/graph"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_MemberGroups(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600}`))
+		case "/users/user-id/getMemberGroups":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+
+			var body map[string]bool
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]bool{"securityEnabledOnly": false}, body)
+
+			w.Write([]byte(`{"value": ["group-1", "group-2"]}`))
+		case "/users/deleted-user-id/getMemberGroups":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := graph.New(ctx, "tenant-id", "client-id", "client-secret", logrus.New(),
+		graph.WithBaseURL(server.URL),
+		graph.WithTokenURL(server.URL+"/token"),
+	)
+
+	groups, err := client.MemberGroups(ctx, "user-id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"group-1", "group-2"}, groups)
+
+	_, err = client.MemberGroups(ctx, "deleted-user-id")
+	assert.ErrorIs(t, err, graph.ErrUserNotFound)
+
+	_, err = client.MemberGroups(ctx, "failing-user-id")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, graph.ErrUserNotFound)
+}
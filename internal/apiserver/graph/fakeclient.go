@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// FakeClient returns group memberships set with SetGroups, for tests and local development.
+type FakeClient struct {
+	lock   sync.Mutex
+	groups map[string][]string
+}
+
+var _ Client = &FakeClient{}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{
+		groups: make(map[string][]string),
+	}
+}
+
+// SetGroups sets the groups of a user. Users without groups set are not found.
+func (f *FakeClient) SetGroups(objectID string, groups ...string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.groups[objectID] = groups
+}
+
+func (f *FakeClient) MemberGroups(_ context.Context, objectID string) ([]string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	groups, ok := f.groups[objectID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+
+	return slices.Clone(groups), nil
+}
@@ -3,8 +3,11 @@ package kolide
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"math/rand/v2"
 	"time"
 
+	"github.com/nais/device/internal/apiserver/metrics"
 	"github.com/nais/device/internal/ioconvenience"
 	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
 	"github.com/sirupsen/logrus"
@@ -29,9 +32,20 @@ func (c *ClientInterceptor) RequireTransportSecurity() bool {
 }
 
 const (
-	eventStreamBackoff = 10 * time.Second
+	eventStreamMinBackoff = time.Second
+	eventStreamMaxBackoff = time.Minute
 )
 
+// eventStreamBackoff returns how long to wait before reconnecting after the given number of consecutive failures,
+// doubling from eventStreamMinBackoff up to eventStreamMaxBackoff, with up to 50% jitter so that instances do not reconnect in lockstep.
+func eventStreamBackoff(failures int) time.Duration {
+	backoff := eventStreamMinBackoff << min(failures, 6)
+	backoff = min(backoff, eventStreamMaxBackoff)
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// DeviceEventStreamer streams device events from the Kolide event handler into stream until ctx is done,
+// reconnecting with backoff whenever the stream fails.
 func DeviceEventStreamer(ctx context.Context, log logrus.FieldLogger, grpcAddress, grpcToken string, grpcSecure bool, stream chan<- *kolidepb.DeviceEvent) error {
 	interceptor := &ClientInterceptor{
 		RequireTLS: grpcSecure,
@@ -60,29 +74,52 @@ func DeviceEventStreamer(ctx context.Context, log logrus.FieldLogger, grpcAddres
 
 	s := kolidepb.NewKolideEventHandlerClient(conn)
 
+	log.WithField("address", conn.Target()).Info("starting Kolide event stream")
+
+	failures := 0
 	for ctx.Err() == nil {
-		events, err := s.Events(ctx, &kolidepb.EventsRequest{})
-		if err != nil {
-			log.WithError(err).Error("start Kolide event stream")
-			log.WithField("backoff", eventStreamBackoff).Warn("restarting event stream after backoff...")
-			time.Sleep(eventStreamBackoff)
-			continue
+		started := time.Now()
+		err := receiveEvents(ctx, s, stream)
+		if ctx.Err() != nil {
+			break
 		}
 
-		log.WithField("address", conn.Target()).Info("started Kolide event stream")
+		// a stream that stayed up for a while starts over with a short backoff
+		if time.Since(started) > eventStreamMaxBackoff {
+			failures = 0
+		}
 
-		for {
-			event, err := events.Recv()
-			if err != nil {
-				log.WithError(err).Error("receive Kolide event")
-				log.WithField("backoff", eventStreamBackoff).Warn("restarting event stream after backoff...")
-				time.Sleep(eventStreamBackoff)
-				break
-			}
+		metrics.IncKolideEventStreamRestarts()
+		backoff := eventStreamBackoff(failures)
+		failures++
+		log.WithError(err).WithField("backoff", backoff).Warn("Kolide event stream failed, reconnecting after backoff")
 
-			stream <- event
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
 		}
 	}
 
 	return ctx.Err()
 }
+
+// receiveEvents receives events from a single event stream until it fails.
+func receiveEvents(ctx context.Context, client kolidepb.KolideEventHandlerClient, stream chan<- *kolidepb.DeviceEvent) error {
+	events, err := client.Events(ctx, &kolidepb.EventsRequest{})
+	if err != nil {
+		return fmt.Errorf("start Kolide event stream: %w", err)
+	}
+
+	for {
+		event, err := events.Recv()
+		if err != nil {
+			return fmt.Errorf("receive Kolide event: %w", err)
+		}
+
+		select {
+		case stream <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
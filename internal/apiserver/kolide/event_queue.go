@@ -0,0 +1,109 @@
+package kolide
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/metrics"
+	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
+	"github.com/sirupsen/logrus"
+)
+
+// EventProcessor updates a device after a Kolide event.
+type EventProcessor func(ctx context.Context, event *kolidepb.DeviceEvent) error
+
+// EventQueue coalesces Kolide device events and processes them with a bounded number of workers.
+// Events are held for a short window, and further events for the same device within the window are dropped,
+// as processing a single event fetches the current state of the device anyway.
+type EventQueue struct {
+	window  time.Duration
+	workers int
+	timeout time.Duration
+	process EventProcessor
+	log     logrus.FieldLogger
+}
+
+type queuedEvent struct {
+	event    *kolidepb.DeviceEvent
+	received time.Time
+}
+
+func NewEventQueue(window time.Duration, workers int, process EventProcessor, log logrus.FieldLogger) *EventQueue {
+	return &EventQueue{
+		window:  window,
+		workers: max(workers, 1),
+		timeout: 2 * time.Minute,
+		process: process,
+		log:     log,
+	}
+}
+
+// Run reads events until ctx is done, and waits for events being processed to finish before returning.
+// Events still waiting in the queue when ctx is done are dropped, as the periodic full sync picks them up.
+func (q *EventQueue) Run(ctx context.Context, events <-chan *kolidepb.DeviceEvent) {
+	work := make(chan queuedEvent)
+
+	var wg sync.WaitGroup
+	for range q.workers {
+		wg.Go(func() {
+			for e := range work {
+				q.processEvent(ctx, e)
+			}
+		})
+	}
+	defer func() {
+		close(work)
+		wg.Wait()
+	}()
+
+	pending := make(map[string]bool)
+	var queue []queuedEvent
+
+	for {
+		metrics.SetKolideEventQueueDepth(len(queue))
+
+		// only offer the oldest event to the workers once its window has passed
+		var next chan<- queuedEvent
+		var due <-chan time.Time
+		var head queuedEvent
+		if len(queue) > 0 {
+			head = queue[0]
+			if wait := time.Until(head.received.Add(q.window)); wait > 0 {
+				due = time.After(wait)
+			} else {
+				next = work
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case event := <-events:
+			if pending[event.GetExternalID()] {
+				metrics.IncKolideEventsCoalesced()
+				continue
+			}
+			pending[event.GetExternalID()] = true
+			queue = append(queue, queuedEvent{event: event, received: time.Now()})
+
+		case next <- head:
+			delete(pending, head.event.GetExternalID())
+			queue = queue[1:]
+
+		case <-due:
+		}
+	}
+}
+
+func (q *EventQueue) processEvent(ctx context.Context, e queuedEvent) {
+	metrics.ObserveKolideEventLag(time.Since(e.received))
+
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+
+	if err := q.process(ctx, e.event); err != nil {
+		q.log.WithError(err).WithField("external_id", e.event.GetExternalID()).Error("update device health")
+	}
+}
@@ -0,0 +1,57 @@
+package kolide_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/kolide"
+	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventQueueCoalescesEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var lock sync.Mutex
+	processed := make(map[string]int)
+	done := make(chan struct{}, 10)
+
+	queue := kolide.NewEventQueue(100*time.Millisecond, 2, func(_ context.Context, event *kolidepb.DeviceEvent) error {
+		lock.Lock()
+		processed[event.GetExternalID()]++
+		lock.Unlock()
+		done <- struct{}{}
+		return nil
+	}, logrus.New())
+
+	events := make(chan *kolidepb.DeviceEvent)
+	go queue.Run(ctx, events)
+
+	for _, id := range []string{"1", "2", "1", "1", "2", "3"} {
+		events <- &kolidepb.DeviceEvent{ExternalID: id}
+	}
+
+	for range 3 {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for events to be processed")
+		}
+	}
+
+	// an event after the first was processed is queued again
+	events <- &kolidepb.DeviceEvent{ExternalID: "1"}
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for events to be processed")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, map[string]int{"1": 2, "2": 1, "3": 1}, processed)
+}
@@ -1,9 +1,12 @@
 package kolide
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/nais/device/internal/apiserver/metrics"
@@ -12,11 +15,17 @@ import (
 
 var ErrMaxRetriesExceeded = errors.New("max retries exceeded")
 
+// Transport adds Kolide API headers, and retries requests that are rate limited or fail with a server error.
+// When Kolide rate limits a request, all requests through the transport wait, so that concurrent callers back off together.
+// Server errors only delay the retry of the failing request.
 type Transport struct {
 	Token             string
 	Transport         http.RoundTripper
 	DefaultRetryAfter time.Duration
 	MaxHTTPRetries    int
+
+	lock        sync.Mutex
+	pausedUntil time.Time
 }
 
 var _ http.RoundTripper = &Transport{}
@@ -36,6 +45,10 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("X-Kolide-Api-Version", "2023-05-26")
 
 	for attempt := range t.MaxHTTPRetries {
+		if err := t.waitForPause(req.Context()); err != nil {
+			return nil, err
+		}
+
 		resp, err := t.Transport.RoundTrip(req)
 		if err != nil {
 			return nil, err
@@ -47,23 +60,62 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 			return resp, nil
 		}
 		retryAfter := t.getRetryAfter(resp.Header)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
 		log.WithFields(log.Fields{
 			"attempt":      attempt + 1,
 			"max_attempts": t.MaxHTTPRetries,
 			"response":     resp.Status,
 			"retry_after":  retryAfter,
-		}).Debug("rate limited, sleeping")
+		}).Debug("request failed, retrying")
 
-		select {
-		case <-time.After(retryAfter):
-		case <-req.Context().Done():
-			return nil, req.Context().Err()
+		if resp.StatusCode == http.StatusTooManyRequests {
+			t.pause(retryAfter)
+			continue
+		}
+
+		if retryAfter == 0 {
+			retryAfter = t.DefaultRetryAfter
+		}
+		if err := sleep(req.Context(), retryAfter); err != nil {
+			return nil, err
 		}
 	}
 
 	return nil, ErrMaxRetriesExceeded
 }
 
+func (t *Transport) pause(d time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if until := time.Now().Add(d); until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+}
+
+func (t *Transport) waitForPause(ctx context.Context) error {
+	t.lock.Lock()
+	wait := time.Until(t.pausedUntil)
+	t.lock.Unlock()
+
+	return sleep(ctx, wait)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (t *Transport) getRetryAfter(header http.Header) time.Duration {
 	limit := header.Get("Ratelimit-Limit")
 	remaining := header.Get("Ratelimit-Remaining")
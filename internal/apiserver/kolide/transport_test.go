@@ -0,0 +1,70 @@
+package kolide
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	serve := func(t *testing.T, statuses ...int) (*Transport, string, *atomic.Int32) {
+		requests := &atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := int(requests.Add(1))
+			if n <= len(statuses) {
+				w.WriteHeader(statuses[n-1])
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+
+		transport := NewTransport("token")
+		transport.DefaultRetryAfter = time.Millisecond
+		return transport, server.URL, requests
+	}
+
+	t.Run("server errors do not pause other requests", func(t *testing.T) {
+		transport, url, requests := serve(t, http.StatusBadGateway)
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.EqualValues(t, 2, requests.Load())
+		assert.True(t, transport.pausedUntil.IsZero())
+	})
+
+	t.Run("rate limits pause all requests", func(t *testing.T) {
+		transport, url, requests := serve(t, http.StatusTooManyRequests)
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.EqualValues(t, 2, requests.Load())
+		assert.False(t, transport.pausedUntil.IsZero())
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		transport, url, requests := serve(t, http.StatusInternalServerError, http.StatusInternalServerError)
+		transport.MaxHTTPRetries = 2
+
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		_, err = transport.RoundTrip(req)
+		assert.ErrorIs(t, err, ErrMaxRetriesExceeded)
+		assert.EqualValues(t, 2, requests.Load())
+	})
+}
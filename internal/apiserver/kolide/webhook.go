@@ -0,0 +1,224 @@
+package kolide
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/metrics"
+	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	WebhookResultAccepted         = "accepted"
+	WebhookResultIgnored          = "ignored"
+	WebhookResultInvalidSignature = "invalid_signature"
+	WebhookResultMalformed        = "malformed"
+	WebhookResultReplayed         = "replayed"
+	WebhookResultUnavailable      = "unavailable"
+
+	maxWebhookBodySize = 1 << 20
+)
+
+// WebhookEvent is the part of a Kolide webhook we care about.
+type WebhookEvent struct {
+	ID        string           `json:"id"`
+	Event     string           `json:"event"`
+	Timestamp time.Time        `json:"timestamp"`
+	Data      WebhookEventData `json:"data"`
+}
+
+type WebhookEventData struct {
+	CheckID   int64 `json:"check_id"`
+	FailureID int64 `json:"failure_id"`
+	DeviceID  int64 `json:"device_id"`
+}
+
+// WebhookHandler receives Kolide webhooks, and emits a device event for every new or resolved check failure.
+// Webhooks must be signed with the signing secret, and are rejected if they are older than maxAge or have been seen before.
+// Seen event IDs are kept in memory only, so after a restart an event may be replayed until it is older than maxAge.
+type WebhookHandler struct {
+	signingSecret []byte
+	maxAge        time.Duration
+	events        chan<- *kolidepb.DeviceEvent
+	log           logrus.FieldLogger
+
+	lock sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewWebhookHandler(signingSecret string, maxAge time.Duration, events chan<- *kolidepb.DeviceEvent, log logrus.FieldLogger) *WebhookHandler {
+	return &WebhookHandler{
+		signingSecret: []byte(signingSecret),
+		maxAge:        maxAge,
+		events:        events,
+		log:           log,
+		seen:          make(map[string]time.Time),
+	}
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		h.reject(w, http.StatusBadRequest, WebhookResultMalformed, fmt.Errorf("read body: %w", err))
+		return
+	}
+
+	if !h.validSignature(body, r.Header.Get("Authorization")) {
+		h.reject(w, http.StatusForbidden, WebhookResultInvalidSignature, errors.New("invalid signature"))
+		return
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		h.reject(w, http.StatusBadRequest, WebhookResultMalformed, fmt.Errorf("unmarshal event: %w", err))
+		return
+	}
+
+	if event.ID == "" || event.Timestamp.IsZero() {
+		h.reject(w, http.StatusBadRequest, WebhookResultMalformed, errors.New("event without id or timestamp"))
+		return
+	}
+
+	if age := time.Since(event.Timestamp); age > h.maxAge || age < -h.maxAge {
+		h.reject(w, http.StatusBadRequest, WebhookResultReplayed, fmt.Errorf("event %s is %v old", event.ID, age.Truncate(time.Second)))
+		return
+	}
+
+	// marked before it is handled, so that concurrent deliveries of the same event are rejected
+	if !h.checkAndMark(event.ID) {
+		h.reject(w, http.StatusConflict, WebhookResultReplayed, fmt.Errorf("event %s already received", event.ID))
+		return
+	}
+
+	log := h.log.WithField("event", event.Event).WithField("event_id", event.ID)
+
+	switch event.Event {
+	case "failures.new", "failures.resolved":
+		if event.Data.DeviceID == 0 {
+			h.reject(w, http.StatusBadRequest, WebhookResultMalformed, fmt.Errorf("event %s without device_id", event.ID))
+			return
+		}
+
+		deviceEvent := &kolidepb.DeviceEvent{
+			Timestamp:  timestamppb.New(event.Timestamp),
+			ExternalID: strconv.FormatInt(event.Data.DeviceID, 10),
+		}
+
+		select {
+		case h.events <- deviceEvent:
+		case <-r.Context().Done():
+			// forget the event, so that Kolide can retry
+			h.unmark(event.ID)
+			h.reject(w, http.StatusServiceUnavailable, WebhookResultUnavailable, fmt.Errorf("event %s: device update queue full", event.ID))
+			return
+		}
+
+		metrics.IncKolideWebhook(WebhookResultAccepted)
+		log.WithField("kolide_device_id", deviceEvent.ExternalID).Debug("Kolide webhook received")
+
+	case "webhook.test":
+		metrics.IncKolideWebhook(WebhookResultAccepted)
+		log.Info("Kolide webhook test received")
+
+	default:
+		metrics.IncKolideWebhook(WebhookResultIgnored)
+		log.Debug("unsupported Kolide webhook event")
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validSignature checks the hex encoded HMAC-SHA256 of the body sent by Kolide in the Authorization header.
+func (h *WebhookHandler) validSignature(body []byte, signature string) bool {
+	incoming, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, h.signingSecret)
+	mac.Write(body)
+	return hmac.Equal(incoming, mac.Sum(nil))
+}
+
+// checkAndMark remembers an event ID for twice the max age, as older events are rejected anyway.
+// It returns false if the event ID has been seen before.
+func (h *WebhookHandler) checkAndMark(id string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	now := time.Now()
+	for seenID, expires := range h.seen {
+		if now.After(expires) {
+			delete(h.seen, seenID)
+		}
+	}
+
+	if _, seen := h.seen[id]; seen {
+		return false
+	}
+
+	h.seen[id] = now.Add(2 * h.maxAge)
+	return true
+}
+
+func (h *WebhookHandler) unmark(id string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	delete(h.seen, id)
+}
+
+func (h *WebhookHandler) reject(w http.ResponseWriter, code int, result string, err error) {
+	metrics.IncKolideWebhook(result)
+	h.log.WithError(err).WithField("result", result).Warn("rejected Kolide webhook")
+	w.WriteHeader(code)
+}
+
+// ServeWebhooks serves handler on /webhooks at address until ctx is done.
+func ServeWebhooks(ctx context.Context, address string, handler http.Handler, log logrus.FieldLogger) error {
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks", handler)
+	mux.HandleFunc("/isalive", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.WithError(err).Warn("shut down Kolide webhook server")
+		}
+	}()
+
+	log.WithField("address", address).Info("serving Kolide webhooks")
+	err := server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
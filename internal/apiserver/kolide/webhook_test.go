@@ -0,0 +1,115 @@
+package kolide_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/kolide"
+	kolidepb "github.com/nais/kolide-event-handler/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const signingSecret = "secret"
+
+func webhookRequest(body, secret string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	r.Header.Set("Authorization", hex.EncodeToString(mac.Sum(nil)))
+	return r
+}
+
+func webhookBody(id string, timestamp time.Time) string {
+	return fmt.Sprintf(`{"id":%q,"event":"failures.new","timestamp":%q,"data":{"check_id":1,"failure_id":2,"device_id":123}}`, id, timestamp.Format(time.RFC3339))
+}
+
+func TestWebhookHandler(t *testing.T) {
+	events := make(chan *kolidepb.DeviceEvent, 1)
+	handler := kolide.NewWebhookHandler(signingSecret, 5*time.Minute, events, logrus.New())
+
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	body := webhookBody("event-1", time.Now())
+	assert.Equal(t, http.StatusOK, serve(webhookRequest(body, signingSecret)))
+	event := <-events
+	assert.Equal(t, "123", event.GetExternalID())
+
+	t.Run("replayed event is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusConflict, serve(webhookRequest(body, signingSecret)))
+	})
+
+	t.Run("old event is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(webhookRequest(webhookBody("event-2", time.Now().Add(-time.Hour)), signingSecret)))
+	})
+
+	t.Run("wrong signature is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, serve(webhookRequest(webhookBody("event-3", time.Now()), "wrong")))
+	})
+
+	t.Run("malformed event is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(webhookRequest(`{"event":`, signingSecret)))
+		assert.Equal(t, http.StatusBadRequest, serve(webhookRequest(`{"event":"failures.new"}`, signingSecret)))
+	})
+
+	t.Run("other events are acknowledged without device updates", func(t *testing.T) {
+		body := fmt.Sprintf(`{"id":"event-4","event":"webhook.test","timestamp":%q}`, time.Now().Format(time.RFC3339))
+		assert.Equal(t, http.StatusOK, serve(webhookRequest(body, signingSecret)))
+		assert.Empty(t, events)
+	})
+
+	t.Run("concurrent deliveries of an event are accepted once", func(t *testing.T) {
+		body := webhookBody("event-5", time.Now())
+		codes := make(chan int, 10)
+		var wg sync.WaitGroup
+		for range cap(codes) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				codes <- serve(webhookRequest(body, signingSecret))
+			}()
+		}
+		wg.Wait()
+		close(codes)
+
+		accepted := 0
+		for code := range codes {
+			if code == http.StatusOK {
+				accepted++
+			} else {
+				assert.Equal(t, http.StatusConflict, code)
+			}
+		}
+		assert.Equal(t, 1, accepted)
+		assert.Len(t, events, 1)
+		<-events
+	})
+
+	t.Run("event is forgotten when the queue is full", func(t *testing.T) {
+		events <- &kolidepb.DeviceEvent{}
+
+		body := webhookBody("event-6", time.Now())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Equal(t, http.StatusServiceUnavailable, serve(webhookRequest(body, signingSecret).WithContext(ctx)))
+
+		// Kolide retries once there is room in the queue
+		<-events
+		assert.Equal(t, http.StatusOK, serve(webhookRequest(body, signingSecret)))
+		<-events
+	})
+}
@@ -0,0 +1,168 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/metrics"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LeaseName is the name of the database lease held by the active apiserver instance.
+const LeaseName = "apiserver"
+
+// Elector campaigns for the apiserver lease, so that only one of several apiserver instances sharing a database is active.
+type Elector struct {
+	db     database.Database
+	holder string
+	ttl    time.Duration
+	leader atomic.Bool
+	log    logrus.FieldLogger
+}
+
+// NewElector creates an elector campaigning as holder for a lease lasting ttl.
+// The lease is renewed three times per ttl, so a standby takes over within ttl after the active instance disappears.
+func NewElector(db database.Database, holder string, ttl time.Duration, log logrus.FieldLogger) *Elector {
+	return &Elector{
+		db:     db,
+		holder: holder,
+		ttl:    ttl,
+		log:    log,
+	}
+}
+
+// IsLeader reports whether this instance currently holds the lease.
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run campaigns for the lease until ctx is done.
+// Every time this instance becomes the leader, lead is started with a context that is canceled when the lease is lost,
+// and Run waits for lead to return before campaigning again. The lease is released when ctx is done,
+// so that a standby can take over without waiting for it to expire.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	renewInterval := e.ttl / 3
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+
+	var stepDown func()
+	// expiry steps down a renew interval before the lease may expire, unless it is renewed first. It runs on its own,
+	// so that an attempt hanging on the database can not keep this instance leading past the expiry.
+	var expiry *time.Timer
+	stopExpiry := func() {
+		if expiry != nil {
+			expiry.Stop()
+		}
+	}
+	defer stopExpiry()
+
+	for {
+		attempted := time.Now()
+		attemptCtx, cancel := context.WithTimeout(ctx, renewInterval)
+		acquired, err := e.db.AcquireLease(attemptCtx, LeaseName, e.holder, e.ttl)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			e.log.WithError(err).Error("acquire lease")
+		}
+
+		switch {
+		case acquired:
+			if !e.IsLeader() {
+				stepDown = e.startLeading(ctx, lead)
+			}
+			stopExpiry()
+			stop := stepDown
+			expiry = time.AfterFunc(time.Until(attempted.Add(e.ttl-renewInterval)), func() {
+				e.log.Warn("unable to renew lease before it expires")
+				stop()
+			})
+		case !e.IsLeader():
+		case err == nil:
+			e.log.Warn("lease taken by another instance")
+			stopExpiry()
+			stepDown()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			stopExpiry()
+			if e.IsLeader() {
+				stepDown()
+				e.release()
+			}
+			return
+		}
+	}
+}
+
+// startLeading starts lead, and returns a function stopping it and waiting for it to return.
+// The returned function may be called several times, also concurrently.
+func (e *Elector) startLeading(ctx context.Context, lead func(ctx context.Context)) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(ctx)
+	}()
+	e.setLeader(true)
+
+	return sync.OnceFunc(func() {
+		cancel()
+		<-done
+		e.setLeader(false)
+	})
+}
+
+func (e *Elector) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := e.db.ReleaseLease(ctx, LeaseName, e.holder); err != nil {
+		e.log.WithError(err).Error("release lease")
+		return
+	}
+
+	e.log.Info("released lease")
+}
+
+func (e *Elector) setLeader(isLeader bool) {
+	e.leader.Store(isLeader)
+	metrics.SetLeader(isLeader)
+
+	if isLeader {
+		e.log.WithField("holder", e.holder).Info("became the active apiserver instance")
+	} else {
+		e.log.WithField("holder", e.holder).Info("stepped down to standby")
+	}
+}
+
+// UnaryServerInterceptor rejects requests with codes.Unavailable while this instance is on standby,
+// so that clients retry against the active instance.
+func (e *Elector) UnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !e.IsLeader() {
+		return nil, errStandby()
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects streams with codes.Unavailable while this instance is on standby,
+// so that clients retry against the active instance.
+func (e *Elector) StreamServerInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !e.IsLeader() {
+		return errStandby()
+	}
+
+	return handler(srv, stream)
+}
+
+func errStandby() error {
+	return status.Error(codes.Unavailable, "apiserver instance is on standby")
+}
@@ -0,0 +1,126 @@
+package leader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/internal/apiserver/leader"
+	"github.com/nais/device/internal/apiserver/testdatabase"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const ttl = 300 * time.Millisecond
+
+func TestElectorFailover(t *testing.T) {
+	db := testdatabase.Setup(t, false)
+	log := logrus.StandardLogger().WithField("component", "test")
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	defer cancelA()
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+
+	a := leader.NewElector(db, "a", ttl, log.WithField("holder", "a"))
+	b := leader.NewElector(db, "b", ttl, log.WithField("holder", "b"))
+
+	aLeading := make(chan struct{})
+	aStopped := make(chan struct{})
+	aDone := make(chan struct{})
+	go func() {
+		defer close(aDone)
+		a.Run(ctxA, func(ctx context.Context) {
+			close(aLeading)
+			<-ctx.Done()
+			close(aStopped)
+		})
+	}()
+
+	select {
+	case <-aLeading:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a never became leader")
+	}
+	assert.True(t, a.IsLeader())
+
+	bLeading := make(chan struct{})
+	bDone := make(chan struct{})
+	go func() {
+		defer close(bDone)
+		b.Run(ctxB, func(ctx context.Context) {
+			close(bLeading)
+			<-ctx.Done()
+		})
+	}()
+
+	// b stays on standby while a renews its lease
+	time.Sleep(2 * ttl)
+	assert.False(t, b.IsLeader())
+
+	// a shuts down and releases the lease, b takes over
+	cancelA()
+	<-aDone
+	select {
+	case <-aStopped:
+	default:
+		t.Fatal("a returned without stopping its leader function")
+	}
+	assert.False(t, a.IsLeader())
+
+	select {
+	case <-bLeading:
+	case <-time.After(5 * time.Second):
+		t.Fatal("b never became leader")
+	}
+	assert.True(t, b.IsLeader())
+
+	cancelB()
+	<-bDone
+}
+
+func TestElectorStepsDownWhileDatabaseHangs(t *testing.T) {
+	log := logrus.StandardLogger().WithField("component", "test")
+
+	// the first attempt acquires the lease, and every later attempt hangs, even past its timeout
+	hang := make(chan struct{})
+	db := database.NewMockDatabase(t)
+	db.EXPECT().AcquireLease(mock.Anything, leader.LeaseName, "a", ttl).Return(true, nil).Once()
+	db.EXPECT().AcquireLease(mock.Anything, leader.LeaseName, "a", ttl).RunAndReturn(func(context.Context, string, string, time.Duration) (bool, error) {
+		<-hang
+		return false, context.DeadlineExceeded
+	}).Maybe()
+	db.EXPECT().ReleaseLease(mock.Anything, leader.LeaseName, "a").Return(nil).Maybe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := leader.NewElector(db, "a", ttl, log)
+
+	var acquired time.Time
+	stopped := make(chan time.Time, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.Run(ctx, func(ctx context.Context) {
+			acquired = time.Now()
+			<-ctx.Done()
+			stopped <- time.Now()
+		})
+	}()
+
+	select {
+	case at := <-stopped:
+		// the lease acquired just before lead started expires ttl later, by then another instance may take it
+		assert.Less(t, at.Sub(acquired), ttl)
+		assert.Eventually(t, func() bool { return !a.IsLeader() }, time.Second, 10*time.Millisecond)
+	case <-time.After(5 * time.Second):
+		t.Fatal("a kept leading while the database hangs")
+	}
+
+	close(hang)
+	cancel()
+	<-done
+}
@@ -3,6 +3,7 @@ package metrics
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -23,6 +24,13 @@ var (
 	deviceStreamsEnded prometheus.CounterVec
 	gatewayStatus      *prometheus.GaugeVec
 	kolideStatusCodes  *prometheus.CounterVec
+	kolideWebhooks     *prometheus.CounterVec
+	kolideEventQueue   prometheus.Gauge
+	kolideEventLag     prometheus.Histogram
+	kolideCoalesced    prometheus.Counter
+	kolideRestarts     prometheus.Counter
+	jitaExpiryDelay    prometheus.Histogram
+	leader             prometheus.Gauge
 )
 
 func Serve(address string) error {
@@ -42,10 +50,42 @@ func IncKolideStatusCode(code int) {
 	kolideStatusCodes.WithLabelValues(strconv.Itoa(code)).Inc()
 }
 
+func IncKolideWebhook(result string) {
+	kolideWebhooks.WithLabelValues(result).Inc()
+}
+
+func SetKolideEventQueueDepth(depth int) {
+	kolideEventQueue.Set(float64(depth))
+}
+
+func ObserveKolideEventLag(d time.Duration) {
+	kolideEventLag.Observe(d.Seconds())
+}
+
+func IncKolideEventsCoalesced() {
+	kolideCoalesced.Inc()
+}
+
+func IncKolideEventStreamRestarts() {
+	kolideRestarts.Inc()
+}
+
 func IncDeviceStreamsEnded(reason string) {
 	deviceStreamsEnded.WithLabelValues(reason).Inc()
 }
 
+func ObserveJitaExpiryDelay(d time.Duration) {
+	jitaExpiryDelay.Observe(d.Seconds())
+}
+
+func SetLeader(isLeader bool) {
+	if isLeader {
+		leader.Set(1.0)
+	} else {
+		leader.Set(0.0)
+	}
+}
+
 func init() {
 	DevicesConnected = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
@@ -96,6 +136,42 @@ func init() {
 		Help:      "Kolide status codes from API",
 	}, []string{"code"})
 
+	kolideWebhooks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "kolide_webhooks",
+		Help:      "Kolide webhooks received, by result",
+	}, []string{"result"})
+
+	kolideEventQueue = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "kolide_event_queue_depth",
+		Help:      "Kolide device events waiting to be processed",
+	})
+
+	kolideEventLag = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "kolide_event_lag_seconds",
+		Help:      "Delay between a Kolide device event being received and the device being updated",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	})
+
+	kolideCoalesced = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "kolide_events_coalesced",
+		Help:      "Kolide device events dropped because an event for the same device was already queued",
+	})
+
+	kolideRestarts = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "kolide_event_stream_restarts",
+		Help:      "Times the Kolide event stream failed and was reconnected",
+	})
+
 	deviceStreamsEnded = *prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: subsystem,
@@ -103,6 +179,21 @@ func init() {
 		Help:      "Device streams ending with reason label",
 	}, []string{"reason"})
 
+	jitaExpiryDelay = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "jita_expiry_delay_seconds",
+		Help:      "Delay between a JITA grant expiring and its gateway receiving a configuration without the grant",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	})
+
+	leader = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "leader",
+		Help:      "1 if this apiserver instance is the active one, 0 if it is on standby",
+	})
+
 	prometheus.MustRegister(
 		DevicesConnected,
 		gatewayStatus,
@@ -111,6 +202,13 @@ func init() {
 		GatewayConfigsReturned,
 		LoginRequests,
 		kolideStatusCodes,
+		kolideWebhooks,
+		kolideEventQueue,
+		kolideEventLag,
+		kolideCoalesced,
+		kolideRestarts,
 		deviceStreamsEnded,
+		jitaExpiryDelay,
+		leader,
 	)
 }
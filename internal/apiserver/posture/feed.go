@@ -0,0 +1,203 @@
+package posture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FeedDocument is the format read by Feed.
+type FeedDocument struct {
+	Devices []FeedDevice `json:"devices"`
+}
+
+// FeedDevice holds the issues for a device, identified by serial and platform like in the apiserver.
+type FeedDevice struct {
+	Serial   string      `json:"serial"`
+	Platform string      `json:"platform"`
+	Issues   []FeedIssue `json:"issues"`
+}
+
+// FeedIssue is an open issue on a device. ResolveBefore defaults to DetectedAt plus the grace period for the severity.
+type FeedIssue struct {
+	Title         string     `json:"title"`
+	Message       string     `json:"message"`
+	Severity      string     `json:"severity"`
+	DetectedAt    time.Time  `json:"detected_at"`
+	ResolveBefore *time.Time `json:"resolve_before,omitempty"`
+}
+
+// Feed is a posture provider reading device issues from a JSON document at a local path or an http(s) URL.
+// The document is read on every Sync and kept in memory, so that evaluating devices never waits for the source.
+type Feed struct {
+	source string
+	token  string
+	policy *PolicyFile
+	client *http.Client
+	log    logrus.FieldLogger
+
+	lock     sync.RWMutex
+	lastRead []byte
+	issues   map[string][]feedIssue
+}
+
+type feedIssue struct {
+	FeedIssue
+	severity pb.Severity
+}
+
+var _ Provider = &Feed{}
+
+// NewFeed creates a feed reading from source. Sources starting with http:// or https:// are fetched,
+// sending token as a bearer token if it is set, anything else is read as a file path.
+// Deadlines for issues without resolve_before follow the grace periods in policy.
+func NewFeed(source, token string, policy *PolicyFile, log logrus.FieldLogger) *Feed {
+	return &Feed{
+		source: source,
+		token:  token,
+		policy: policy,
+		client: &http.Client{Timeout: 30 * time.Second},
+		log:    log,
+	}
+}
+
+func (f *Feed) Name() string {
+	return "feed"
+}
+
+func (f *Feed) DeviceIssues(_ context.Context, device *pb.Device) ([]*pb.DeviceIssue, error) {
+	f.lock.RLock()
+	issues := f.issues[feedKey(device.GetSerial(), device.GetPlatform())]
+	f.lock.RUnlock()
+
+	if len(issues) == 0 {
+		return nil, nil
+	}
+
+	policy := f.policy.Policy()
+	deviceIssues := make([]*pb.DeviceIssue, 0, len(issues))
+	for _, issue := range issues {
+		resolveBefore := issue.DetectedAt.Add(policy.GracePeriod(issue.severity))
+		if issue.ResolveBefore != nil {
+			resolveBefore = *issue.ResolveBefore
+		}
+
+		deviceIssues = append(deviceIssues, &pb.DeviceIssue{
+			Title:         issue.Title,
+			Message:       issue.Message,
+			Severity:      issue.severity,
+			DetectedAt:    timestamppb.New(issue.DetectedAt),
+			LastUpdated:   timestamppb.New(issue.DetectedAt),
+			ResolveBefore: timestamppb.New(resolveBefore),
+		})
+	}
+
+	return deviceIssues, nil
+}
+
+// Sync reads the feed, and reports whether the issues changed. If the feed can not be read or is invalid,
+// the issues from the last good read are kept.
+func (f *Feed) Sync(ctx context.Context) (bool, error) {
+	data, err := f.read(ctx)
+	if err != nil {
+		return false, fmt.Errorf("read posture feed: %w", err)
+	}
+
+	f.lock.RLock()
+	unchanged := f.issues != nil && bytes.Equal(f.lastRead, data)
+	f.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	issues, err := parseFeed(data)
+	if err != nil {
+		return false, err
+	}
+
+	f.lock.Lock()
+	f.lastRead = data
+	f.issues = issues
+	f.lock.Unlock()
+
+	f.log.WithField("devices", len(issues)).Info("read posture feed")
+
+	return true, nil
+}
+
+func (f *Feed) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(f.source, "http://") && !strings.HasPrefix(f.source, "https://") {
+		return os.ReadFile(f.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	if f.token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.token)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parseFeed parses a feed document into issues per device. Issues with severity info are left out,
+// as they never affect access. The whole document is rejected if any issue is invalid.
+func parseFeed(data []byte) (map[string][]feedIssue, error) {
+	var doc FeedDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse posture feed: %w", err)
+	}
+
+	issues := make(map[string][]feedIssue)
+	for _, device := range doc.Devices {
+		if device.Serial == "" || device.Platform == "" {
+			return nil, fmt.Errorf("parse posture feed: device without serial or platform")
+		}
+
+		key := feedKey(device.Serial, device.Platform)
+		for _, issue := range device.Issues {
+			severity, err := ParseSeverity(issue.Severity)
+			if err != nil {
+				return nil, fmt.Errorf("parse posture feed: device %s: %w", device.Serial, err)
+			}
+
+			if issue.Title == "" || issue.DetectedAt.IsZero() {
+				return nil, fmt.Errorf("parse posture feed: device %s: issue without title or detected_at", device.Serial)
+			}
+
+			if severity == pb.Severity_Info {
+				continue
+			}
+
+			issues[key] = append(issues[key], feedIssue{FeedIssue: issue, severity: severity})
+		}
+	}
+
+	return issues, nil
+}
+
+func feedKey(serial, platform string) string {
+	return platform + "/" + serial
+}
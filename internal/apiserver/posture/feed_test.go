@@ -0,0 +1,96 @@
+package posture_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/posture"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const feedDocument = `{
+  "devices": [
+    {
+      "serial": "serial",
+      "platform": "darwin",
+      "issues": [
+        {"title": "disk not encrypted", "message": "turn on FileVault", "severity": "danger", "detected_at": "2026-01-01T00:00:00Z"},
+        {"title": "informational", "severity": "info", "detected_at": "2026-01-01T00:00:00Z"},
+        {"title": "old os", "severity": "Notice", "detected_at": "2026-01-01T00:00:00Z", "resolve_before": "2026-02-01T00:00:00Z"}
+      ]
+    }
+  ]
+}`
+
+func TestFeedFromFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "posture.json")
+	assert.NoError(t, os.WriteFile(path, []byte(feedDocument), 0o600))
+
+	feed := posture.NewFeed(path, "", nil, logrus.New())
+
+	changed, err := feed.Sync(ctx)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	issues, err := feed.DeviceIssues(ctx, &pb.Device{Serial: "serial", Platform: "darwin"})
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+
+	detectedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "disk not encrypted", issues[0].Title)
+	assert.Equal(t, pb.Severity_Danger, issues[0].Severity)
+	assert.Equal(t, detectedAt.Add(posture.DurationDanger), issues[0].ResolveBefore.AsTime())
+	assert.Equal(t, pb.Severity_Notice, issues[1].Severity)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), issues[1].ResolveBefore.AsTime())
+
+	issues, err = feed.DeviceIssues(ctx, &pb.Device{Serial: "serial", Platform: "windows"})
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+
+	changed, err = feed.Sync(ctx)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// invalid feeds keep the last good issues
+	assert.NoError(t, os.WriteFile(path, []byte(`{"devices": [{"serial": "serial", "platform": "darwin", "issues": [{"title": "x", "severity": "bad"}]}]}`), 0o600))
+	changed, err = feed.Sync(ctx)
+	assert.Error(t, err)
+	assert.False(t, changed)
+
+	issues, err = feed.DeviceIssues(ctx, &pb.Device{Serial: "serial", Platform: "darwin"})
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+}
+
+func TestFeedFromHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(feedDocument))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	_, err := posture.NewFeed(server.URL, "wrong", nil, logrus.New()).Sync(ctx)
+	assert.Error(t, err)
+
+	feed := posture.NewFeed(server.URL, "secret", nil, logrus.New())
+	changed, err := feed.Sync(ctx)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	issues, err := feed.DeviceIssues(ctx, &pb.Device{Serial: "serial", Platform: "darwin"})
+	assert.NoError(t, err)
+	assert.Len(t, issues, 2)
+}
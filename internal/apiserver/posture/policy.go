@@ -0,0 +1,275 @@
+package posture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// Policy decides the severity of Kolide checks, and how long devices may keep connecting after an issue is detected.
+type Policy struct {
+	// GracePeriods per severity, severities without an entry use DurationUnknown.
+	GracePeriods map[pb.Severity]time.Duration
+	// Tags maps lower case check tags to severities. A check gets the highest severity of its tags.
+	Tags map[string]pb.Severity
+	// DefaultSeverity is used for checks without any known tag.
+	DefaultSeverity pb.Severity
+	// Checks overrides the severity and grace period of single checks by check ID.
+	Checks map[int64]CheckOverride
+}
+
+// CheckOverride overrides the policy for a single check. Nil fields are not overridden.
+type CheckOverride struct {
+	Severity    *pb.Severity
+	GracePeriod *time.Duration
+}
+
+// Rating is how a policy rates a check.
+type Rating struct {
+	Severity    pb.Severity
+	GracePeriod time.Duration
+	// Reason explains where the severity and grace period came from.
+	Reason string
+	// UnknownTags are the tags of the check not mapped to a severity.
+	UnknownTags []string
+}
+
+// DefaultPolicy returns the built-in policy, where check tags named after a severity give that severity.
+func DefaultPolicy() *Policy {
+	p := &Policy{
+		GracePeriods:    make(map[pb.Severity]time.Duration),
+		Tags:            make(map[string]pb.Severity),
+		DefaultSeverity: pb.Severity_Warning,
+		Checks:          make(map[int64]CheckOverride),
+	}
+
+	for value, name := range pb.Severity_name {
+		severity := pb.Severity(value)
+		p.Tags[strings.ToLower(name)] = severity
+		p.GracePeriods[severity] = GracePeriod(severity)
+	}
+
+	return p
+}
+
+// GracePeriod returns how long a device may keep connecting after an issue with the given severity is detected.
+func (p *Policy) GracePeriod(severity pb.Severity) time.Duration {
+	if gracePeriod, ok := p.GracePeriods[severity]; ok {
+		return gracePeriod
+	}
+
+	return DurationUnknown
+}
+
+// RateCheck returns the severity and grace period of a Kolide check with the given ID and tags.
+func (p *Policy) RateCheck(checkID int64, tags []string) Rating {
+	rating := Rating{Severity: -1}
+
+	var reason string
+	for _, tag := range tags {
+		severity, ok := p.Tags[strings.ToLower(strings.TrimSpace(tag))]
+		if !ok {
+			rating.UnknownTags = append(rating.UnknownTags, tag)
+			continue
+		}
+
+		if severity > rating.Severity {
+			rating.Severity = severity
+			reason = fmt.Sprintf("tag %q", tag)
+		}
+	}
+
+	if rating.Severity == -1 {
+		rating.Severity = p.DefaultSeverity
+		reason = "default severity"
+	}
+
+	override, overridden := p.Checks[checkID]
+	if overridden && override.Severity != nil {
+		rating.Severity = *override.Severity
+		reason = fmt.Sprintf("override for check %d", checkID)
+	}
+
+	rating.GracePeriod = p.GracePeriod(rating.Severity)
+	rating.Reason = fmt.Sprintf("severity from %s, grace period for %s", reason, strings.ToLower(rating.Severity.String()))
+
+	if overridden && override.GracePeriod != nil {
+		rating.GracePeriod = *override.GracePeriod
+		rating.Reason = fmt.Sprintf("severity from %s, grace period from override for check %d", reason, checkID)
+	}
+
+	return rating
+}
+
+// policyDocument is the format of a policy file. All fields are optional, and extend or replace the defaults.
+type policyDocument struct {
+	GracePeriods    map[string]string              `json:"grace_periods" yaml:"grace_periods"`
+	Tags            map[string]string              `json:"tags" yaml:"tags"`
+	DefaultSeverity string                         `json:"default_severity" yaml:"default_severity"`
+	Checks          map[string]checkOverrideConfig `json:"checks" yaml:"checks"`
+}
+
+type checkOverrideConfig struct {
+	Severity    string `json:"severity" yaml:"severity"`
+	GracePeriod string `json:"grace_period" yaml:"grace_period"`
+}
+
+// ParsePolicy parses a policy file on top of the default policy. Files ending in .yaml or .yml are parsed as YAML, anything else as JSON.
+func ParsePolicy(path string, data []byte) (*Policy, error) {
+	var doc policyDocument
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("unmarshaling posture policy yaml: %w", err)
+		}
+	default:
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("unmarshaling posture policy json: %w", err)
+		}
+	}
+
+	p := DefaultPolicy()
+
+	for name, raw := range doc.GracePeriods {
+		severity, err := ParseSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("grace period: %w", err)
+		}
+		gracePeriod, err := parseGracePeriod(raw)
+		if err != nil {
+			return nil, fmt.Errorf("grace period for %s: %w", name, err)
+		}
+		p.GracePeriods[severity] = gracePeriod
+	}
+
+	for tag, name := range doc.Tags {
+		severity, err := ParseSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("tag %q: %w", tag, err)
+		}
+		p.Tags[strings.ToLower(tag)] = severity
+	}
+
+	if doc.DefaultSeverity != "" {
+		severity, err := ParseSeverity(doc.DefaultSeverity)
+		if err != nil {
+			return nil, fmt.Errorf("default severity: %w", err)
+		}
+		p.DefaultSeverity = severity
+	}
+
+	for rawID, check := range doc.Checks {
+		checkID, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("check %q: invalid check ID", rawID)
+		}
+
+		var override CheckOverride
+		if check.Severity != "" {
+			severity, err := ParseSeverity(check.Severity)
+			if err != nil {
+				return nil, fmt.Errorf("check %d: %w", checkID, err)
+			}
+			override.Severity = &severity
+		}
+		if check.GracePeriod != "" {
+			gracePeriod, err := parseGracePeriod(check.GracePeriod)
+			if err != nil {
+				return nil, fmt.Errorf("check %d: grace period: %w", checkID, err)
+			}
+			override.GracePeriod = &gracePeriod
+		}
+		p.Checks[checkID] = override
+	}
+
+	return p, nil
+}
+
+func parseGracePeriod(raw string) (time.Duration, error) {
+	gracePeriod, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, err
+	}
+	if gracePeriod < 0 {
+		return 0, fmt.Errorf("negative grace period %s", raw)
+	}
+
+	return gracePeriod, nil
+}
+
+// PolicyFile holds the policy read from a file, and the default policy until the file has been read.
+// A nil PolicyFile always holds the default policy.
+type PolicyFile struct {
+	path     string
+	lastRead []byte
+	policy   atomic.Pointer[Policy]
+	log      logrus.FieldLogger
+}
+
+var defaultPolicy = DefaultPolicy()
+
+// NewPolicyFile creates a policy read from path by Sync.
+func NewPolicyFile(path string, log logrus.FieldLogger) *PolicyFile {
+	return &PolicyFile{
+		path: path,
+		log:  log,
+	}
+}
+
+// Policy returns the current policy. It must not be modified.
+func (f *PolicyFile) Policy() *Policy {
+	if f == nil {
+		return defaultPolicy
+	}
+
+	if p := f.policy.Load(); p != nil {
+		return p
+	}
+
+	return defaultPolicy
+}
+
+// Path returns the path of the policy file, or an empty string if the default policy is used.
+func (f *PolicyFile) Path() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.path
+}
+
+// Sync reads the policy file, and reports whether the policy changed. If the file can not be read or is invalid,
+// the last good policy is kept. Sync must not be called concurrently.
+func (f *PolicyFile) Sync(_ context.Context) (bool, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return false, fmt.Errorf("read posture policy file: %w", err)
+	}
+
+	if f.lastRead != nil && bytes.Equal(f.lastRead, data) {
+		return false, nil
+	}
+
+	p, err := ParsePolicy(f.path, data)
+	if err != nil {
+		return false, fmt.Errorf("parse posture policy file: %w", err)
+	}
+
+	f.policy.Store(p)
+	f.lastRead = data
+
+	f.log.WithField("tags", len(p.Tags)).WithField("checks", len(p.Checks)).Info("loaded posture policy")
+
+	return true, nil
+}
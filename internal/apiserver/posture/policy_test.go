@@ -0,0 +1,98 @@
+package posture_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/posture"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const policyDocument = `
+grace_periods:
+  danger: 4h
+tags:
+  must-fix: critical
+default_severity: notice
+checks:
+  "42":
+    severity: info
+  "43":
+    grace_period: 720h
+`
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := posture.ParsePolicy("policy.yaml", []byte(policyDocument))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		checkID     int64
+		tags        []string
+		severity    pb.Severity
+		gracePeriod time.Duration
+	}{
+		{"default tags still apply", 1, []string{"Warning"}, pb.Severity_Warning, posture.DurationWarning},
+		{"grace period per severity", 1, []string{"danger"}, pb.Severity_Danger, 4 * time.Hour},
+		{"custom tag", 1, []string{"warning", "must-fix"}, pb.Severity_Critical, posture.DurationCritical},
+		{"default severity", 1, []string{"unknown"}, pb.Severity_Notice, posture.DurationNotice},
+		{"severity override", 42, []string{"critical"}, pb.Severity_Info, posture.DurationUnknown},
+		{"grace period override", 43, []string{"danger"}, pb.Severity_Danger, 720 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rating := policy.RateCheck(tt.checkID, tt.tags)
+			assert.Equal(t, tt.severity, rating.Severity)
+			assert.Equal(t, tt.gracePeriod, rating.GracePeriod)
+			assert.NotEmpty(t, rating.Reason)
+		})
+	}
+
+	rating := policy.RateCheck(1, []string{"unknown", "danger"})
+	assert.Equal(t, []string{"unknown"}, rating.UnknownTags)
+
+	_, err = posture.ParsePolicy("policy.json", []byte(`{"tags": {"foo": "severe"}}`))
+	assert.Error(t, err)
+
+	_, err = posture.ParsePolicy("policy.json", []byte(`{"checks": {"abc": {"severity": "danger"}}}`))
+	assert.Error(t, err)
+
+	_, err = posture.ParsePolicy("policy.json", []byte(`{"grace_periods": {"danger": "-1h"}}`))
+	assert.Error(t, err)
+}
+
+func TestPolicyFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+
+	var nilFile *posture.PolicyFile
+	assert.Equal(t, posture.DurationDanger, nilFile.Policy().GracePeriod(pb.Severity_Danger))
+
+	file := posture.NewPolicyFile(path, logrus.New())
+	_, err := file.Sync(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, posture.DurationDanger, file.Policy().GracePeriod(pb.Severity_Danger))
+
+	assert.NoError(t, os.WriteFile(path, []byte(policyDocument), 0o600))
+	changed, err := file.Sync(ctx)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, 4*time.Hour, file.Policy().GracePeriod(pb.Severity_Danger))
+
+	changed, err = file.Sync(ctx)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// invalid policies keep the last good policy
+	assert.NoError(t, os.WriteFile(path, []byte("grace_periods:\n  danger: soon\n"), 0o600))
+	changed, err = file.Sync(ctx)
+	assert.Error(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, 4*time.Hour, file.Policy().GracePeriod(pb.Severity_Danger))
+}
@@ -0,0 +1,59 @@
+package posture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+)
+
+// Provider is a source of device posture, such as Kolide or an issue feed.
+// Issues from all providers are merged into the issues of a device.
+type Provider interface {
+	// Name identifies the provider in logs.
+	Name() string
+	// DeviceIssues returns the open issues for a device, with their severities and deadlines.
+	// Devices unknown to the provider have no issues.
+	DeviceIssues(ctx context.Context, device *pb.Device) ([]*pb.DeviceIssue, error)
+}
+
+// Devices are allowed to connect this long after an issue is detected.
+const (
+	DurationCritical  = 0
+	DurationDanger    = time.Hour
+	DurationWarning   = time.Hour * 24 * 2
+	DurationAttention = time.Hour * 24 * 3
+	DurationNotice    = time.Hour * 24 * 7
+	DurationUnknown   = time.Hour * 24 * 30
+)
+
+// GracePeriod returns how long a device may keep connecting after an issue with the given severity is detected.
+func GracePeriod(severity pb.Severity) time.Duration {
+	switch severity {
+	case pb.Severity_Notice:
+		return DurationNotice
+	case pb.Severity_Attention:
+		return DurationAttention
+	case pb.Severity_Warning:
+		return DurationWarning
+	case pb.Severity_Danger:
+		return DurationDanger
+	case pb.Severity_Critical:
+		return DurationCritical
+	default:
+		return DurationUnknown
+	}
+}
+
+// ParseSeverity parses a severity name such as "danger", ignoring case.
+func ParseSeverity(name string) (pb.Severity, error) {
+	for value, severityName := range pb.Severity_name {
+		if strings.EqualFold(name, severityName) {
+			return pb.Severity(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown severity %q", name)
+}
@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: audit_events.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const addAuditEvent = `-- name: AddAuditEvent :exec
+INSERT INTO audit_events (created, actor, action, target, reason)
+VALUES (?1, ?2, ?3, ?4, ?5)
+`
+
+type AddAuditEventParams struct {
+	Created string
+	Actor   string
+	Action  string
+	Target  string
+	Reason  string
+}
+
+func (q *Queries) AddAuditEvent(ctx context.Context, arg AddAuditEventParams) error {
+	_, err := q.exec(ctx, q.addAuditEventStmt, addAuditEvent,
+		arg.Created,
+		arg.Actor,
+		arg.Action,
+		arg.Target,
+		arg.Reason,
+	)
+	return err
+}
+
+const getAuditEvents = `-- name: GetAuditEvents :many
+SELECT id, created, actor, "action", target, reason FROM audit_events
+WHERE
+    (CAST(?1 AS TEXT) = '' OR actor = ?1)
+    AND (CAST(?2 AS TEXT) = '' OR action = ?2)
+    AND (CAST(?3 AS TEXT) = '' OR target = ?3)
+    AND (CAST(?4 AS TEXT) = '' OR DATETIME(created) >= DATETIME(?4))
+    AND (CAST(?5 AS TEXT) = '' OR DATETIME(created) < DATETIME(?5))
+    AND (CAST(?6 AS INTEGER) = 0 OR id < ?6)
+ORDER BY id DESC
+LIMIT ?7
+`
+
+type GetAuditEventsParams struct {
+	Actor    string
+	Action   string
+	Target   string
+	Since    string
+	Until    string
+	BeforeID int64
+	PageSize int64
+}
+
+func (q *Queries) GetAuditEvents(ctx context.Context, arg GetAuditEventsParams) ([]*AuditEvent, error) {
+	rows, err := q.query(ctx, q.getAuditEventsStmt, getAuditEvents,
+		arg.Actor,
+		arg.Action,
+		arg.Target,
+		arg.Since,
+		arg.Until,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Created,
+			&i.Actor,
+			&i.Action,
+			&i.Target,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -38,8 +38,20 @@ func (q *Queries) AddDevice(ctx context.Context, arg AddDeviceParams) error {
 	return err
 }
 
+const deleteDevice = `-- name: DeleteDevice :execrows
+DELETE FROM devices WHERE id = ?1
+`
+
+func (q *Queries) DeleteDevice(ctx context.Context, id int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteDeviceStmt, deleteDevice, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getDeviceByExternalID = `-- name: GetDeviceByExternalID :one
-SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id FROM devices WHERE external_id = ?1
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE external_id = ?1
 `
 
 func (q *Queries) GetDeviceByExternalID(ctx context.Context, externalID sql.NullString) (*Device, error) {
@@ -57,12 +69,14 @@ func (q *Queries) GetDeviceByExternalID(ctx context.Context, externalID sql.Null
 		&i.Ipv6,
 		&i.LastSeen,
 		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
 
 const getDeviceByID = `-- name: GetDeviceByID :one
-SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id FROM devices WHERE devices.id = ?1
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE devices.id = ?1
 `
 
 func (q *Queries) GetDeviceByID(ctx context.Context, id int64) (*Device, error) {
@@ -80,12 +94,14 @@ func (q *Queries) GetDeviceByID(ctx context.Context, id int64) (*Device, error)
 		&i.Ipv6,
 		&i.LastSeen,
 		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
 
 const getDeviceByPublicKey = `-- name: GetDeviceByPublicKey :one
-SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id FROM devices WHERE public_key = ?1
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE public_key = ?1
 `
 
 func (q *Queries) GetDeviceByPublicKey(ctx context.Context, publicKey string) (*Device, error) {
@@ -103,12 +119,14 @@ func (q *Queries) GetDeviceByPublicKey(ctx context.Context, publicKey string) (*
 		&i.Ipv6,
 		&i.LastSeen,
 		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
 
 const getDeviceBySerialAndPlatform = `-- name: GetDeviceBySerialAndPlatform :one
-SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id FROM devices WHERE serial = ?1 AND platform = ?2
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE serial = ?1 AND platform = ?2
 `
 
 type GetDeviceBySerialAndPlatformParams struct {
@@ -131,12 +149,14 @@ func (q *Queries) GetDeviceBySerialAndPlatform(ctx context.Context, arg GetDevic
 		&i.Ipv6,
 		&i.LastSeen,
 		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
 
 const getDevices = `-- name: GetDevices :many
-SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id FROM devices ORDER BY devices.id
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices ORDER BY devices.id
 `
 
 func (q *Queries) GetDevices(ctx context.Context) ([]*Device, error) {
@@ -160,6 +180,8 @@ func (q *Queries) GetDevices(ctx context.Context) ([]*Device, error) {
 			&i.Ipv6,
 			&i.LastSeen,
 			&i.ExternalID,
+			&i.AgentVersion,
+			&i.AgentVersionOutdatedSince,
 		); err != nil {
 			return nil, err
 		}
@@ -233,3 +255,54 @@ func (q *Queries) UpdateDevice(ctx context.Context, arg UpdateDeviceParams) erro
 	)
 	return err
 }
+
+const updateDeviceAgentVersion = `-- name: UpdateDeviceAgentVersion :exec
+UPDATE devices
+SET agent_version = ?1
+WHERE id = ?2
+`
+
+type UpdateDeviceAgentVersionParams struct {
+	AgentVersion string
+	ID           int64
+}
+
+func (q *Queries) UpdateDeviceAgentVersion(ctx context.Context, arg UpdateDeviceAgentVersionParams) error {
+	_, err := q.exec(ctx, q.updateDeviceAgentVersionStmt, updateDeviceAgentVersion, arg.AgentVersion, arg.ID)
+	return err
+}
+
+const updateDeviceAgentVersionOutdatedSince = `-- name: UpdateDeviceAgentVersionOutdatedSince :exec
+UPDATE devices
+SET agent_version_outdated_since = ?1
+WHERE id = ?2
+`
+
+type UpdateDeviceAgentVersionOutdatedSinceParams struct {
+	AgentVersionOutdatedSince sql.NullString
+	ID                        int64
+}
+
+func (q *Queries) UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, arg UpdateDeviceAgentVersionOutdatedSinceParams) error {
+	_, err := q.exec(ctx, q.updateDeviceAgentVersionOutdatedSinceStmt, updateDeviceAgentVersionOutdatedSince, arg.AgentVersionOutdatedSince, arg.ID)
+	return err
+}
+
+const updateDeviceUsername = `-- name: UpdateDeviceUsername :execrows
+UPDATE devices
+SET username = ?1
+WHERE id = ?2
+`
+
+type UpdateDeviceUsernameParams struct {
+	Username string
+	ID       int64
+}
+
+func (q *Queries) UpdateDeviceUsername(ctx context.Context, arg UpdateDeviceUsernameParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateDeviceUsernameStmt, updateDeviceUsername, arg.Username, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -8,8 +8,8 @@ import (
 )
 
 const addGateway = `-- name: AddGateway :exec
-INSERT INTO gateways (name, endpoint, public_key, ipv4, ipv6, password_hash, requires_privileged_access)
-VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+INSERT INTO gateways (name, endpoint, public_key, ipv4, ipv6, password_hash, requires_privileged_access, requires_approval, jita_max_duration_seconds, jita_min_reason_length, jita_reason_pattern, jita_max_grants_per_day)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12)
 ON CONFLICT (name) DO
     UPDATE SET endpoint = excluded.endpoint, public_key = excluded.public_key, password_hash = excluded.password_hash, ipv6 = excluded.ipv6
 `
@@ -22,6 +22,11 @@ type AddGatewayParams struct {
 	Ipv6                     string
 	PasswordHash             string
 	RequiresPrivilegedAccess bool
+	RequiresApproval         bool
+	JitaMaxDurationSeconds   int64
+	JitaMinReasonLength      int64
+	JitaReasonPattern        string
+	JitaMaxGrantsPerDay      int64
 }
 
 func (q *Queries) AddGateway(ctx context.Context, arg AddGatewayParams) error {
@@ -33,6 +38,11 @@ func (q *Queries) AddGateway(ctx context.Context, arg AddGatewayParams) error {
 		arg.Ipv6,
 		arg.PasswordHash,
 		arg.RequiresPrivilegedAccess,
+		arg.RequiresApproval,
+		arg.JitaMaxDurationSeconds,
+		arg.JitaMinReasonLength,
+		arg.JitaReasonPattern,
+		arg.JitaMaxGrantsPerDay,
 	)
 	return err
 }
@@ -70,6 +80,18 @@ func (q *Queries) AddGatewayRoute(ctx context.Context, arg AddGatewayRouteParams
 	return err
 }
 
+const deleteGateway = `-- name: DeleteGateway :execrows
+DELETE FROM gateways WHERE name = ?1
+`
+
+func (q *Queries) DeleteGateway(ctx context.Context, name string) (int64, error) {
+	result, err := q.exec(ctx, q.deleteGatewayStmt, deleteGateway, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteGatewayAccessGroupIDs = `-- name: DeleteGatewayAccessGroupIDs :exec
 DELETE FROM gateway_access_group_ids WHERE gateway_name = ?1
 `
@@ -116,7 +138,7 @@ func (q *Queries) GetGatewayAccessGroupIDs(ctx context.Context, gatewayName stri
 }
 
 const getGatewayByName = `-- name: GetGatewayByName :one
-SELECT name, endpoint, public_key, ipv4, requires_privileged_access, password_hash, ipv6 FROM gateways WHERE name = ?1
+SELECT name, endpoint, public_key, ipv4, requires_privileged_access, password_hash, ipv6, requires_approval, jita_max_duration_seconds, jita_min_reason_length, jita_reason_pattern, jita_max_grants_per_day FROM gateways WHERE name = ?1
 `
 
 func (q *Queries) GetGatewayByName(ctx context.Context, name string) (*Gateway, error) {
@@ -130,6 +152,11 @@ func (q *Queries) GetGatewayByName(ctx context.Context, name string) (*Gateway,
 		&i.RequiresPrivilegedAccess,
 		&i.PasswordHash,
 		&i.Ipv6,
+		&i.RequiresApproval,
+		&i.JitaMaxDurationSeconds,
+		&i.JitaMinReasonLength,
+		&i.JitaReasonPattern,
+		&i.JitaMaxGrantsPerDay,
 	)
 	return &i, err
 }
@@ -167,7 +194,7 @@ func (q *Queries) GetGatewayRoutes(ctx context.Context, gatewayName string) ([]*
 }
 
 const getGateways = `-- name: GetGateways :many
-SELECT name, endpoint, public_key, ipv4, requires_privileged_access, password_hash, ipv6 FROM gateways ORDER BY name
+SELECT name, endpoint, public_key, ipv4, requires_privileged_access, password_hash, ipv6, requires_approval, jita_max_duration_seconds, jita_min_reason_length, jita_reason_pattern, jita_max_grants_per_day FROM gateways ORDER BY name
 `
 
 func (q *Queries) GetGateways(ctx context.Context) ([]*Gateway, error) {
@@ -187,6 +214,11 @@ func (q *Queries) GetGateways(ctx context.Context) ([]*Gateway, error) {
 			&i.RequiresPrivilegedAccess,
 			&i.PasswordHash,
 			&i.Ipv6,
+			&i.RequiresApproval,
+			&i.JitaMaxDurationSeconds,
+			&i.JitaMinReasonLength,
+			&i.JitaReasonPattern,
+			&i.JitaMaxGrantsPerDay,
 		); err != nil {
 			return nil, err
 		}
@@ -203,8 +235,8 @@ func (q *Queries) GetGateways(ctx context.Context) ([]*Gateway, error) {
 
 const updateGateway = `-- name: UpdateGateway :exec
 UPDATE gateways
-SET public_key = ?1, endpoint = ?2, ipv4 = ?3, ipv6 = ?4, requires_privileged_access = ?5, password_hash = ?6
-WHERE name = ?7
+SET public_key = ?1, endpoint = ?2, ipv4 = ?3, ipv6 = ?4, requires_privileged_access = ?5, requires_approval = ?6, jita_max_duration_seconds = ?7, jita_min_reason_length = ?8, jita_reason_pattern = ?9, jita_max_grants_per_day = ?10, password_hash = ?11
+WHERE name = ?12
 `
 
 type UpdateGatewayParams struct {
@@ -213,6 +245,11 @@ type UpdateGatewayParams struct {
 	Ipv4                     string
 	Ipv6                     string
 	RequiresPrivilegedAccess bool
+	RequiresApproval         bool
+	JitaMaxDurationSeconds   int64
+	JitaMinReasonLength      int64
+	JitaReasonPattern        string
+	JitaMaxGrantsPerDay      int64
 	PasswordHash             string
 	Name                     string
 }
@@ -224,6 +261,11 @@ func (q *Queries) UpdateGateway(ctx context.Context, arg UpdateGatewayParams) er
 		arg.Ipv4,
 		arg.Ipv6,
 		arg.RequiresPrivilegedAccess,
+		arg.RequiresApproval,
+		arg.JitaMaxDurationSeconds,
+		arg.JitaMinReasonLength,
+		arg.JitaReasonPattern,
+		arg.JitaMaxGrantsPerDay,
 		arg.PasswordHash,
 		arg.Name,
 	)
@@ -232,16 +274,29 @@ func (q *Queries) UpdateGateway(ctx context.Context, arg UpdateGatewayParams) er
 
 const updateGatewayDynamicFields = `-- name: UpdateGatewayDynamicFields :exec
 UPDATE gateways
-SET requires_privileged_access = ?1
-WHERE name = ?2
+SET requires_privileged_access = ?1, requires_approval = ?2, jita_max_duration_seconds = ?3, jita_min_reason_length = ?4, jita_reason_pattern = ?5, jita_max_grants_per_day = ?6
+WHERE name = ?7
 `
 
 type UpdateGatewayDynamicFieldsParams struct {
 	RequiresPrivilegedAccess bool
+	RequiresApproval         bool
+	JitaMaxDurationSeconds   int64
+	JitaMinReasonLength      int64
+	JitaReasonPattern        string
+	JitaMaxGrantsPerDay      int64
 	Name                     string
 }
 
 func (q *Queries) UpdateGatewayDynamicFields(ctx context.Context, arg UpdateGatewayDynamicFieldsParams) error {
-	_, err := q.exec(ctx, q.updateGatewayDynamicFieldsStmt, updateGatewayDynamicFields, arg.RequiresPrivilegedAccess, arg.Name)
+	_, err := q.exec(ctx, q.updateGatewayDynamicFieldsStmt, updateGatewayDynamicFields,
+		arg.RequiresPrivilegedAccess,
+		arg.RequiresApproval,
+		arg.JitaMaxDurationSeconds,
+		arg.JitaMinReasonLength,
+		arg.JitaReasonPattern,
+		arg.JitaMaxGrantsPerDay,
+		arg.Name,
+	)
 	return err
 }
@@ -0,0 +1,178 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: issue_exemptions.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createIssueExemption = `-- name: CreateIssueExemption :one
+INSERT INTO issue_exemptions (device_id, username, check_id, reason, created_by, created, expires)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+RETURNING id, device_id, username, check_id, reason, created_by, created, expires, revoked
+`
+
+type CreateIssueExemptionParams struct {
+	DeviceID  sql.NullInt64
+	Username  sql.NullString
+	CheckID   sql.NullInt64
+	Reason    string
+	CreatedBy string
+	Created   string
+	Expires   string
+}
+
+func (q *Queries) CreateIssueExemption(ctx context.Context, arg CreateIssueExemptionParams) (*IssueExemption, error) {
+	row := q.queryRow(ctx, q.createIssueExemptionStmt, createIssueExemption,
+		arg.DeviceID,
+		arg.Username,
+		arg.CheckID,
+		arg.Reason,
+		arg.CreatedBy,
+		arg.Created,
+		arg.Expires,
+	)
+	var i IssueExemption
+	err := row.Scan(
+		&i.ID,
+		&i.DeviceID,
+		&i.Username,
+		&i.CheckID,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.Created,
+		&i.Expires,
+		&i.Revoked,
+	)
+	return &i, err
+}
+
+const getActiveIssueExemptionsForDevice = `-- name: GetActiveIssueExemptionsForDevice :many
+SELECT id, device_id, username, check_id, reason, created_by, created, expires, revoked FROM issue_exemptions
+WHERE
+    DATETIME(expires) > DATETIME('now')
+    AND revoked IS NULL
+    AND (device_id IS NULL OR device_id = ?1)
+    AND (username IS NULL OR username = ?2)
+ORDER BY id
+`
+
+type GetActiveIssueExemptionsForDeviceParams struct {
+	DeviceID sql.NullInt64
+	Username sql.NullString
+}
+
+func (q *Queries) GetActiveIssueExemptionsForDevice(ctx context.Context, arg GetActiveIssueExemptionsForDeviceParams) ([]*IssueExemption, error) {
+	rows, err := q.query(ctx, q.getActiveIssueExemptionsForDeviceStmt, getActiveIssueExemptionsForDevice, arg.DeviceID, arg.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*IssueExemption
+	for rows.Next() {
+		var i IssueExemption
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeviceID,
+			&i.Username,
+			&i.CheckID,
+			&i.Reason,
+			&i.CreatedBy,
+			&i.Created,
+			&i.Expires,
+			&i.Revoked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIssueExemption = `-- name: GetIssueExemption :one
+SELECT id, device_id, username, check_id, reason, created_by, created, expires, revoked FROM issue_exemptions WHERE id = ?1
+`
+
+func (q *Queries) GetIssueExemption(ctx context.Context, id int64) (*IssueExemption, error) {
+	row := q.queryRow(ctx, q.getIssueExemptionStmt, getIssueExemption, id)
+	var i IssueExemption
+	err := row.Scan(
+		&i.ID,
+		&i.DeviceID,
+		&i.Username,
+		&i.CheckID,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.Created,
+		&i.Expires,
+		&i.Revoked,
+	)
+	return &i, err
+}
+
+const getIssueExemptions = `-- name: GetIssueExemptions :many
+SELECT id, device_id, username, check_id, reason, created_by, created, expires, revoked FROM issue_exemptions
+WHERE
+    CAST(?1 AS BOOLEAN) = FALSE
+    OR (DATETIME(expires) > DATETIME('now') AND revoked IS NULL)
+ORDER BY id DESC
+`
+
+func (q *Queries) GetIssueExemptions(ctx context.Context, activeOnly bool) ([]*IssueExemption, error) {
+	rows, err := q.query(ctx, q.getIssueExemptionsStmt, getIssueExemptions, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*IssueExemption
+	for rows.Next() {
+		var i IssueExemption
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeviceID,
+			&i.Username,
+			&i.CheckID,
+			&i.Reason,
+			&i.CreatedBy,
+			&i.Created,
+			&i.Expires,
+			&i.Revoked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeIssueExemption = `-- name: RevokeIssueExemption :exec
+UPDATE issue_exemptions
+SET revoked = ?1
+WHERE
+    id = ?2
+    AND revoked IS NULL
+`
+
+type RevokeIssueExemptionParams struct {
+	Revoked sql.NullString
+	ID      int64
+}
+
+func (q *Queries) RevokeIssueExemption(ctx context.Context, arg RevokeIssueExemptionParams) error {
+	_, err := q.exec(ctx, q.revokeIssueExemptionStmt, revokeIssueExemption, arg.Revoked, arg.ID)
+	return err
+}
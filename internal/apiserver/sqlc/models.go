@@ -11,18 +11,29 @@ type Acceptance struct {
 	AcceptedAt string
 }
 
+type AuditEvent struct {
+	ID      int64
+	Created string
+	Actor   string
+	Action  string
+	Target  string
+	Reason  string
+}
+
 type Device struct {
-	ID          int64
-	Username    string
-	Serial      string
-	Platform    string
-	Healthy     bool
-	LastUpdated sql.NullString
-	PublicKey   string
-	Ipv4        string
-	Ipv6        string
-	LastSeen    sql.NullString
-	ExternalID  sql.NullString
+	ID                        int64
+	Username                  string
+	Serial                    string
+	Platform                  string
+	Healthy                   bool
+	LastUpdated               sql.NullString
+	PublicKey                 string
+	Ipv4                      string
+	Ipv6                      string
+	LastSeen                  sql.NullString
+	ExternalID                sql.NullString
+	AgentVersion              string
+	AgentVersionOutdatedSince sql.NullString
 }
 
 type Gateway struct {
@@ -33,6 +44,11 @@ type Gateway struct {
 	RequiresPrivilegedAccess bool
 	PasswordHash             string
 	Ipv6                     string
+	RequiresApproval         bool
+	JitaMaxDurationSeconds   int64
+	JitaMinReasonLength      int64
+	JitaReasonPattern        string
+	JitaMaxGrantsPerDay      int64
 }
 
 type GatewayAccessGroupID struct {
@@ -48,6 +64,9 @@ type GatewayJitaGrant struct {
 	Expires     string
 	Revoked     sql.NullString
 	Reason      string
+	Approval    string
+	Approver    sql.NullString
+	Decided     sql.NullString
 }
 
 type GatewayRoute struct {
@@ -56,6 +75,18 @@ type GatewayRoute struct {
 	Family      string
 }
 
+type IssueExemption struct {
+	ID        int64
+	DeviceID  sql.NullInt64
+	Username  sql.NullString
+	CheckID   sql.NullInt64
+	Reason    string
+	CreatedBy string
+	Created   string
+	Expires   string
+	Revoked   sql.NullString
+}
+
 type KolideCheck struct {
 	ID          int64
 	Tags        string
@@ -74,6 +105,12 @@ type KolideIssue struct {
 	Ignored     bool
 }
 
+type Lease struct {
+	Name    string
+	Holder  string
+	Expires string
+}
+
 type Session struct {
 	Key      string
 	Expiry   string
@@ -0,0 +1,786 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
+	q := Queries{db: db}
+	var err error
+	if q.acceptAcceptableUseStmt, err = db.PrepareContext(ctx, acceptAcceptableUse); err != nil {
+		return nil, fmt.Errorf("error preparing query AcceptAcceptableUse: %w", err)
+	}
+	if q.addAuditEventStmt, err = db.PrepareContext(ctx, addAuditEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query AddAuditEvent: %w", err)
+	}
+	if q.addDeviceStmt, err = db.PrepareContext(ctx, addDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query AddDevice: %w", err)
+	}
+	if q.addGatewayStmt, err = db.PrepareContext(ctx, addGateway); err != nil {
+		return nil, fmt.Errorf("error preparing query AddGateway: %w", err)
+	}
+	if q.addGatewayAccessGroupIDStmt, err = db.PrepareContext(ctx, addGatewayAccessGroupID); err != nil {
+		return nil, fmt.Errorf("error preparing query AddGatewayAccessGroupID: %w", err)
+	}
+	if q.addGatewayRouteStmt, err = db.PrepareContext(ctx, addGatewayRoute); err != nil {
+		return nil, fmt.Errorf("error preparing query AddGatewayRoute: %w", err)
+	}
+	if q.addSessionStmt, err = db.PrepareContext(ctx, addSession); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSession: %w", err)
+	}
+	if q.addSessionAccessGroupIDStmt, err = db.PrepareContext(ctx, addSessionAccessGroupID); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSessionAccessGroupID: %w", err)
+	}
+	if q.countGatewayJitaGrantsForUserSinceStmt, err = db.PrepareContext(ctx, countGatewayJitaGrantsForUserSince); err != nil {
+		return nil, fmt.Errorf("error preparing query CountGatewayJitaGrantsForUserSince: %w", err)
+	}
+	if q.createIssueExemptionStmt, err = db.PrepareContext(ctx, createIssueExemption); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateIssueExemption: %w", err)
+	}
+	if q.createLeaseStmt, err = db.PrepareContext(ctx, createLease); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateLease: %w", err)
+	}
+	if q.deleteDeviceStmt, err = db.PrepareContext(ctx, deleteDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDevice: %w", err)
+	}
+	if q.deleteGatewayStmt, err = db.PrepareContext(ctx, deleteGateway); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGateway: %w", err)
+	}
+	if q.deleteGatewayAccessGroupIDsStmt, err = db.PrepareContext(ctx, deleteGatewayAccessGroupIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGatewayAccessGroupIDs: %w", err)
+	}
+	if q.deleteGatewayJitaGrantsStmt, err = db.PrepareContext(ctx, deleteGatewayJitaGrants); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGatewayJitaGrants: %w", err)
+	}
+	if q.deleteGatewayRoutesStmt, err = db.PrepareContext(ctx, deleteGatewayRoutes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteGatewayRoutes: %w", err)
+	}
+	if q.deleteKolideIssuesForDeviceStmt, err = db.PrepareContext(ctx, deleteKolideIssuesForDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteKolideIssuesForDevice: %w", err)
+	}
+	if q.getAcceptanceStmt, err = db.PrepareContext(ctx, getAcceptance); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAcceptance: %w", err)
+	}
+	if q.getAcceptancesStmt, err = db.PrepareContext(ctx, getAcceptances); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAcceptances: %w", err)
+	}
+	if q.getActiveIssueExemptionsForDeviceStmt, err = db.PrepareContext(ctx, getActiveIssueExemptionsForDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveIssueExemptionsForDevice: %w", err)
+	}
+	if q.getAuditEventsStmt, err = db.PrepareContext(ctx, getAuditEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAuditEvents: %w", err)
+	}
+	if q.getDeviceByExternalIDStmt, err = db.PrepareContext(ctx, getDeviceByExternalID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDeviceByExternalID: %w", err)
+	}
+	if q.getDeviceByIDStmt, err = db.PrepareContext(ctx, getDeviceByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDeviceByID: %w", err)
+	}
+	if q.getDeviceByPublicKeyStmt, err = db.PrepareContext(ctx, getDeviceByPublicKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDeviceByPublicKey: %w", err)
+	}
+	if q.getDeviceBySerialAndPlatformStmt, err = db.PrepareContext(ctx, getDeviceBySerialAndPlatform); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDeviceBySerialAndPlatform: %w", err)
+	}
+	if q.getDevicesStmt, err = db.PrepareContext(ctx, getDevices); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDevices: %w", err)
+	}
+	if q.getGatewayAccessGroupIDsStmt, err = db.PrepareContext(ctx, getGatewayAccessGroupIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayAccessGroupIDs: %w", err)
+	}
+	if q.getGatewayByNameStmt, err = db.PrepareContext(ctx, getGatewayByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayByName: %w", err)
+	}
+	if q.getGatewayJitaGrantStmt, err = db.PrepareContext(ctx, getGatewayJitaGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayJitaGrant: %w", err)
+	}
+	if q.getGatewayJitaGrantsStmt, err = db.PrepareContext(ctx, getGatewayJitaGrants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayJitaGrants: %w", err)
+	}
+	if q.getGatewayJitaGrantsForUserStmt, err = db.PrepareContext(ctx, getGatewayJitaGrantsForUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayJitaGrantsForUser: %w", err)
+	}
+	if q.getGatewayRoutesStmt, err = db.PrepareContext(ctx, getGatewayRoutes); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGatewayRoutes: %w", err)
+	}
+	if q.getGatewaysStmt, err = db.PrepareContext(ctx, getGateways); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGateways: %w", err)
+	}
+	if q.getIssueExemptionStmt, err = db.PrepareContext(ctx, getIssueExemption); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIssueExemption: %w", err)
+	}
+	if q.getIssueExemptionsStmt, err = db.PrepareContext(ctx, getIssueExemptions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIssueExemptions: %w", err)
+	}
+	if q.getKolideCheckStmt, err = db.PrepareContext(ctx, getKolideCheck); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKolideCheck: %w", err)
+	}
+	if q.getKolideChecksStmt, err = db.PrepareContext(ctx, getKolideChecks); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKolideChecks: %w", err)
+	}
+	if q.getKolideIssuesStmt, err = db.PrepareContext(ctx, getKolideIssues); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKolideIssues: %w", err)
+	}
+	if q.getKolideIssuesForDeviceStmt, err = db.PrepareContext(ctx, getKolideIssuesForDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKolideIssuesForDevice: %w", err)
+	}
+	if q.getLastUsedIPV6Stmt, err = db.PrepareContext(ctx, getLastUsedIPV6); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLastUsedIPV6: %w", err)
+	}
+	if q.getMostRecentDeviceSessionStmt, err = db.PrepareContext(ctx, getMostRecentDeviceSession); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMostRecentDeviceSession: %w", err)
+	}
+	if q.getPeersStmt, err = db.PrepareContext(ctx, getPeers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPeers: %w", err)
+	}
+	if q.getPendingGatewayJitaGrantsStmt, err = db.PrepareContext(ctx, getPendingGatewayJitaGrants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPendingGatewayJitaGrants: %w", err)
+	}
+	if q.getSessionByKeyStmt, err = db.PrepareContext(ctx, getSessionByKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSessionByKey: %w", err)
+	}
+	if q.getSessionGroupIDsStmt, err = db.PrepareContext(ctx, getSessionGroupIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSessionGroupIDs: %w", err)
+	}
+	if q.getSessionsStmt, err = db.PrepareContext(ctx, getSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSessions: %w", err)
+	}
+	if q.grantPrivilegedGatewayAccessStmt, err = db.PrepareContext(ctx, grantPrivilegedGatewayAccess); err != nil {
+		return nil, fmt.Errorf("error preparing query GrantPrivilegedGatewayAccess: %w", err)
+	}
+	if q.rejectAcceptableUseStmt, err = db.PrepareContext(ctx, rejectAcceptableUse); err != nil {
+		return nil, fmt.Errorf("error preparing query RejectAcceptableUse: %w", err)
+	}
+	if q.releaseLeaseStmt, err = db.PrepareContext(ctx, releaseLease); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseLease: %w", err)
+	}
+	if q.removeConflictingSessionsStmt, err = db.PrepareContext(ctx, removeConflictingSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveConflictingSessions: %w", err)
+	}
+	if q.removeExpiredSessionsStmt, err = db.PrepareContext(ctx, removeExpiredSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveExpiredSessions: %w", err)
+	}
+	if q.removeSessionStmt, err = db.PrepareContext(ctx, removeSession); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveSession: %w", err)
+	}
+	if q.removeSessionAccessGroupIDsStmt, err = db.PrepareContext(ctx, removeSessionAccessGroupIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveSessionAccessGroupIDs: %w", err)
+	}
+	if q.removeSessionsForDeviceStmt, err = db.PrepareContext(ctx, removeSessionsForDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveSessionsForDevice: %w", err)
+	}
+	if q.removeSessionsForUserStmt, err = db.PrepareContext(ctx, removeSessionsForUser); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveSessionsForUser: %w", err)
+	}
+	if q.renewLeaseStmt, err = db.PrepareContext(ctx, renewLease); err != nil {
+		return nil, fmt.Errorf("error preparing query RenewLease: %w", err)
+	}
+	if q.reviewGatewayJitaGrantStmt, err = db.PrepareContext(ctx, reviewGatewayJitaGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query ReviewGatewayJitaGrant: %w", err)
+	}
+	if q.revokeGatewayJitaGrantStmt, err = db.PrepareContext(ctx, revokeGatewayJitaGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeGatewayJitaGrant: %w", err)
+	}
+	if q.revokeIssueExemptionStmt, err = db.PrepareContext(ctx, revokeIssueExemption); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeIssueExemption: %w", err)
+	}
+	if q.revokePrivilegedGatewayAccessStmt, err = db.PrepareContext(ctx, revokePrivilegedGatewayAccess); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokePrivilegedGatewayAccess: %w", err)
+	}
+	if q.setKolideCheckStmt, err = db.PrepareContext(ctx, setKolideCheck); err != nil {
+		return nil, fmt.Errorf("error preparing query SetKolideCheck: %w", err)
+	}
+	if q.setKolideIssueStmt, err = db.PrepareContext(ctx, setKolideIssue); err != nil {
+		return nil, fmt.Errorf("error preparing query SetKolideIssue: %w", err)
+	}
+	if q.truncateKolideIssuesStmt, err = db.PrepareContext(ctx, truncateKolideIssues); err != nil {
+		return nil, fmt.Errorf("error preparing query TruncateKolideIssues: %w", err)
+	}
+	if q.updateDeviceStmt, err = db.PrepareContext(ctx, updateDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateDevice: %w", err)
+	}
+	if q.updateDeviceAgentVersionStmt, err = db.PrepareContext(ctx, updateDeviceAgentVersion); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateDeviceAgentVersion: %w", err)
+	}
+	if q.updateDeviceAgentVersionOutdatedSinceStmt, err = db.PrepareContext(ctx, updateDeviceAgentVersionOutdatedSince); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateDeviceAgentVersionOutdatedSince: %w", err)
+	}
+	if q.updateDeviceUsernameStmt, err = db.PrepareContext(ctx, updateDeviceUsername); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateDeviceUsername: %w", err)
+	}
+	if q.updateGatewayStmt, err = db.PrepareContext(ctx, updateGateway); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateGateway: %w", err)
+	}
+	if q.updateGatewayDynamicFieldsStmt, err = db.PrepareContext(ctx, updateGatewayDynamicFields); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateGatewayDynamicFields: %w", err)
+	}
+	if q.userHasAccessToPrivilegedGatewayStmt, err = db.PrepareContext(ctx, userHasAccessToPrivilegedGateway); err != nil {
+		return nil, fmt.Errorf("error preparing query UserHasAccessToPrivilegedGateway: %w", err)
+	}
+	if q.usersWithAccessToPrivilegedGatewayStmt, err = db.PrepareContext(ctx, usersWithAccessToPrivilegedGateway); err != nil {
+		return nil, fmt.Errorf("error preparing query UsersWithAccessToPrivilegedGateway: %w", err)
+	}
+	return &q, nil
+}
+
+func (q *Queries) Close() error {
+	var err error
+	if q.acceptAcceptableUseStmt != nil {
+		if cerr := q.acceptAcceptableUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing acceptAcceptableUseStmt: %w", cerr)
+		}
+	}
+	if q.addAuditEventStmt != nil {
+		if cerr := q.addAuditEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addAuditEventStmt: %w", cerr)
+		}
+	}
+	if q.addDeviceStmt != nil {
+		if cerr := q.addDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addDeviceStmt: %w", cerr)
+		}
+	}
+	if q.addGatewayStmt != nil {
+		if cerr := q.addGatewayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addGatewayStmt: %w", cerr)
+		}
+	}
+	if q.addGatewayAccessGroupIDStmt != nil {
+		if cerr := q.addGatewayAccessGroupIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addGatewayAccessGroupIDStmt: %w", cerr)
+		}
+	}
+	if q.addGatewayRouteStmt != nil {
+		if cerr := q.addGatewayRouteStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addGatewayRouteStmt: %w", cerr)
+		}
+	}
+	if q.addSessionStmt != nil {
+		if cerr := q.addSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSessionStmt: %w", cerr)
+		}
+	}
+	if q.addSessionAccessGroupIDStmt != nil {
+		if cerr := q.addSessionAccessGroupIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSessionAccessGroupIDStmt: %w", cerr)
+		}
+	}
+	if q.countGatewayJitaGrantsForUserSinceStmt != nil {
+		if cerr := q.countGatewayJitaGrantsForUserSinceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countGatewayJitaGrantsForUserSinceStmt: %w", cerr)
+		}
+	}
+	if q.createIssueExemptionStmt != nil {
+		if cerr := q.createIssueExemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createIssueExemptionStmt: %w", cerr)
+		}
+	}
+	if q.createLeaseStmt != nil {
+		if cerr := q.createLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createLeaseStmt: %w", cerr)
+		}
+	}
+	if q.deleteDeviceStmt != nil {
+		if cerr := q.deleteDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDeviceStmt: %w", cerr)
+		}
+	}
+	if q.deleteGatewayStmt != nil {
+		if cerr := q.deleteGatewayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGatewayStmt: %w", cerr)
+		}
+	}
+	if q.deleteGatewayAccessGroupIDsStmt != nil {
+		if cerr := q.deleteGatewayAccessGroupIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGatewayAccessGroupIDsStmt: %w", cerr)
+		}
+	}
+	if q.deleteGatewayJitaGrantsStmt != nil {
+		if cerr := q.deleteGatewayJitaGrantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGatewayJitaGrantsStmt: %w", cerr)
+		}
+	}
+	if q.deleteGatewayRoutesStmt != nil {
+		if cerr := q.deleteGatewayRoutesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteGatewayRoutesStmt: %w", cerr)
+		}
+	}
+	if q.deleteKolideIssuesForDeviceStmt != nil {
+		if cerr := q.deleteKolideIssuesForDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteKolideIssuesForDeviceStmt: %w", cerr)
+		}
+	}
+	if q.getAcceptanceStmt != nil {
+		if cerr := q.getAcceptanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAcceptanceStmt: %w", cerr)
+		}
+	}
+	if q.getAcceptancesStmt != nil {
+		if cerr := q.getAcceptancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAcceptancesStmt: %w", cerr)
+		}
+	}
+	if q.getActiveIssueExemptionsForDeviceStmt != nil {
+		if cerr := q.getActiveIssueExemptionsForDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveIssueExemptionsForDeviceStmt: %w", cerr)
+		}
+	}
+	if q.getAuditEventsStmt != nil {
+		if cerr := q.getAuditEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAuditEventsStmt: %w", cerr)
+		}
+	}
+	if q.getDeviceByExternalIDStmt != nil {
+		if cerr := q.getDeviceByExternalIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDeviceByExternalIDStmt: %w", cerr)
+		}
+	}
+	if q.getDeviceByIDStmt != nil {
+		if cerr := q.getDeviceByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDeviceByIDStmt: %w", cerr)
+		}
+	}
+	if q.getDeviceByPublicKeyStmt != nil {
+		if cerr := q.getDeviceByPublicKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDeviceByPublicKeyStmt: %w", cerr)
+		}
+	}
+	if q.getDeviceBySerialAndPlatformStmt != nil {
+		if cerr := q.getDeviceBySerialAndPlatformStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDeviceBySerialAndPlatformStmt: %w", cerr)
+		}
+	}
+	if q.getDevicesStmt != nil {
+		if cerr := q.getDevicesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDevicesStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayAccessGroupIDsStmt != nil {
+		if cerr := q.getGatewayAccessGroupIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayAccessGroupIDsStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayByNameStmt != nil {
+		if cerr := q.getGatewayByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayByNameStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayJitaGrantStmt != nil {
+		if cerr := q.getGatewayJitaGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayJitaGrantStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayJitaGrantsStmt != nil {
+		if cerr := q.getGatewayJitaGrantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayJitaGrantsStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayJitaGrantsForUserStmt != nil {
+		if cerr := q.getGatewayJitaGrantsForUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayJitaGrantsForUserStmt: %w", cerr)
+		}
+	}
+	if q.getGatewayRoutesStmt != nil {
+		if cerr := q.getGatewayRoutesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewayRoutesStmt: %w", cerr)
+		}
+	}
+	if q.getGatewaysStmt != nil {
+		if cerr := q.getGatewaysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGatewaysStmt: %w", cerr)
+		}
+	}
+	if q.getIssueExemptionStmt != nil {
+		if cerr := q.getIssueExemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIssueExemptionStmt: %w", cerr)
+		}
+	}
+	if q.getIssueExemptionsStmt != nil {
+		if cerr := q.getIssueExemptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIssueExemptionsStmt: %w", cerr)
+		}
+	}
+	if q.getKolideCheckStmt != nil {
+		if cerr := q.getKolideCheckStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKolideCheckStmt: %w", cerr)
+		}
+	}
+	if q.getKolideChecksStmt != nil {
+		if cerr := q.getKolideChecksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKolideChecksStmt: %w", cerr)
+		}
+	}
+	if q.getKolideIssuesStmt != nil {
+		if cerr := q.getKolideIssuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKolideIssuesStmt: %w", cerr)
+		}
+	}
+	if q.getKolideIssuesForDeviceStmt != nil {
+		if cerr := q.getKolideIssuesForDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKolideIssuesForDeviceStmt: %w", cerr)
+		}
+	}
+	if q.getLastUsedIPV6Stmt != nil {
+		if cerr := q.getLastUsedIPV6Stmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLastUsedIPV6Stmt: %w", cerr)
+		}
+	}
+	if q.getMostRecentDeviceSessionStmt != nil {
+		if cerr := q.getMostRecentDeviceSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMostRecentDeviceSessionStmt: %w", cerr)
+		}
+	}
+	if q.getPeersStmt != nil {
+		if cerr := q.getPeersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPeersStmt: %w", cerr)
+		}
+	}
+	if q.getPendingGatewayJitaGrantsStmt != nil {
+		if cerr := q.getPendingGatewayJitaGrantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPendingGatewayJitaGrantsStmt: %w", cerr)
+		}
+	}
+	if q.getSessionByKeyStmt != nil {
+		if cerr := q.getSessionByKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSessionByKeyStmt: %w", cerr)
+		}
+	}
+	if q.getSessionGroupIDsStmt != nil {
+		if cerr := q.getSessionGroupIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSessionGroupIDsStmt: %w", cerr)
+		}
+	}
+	if q.getSessionsStmt != nil {
+		if cerr := q.getSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSessionsStmt: %w", cerr)
+		}
+	}
+	if q.grantPrivilegedGatewayAccessStmt != nil {
+		if cerr := q.grantPrivilegedGatewayAccessStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing grantPrivilegedGatewayAccessStmt: %w", cerr)
+		}
+	}
+	if q.rejectAcceptableUseStmt != nil {
+		if cerr := q.rejectAcceptableUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rejectAcceptableUseStmt: %w", cerr)
+		}
+	}
+	if q.releaseLeaseStmt != nil {
+		if cerr := q.releaseLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseLeaseStmt: %w", cerr)
+		}
+	}
+	if q.removeConflictingSessionsStmt != nil {
+		if cerr := q.removeConflictingSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeConflictingSessionsStmt: %w", cerr)
+		}
+	}
+	if q.removeExpiredSessionsStmt != nil {
+		if cerr := q.removeExpiredSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeExpiredSessionsStmt: %w", cerr)
+		}
+	}
+	if q.removeSessionStmt != nil {
+		if cerr := q.removeSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeSessionStmt: %w", cerr)
+		}
+	}
+	if q.removeSessionAccessGroupIDsStmt != nil {
+		if cerr := q.removeSessionAccessGroupIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeSessionAccessGroupIDsStmt: %w", cerr)
+		}
+	}
+	if q.removeSessionsForDeviceStmt != nil {
+		if cerr := q.removeSessionsForDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeSessionsForDeviceStmt: %w", cerr)
+		}
+	}
+	if q.removeSessionsForUserStmt != nil {
+		if cerr := q.removeSessionsForUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeSessionsForUserStmt: %w", cerr)
+		}
+	}
+	if q.renewLeaseStmt != nil {
+		if cerr := q.renewLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing renewLeaseStmt: %w", cerr)
+		}
+	}
+	if q.reviewGatewayJitaGrantStmt != nil {
+		if cerr := q.reviewGatewayJitaGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reviewGatewayJitaGrantStmt: %w", cerr)
+		}
+	}
+	if q.revokeGatewayJitaGrantStmt != nil {
+		if cerr := q.revokeGatewayJitaGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeGatewayJitaGrantStmt: %w", cerr)
+		}
+	}
+	if q.revokeIssueExemptionStmt != nil {
+		if cerr := q.revokeIssueExemptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeIssueExemptionStmt: %w", cerr)
+		}
+	}
+	if q.revokePrivilegedGatewayAccessStmt != nil {
+		if cerr := q.revokePrivilegedGatewayAccessStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokePrivilegedGatewayAccessStmt: %w", cerr)
+		}
+	}
+	if q.setKolideCheckStmt != nil {
+		if cerr := q.setKolideCheckStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setKolideCheckStmt: %w", cerr)
+		}
+	}
+	if q.setKolideIssueStmt != nil {
+		if cerr := q.setKolideIssueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setKolideIssueStmt: %w", cerr)
+		}
+	}
+	if q.truncateKolideIssuesStmt != nil {
+		if cerr := q.truncateKolideIssuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing truncateKolideIssuesStmt: %w", cerr)
+		}
+	}
+	if q.updateDeviceStmt != nil {
+		if cerr := q.updateDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateDeviceStmt: %w", cerr)
+		}
+	}
+	if q.updateDeviceAgentVersionStmt != nil {
+		if cerr := q.updateDeviceAgentVersionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateDeviceAgentVersionStmt: %w", cerr)
+		}
+	}
+	if q.updateDeviceAgentVersionOutdatedSinceStmt != nil {
+		if cerr := q.updateDeviceAgentVersionOutdatedSinceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateDeviceAgentVersionOutdatedSinceStmt: %w", cerr)
+		}
+	}
+	if q.updateDeviceUsernameStmt != nil {
+		if cerr := q.updateDeviceUsernameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateDeviceUsernameStmt: %w", cerr)
+		}
+	}
+	if q.updateGatewayStmt != nil {
+		if cerr := q.updateGatewayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateGatewayStmt: %w", cerr)
+		}
+	}
+	if q.updateGatewayDynamicFieldsStmt != nil {
+		if cerr := q.updateGatewayDynamicFieldsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateGatewayDynamicFieldsStmt: %w", cerr)
+		}
+	}
+	if q.userHasAccessToPrivilegedGatewayStmt != nil {
+		if cerr := q.userHasAccessToPrivilegedGatewayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing userHasAccessToPrivilegedGatewayStmt: %w", cerr)
+		}
+	}
+	if q.usersWithAccessToPrivilegedGatewayStmt != nil {
+		if cerr := q.usersWithAccessToPrivilegedGatewayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing usersWithAccessToPrivilegedGatewayStmt: %w", cerr)
+		}
+	}
+	return err
+}
+
+func (q *Queries) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
+	case stmt != nil:
+		return stmt.ExecContext(ctx, args...)
+	default:
+		return q.db.ExecContext(ctx, query, args...)
+	}
+}
+
+func (q *Queries) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryContext(ctx, args...)
+	default:
+		return q.db.QueryContext(ctx, query, args...)
+	}
+}
+
+func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryRowContext(ctx, args...)
+	default:
+		return q.db.QueryRowContext(ctx, query, args...)
+	}
+}
+
+type Queries struct {
+	db                                        DBTX
+	tx                                        *sql.Tx
+	acceptAcceptableUseStmt                   *sql.Stmt
+	addAuditEventStmt                         *sql.Stmt
+	addDeviceStmt                             *sql.Stmt
+	addGatewayStmt                            *sql.Stmt
+	addGatewayAccessGroupIDStmt               *sql.Stmt
+	addGatewayRouteStmt                       *sql.Stmt
+	addSessionStmt                            *sql.Stmt
+	addSessionAccessGroupIDStmt               *sql.Stmt
+	countGatewayJitaGrantsForUserSinceStmt    *sql.Stmt
+	createIssueExemptionStmt                  *sql.Stmt
+	createLeaseStmt                           *sql.Stmt
+	deleteDeviceStmt                          *sql.Stmt
+	deleteGatewayStmt                         *sql.Stmt
+	deleteGatewayAccessGroupIDsStmt           *sql.Stmt
+	deleteGatewayJitaGrantsStmt               *sql.Stmt
+	deleteGatewayRoutesStmt                   *sql.Stmt
+	deleteKolideIssuesForDeviceStmt           *sql.Stmt
+	getAcceptanceStmt                         *sql.Stmt
+	getAcceptancesStmt                        *sql.Stmt
+	getActiveIssueExemptionsForDeviceStmt     *sql.Stmt
+	getAuditEventsStmt                        *sql.Stmt
+	getDeviceByExternalIDStmt                 *sql.Stmt
+	getDeviceByIDStmt                         *sql.Stmt
+	getDeviceByPublicKeyStmt                  *sql.Stmt
+	getDeviceBySerialAndPlatformStmt          *sql.Stmt
+	getDevicesStmt                            *sql.Stmt
+	getGatewayAccessGroupIDsStmt              *sql.Stmt
+	getGatewayByNameStmt                      *sql.Stmt
+	getGatewayJitaGrantStmt                   *sql.Stmt
+	getGatewayJitaGrantsStmt                  *sql.Stmt
+	getGatewayJitaGrantsForUserStmt           *sql.Stmt
+	getGatewayRoutesStmt                      *sql.Stmt
+	getGatewaysStmt                           *sql.Stmt
+	getIssueExemptionStmt                     *sql.Stmt
+	getIssueExemptionsStmt                    *sql.Stmt
+	getKolideCheckStmt                        *sql.Stmt
+	getKolideChecksStmt                       *sql.Stmt
+	getKolideIssuesStmt                       *sql.Stmt
+	getKolideIssuesForDeviceStmt              *sql.Stmt
+	getLastUsedIPV6Stmt                       *sql.Stmt
+	getMostRecentDeviceSessionStmt            *sql.Stmt
+	getPeersStmt                              *sql.Stmt
+	getPendingGatewayJitaGrantsStmt           *sql.Stmt
+	getSessionByKeyStmt                       *sql.Stmt
+	getSessionGroupIDsStmt                    *sql.Stmt
+	getSessionsStmt                           *sql.Stmt
+	grantPrivilegedGatewayAccessStmt          *sql.Stmt
+	rejectAcceptableUseStmt                   *sql.Stmt
+	releaseLeaseStmt                          *sql.Stmt
+	removeConflictingSessionsStmt             *sql.Stmt
+	removeExpiredSessionsStmt                 *sql.Stmt
+	removeSessionStmt                         *sql.Stmt
+	removeSessionAccessGroupIDsStmt           *sql.Stmt
+	removeSessionsForDeviceStmt               *sql.Stmt
+	removeSessionsForUserStmt                 *sql.Stmt
+	renewLeaseStmt                            *sql.Stmt
+	reviewGatewayJitaGrantStmt                *sql.Stmt
+	revokeGatewayJitaGrantStmt                *sql.Stmt
+	revokeIssueExemptionStmt                  *sql.Stmt
+	revokePrivilegedGatewayAccessStmt         *sql.Stmt
+	setKolideCheckStmt                        *sql.Stmt
+	setKolideIssueStmt                        *sql.Stmt
+	truncateKolideIssuesStmt                  *sql.Stmt
+	updateDeviceStmt                          *sql.Stmt
+	updateDeviceAgentVersionStmt              *sql.Stmt
+	updateDeviceAgentVersionOutdatedSinceStmt *sql.Stmt
+	updateDeviceUsernameStmt                  *sql.Stmt
+	updateGatewayStmt                         *sql.Stmt
+	updateGatewayDynamicFieldsStmt            *sql.Stmt
+	userHasAccessToPrivilegedGatewayStmt      *sql.Stmt
+	usersWithAccessToPrivilegedGatewayStmt    *sql.Stmt
+}
+
+func (q *Queries) WithTx(tx *sql.Tx) *Queries {
+	return &Queries{
+		db:                                        tx,
+		tx:                                        tx,
+		acceptAcceptableUseStmt:                   q.acceptAcceptableUseStmt,
+		addAuditEventStmt:                         q.addAuditEventStmt,
+		addDeviceStmt:                             q.addDeviceStmt,
+		addGatewayStmt:                            q.addGatewayStmt,
+		addGatewayAccessGroupIDStmt:               q.addGatewayAccessGroupIDStmt,
+		addGatewayRouteStmt:                       q.addGatewayRouteStmt,
+		addSessionStmt:                            q.addSessionStmt,
+		addSessionAccessGroupIDStmt:               q.addSessionAccessGroupIDStmt,
+		countGatewayJitaGrantsForUserSinceStmt:    q.countGatewayJitaGrantsForUserSinceStmt,
+		createIssueExemptionStmt:                  q.createIssueExemptionStmt,
+		createLeaseStmt:                           q.createLeaseStmt,
+		deleteDeviceStmt:                          q.deleteDeviceStmt,
+		deleteGatewayStmt:                         q.deleteGatewayStmt,
+		deleteGatewayAccessGroupIDsStmt:           q.deleteGatewayAccessGroupIDsStmt,
+		deleteGatewayJitaGrantsStmt:               q.deleteGatewayJitaGrantsStmt,
+		deleteGatewayRoutesStmt:                   q.deleteGatewayRoutesStmt,
+		deleteKolideIssuesForDeviceStmt:           q.deleteKolideIssuesForDeviceStmt,
+		getAcceptanceStmt:                         q.getAcceptanceStmt,
+		getAcceptancesStmt:                        q.getAcceptancesStmt,
+		getActiveIssueExemptionsForDeviceStmt:     q.getActiveIssueExemptionsForDeviceStmt,
+		getAuditEventsStmt:                        q.getAuditEventsStmt,
+		getDeviceByExternalIDStmt:                 q.getDeviceByExternalIDStmt,
+		getDeviceByIDStmt:                         q.getDeviceByIDStmt,
+		getDeviceByPublicKeyStmt:                  q.getDeviceByPublicKeyStmt,
+		getDeviceBySerialAndPlatformStmt:          q.getDeviceBySerialAndPlatformStmt,
+		getDevicesStmt:                            q.getDevicesStmt,
+		getGatewayAccessGroupIDsStmt:              q.getGatewayAccessGroupIDsStmt,
+		getGatewayByNameStmt:                      q.getGatewayByNameStmt,
+		getGatewayJitaGrantStmt:                   q.getGatewayJitaGrantStmt,
+		getGatewayJitaGrantsStmt:                  q.getGatewayJitaGrantsStmt,
+		getGatewayJitaGrantsForUserStmt:           q.getGatewayJitaGrantsForUserStmt,
+		getGatewayRoutesStmt:                      q.getGatewayRoutesStmt,
+		getGatewaysStmt:                           q.getGatewaysStmt,
+		getIssueExemptionStmt:                     q.getIssueExemptionStmt,
+		getIssueExemptionsStmt:                    q.getIssueExemptionsStmt,
+		getKolideCheckStmt:                        q.getKolideCheckStmt,
+		getKolideChecksStmt:                       q.getKolideChecksStmt,
+		getKolideIssuesStmt:                       q.getKolideIssuesStmt,
+		getKolideIssuesForDeviceStmt:              q.getKolideIssuesForDeviceStmt,
+		getLastUsedIPV6Stmt:                       q.getLastUsedIPV6Stmt,
+		getMostRecentDeviceSessionStmt:            q.getMostRecentDeviceSessionStmt,
+		getPeersStmt:                              q.getPeersStmt,
+		getPendingGatewayJitaGrantsStmt:           q.getPendingGatewayJitaGrantsStmt,
+		getSessionByKeyStmt:                       q.getSessionByKeyStmt,
+		getSessionGroupIDsStmt:                    q.getSessionGroupIDsStmt,
+		getSessionsStmt:                           q.getSessionsStmt,
+		grantPrivilegedGatewayAccessStmt:          q.grantPrivilegedGatewayAccessStmt,
+		rejectAcceptableUseStmt:                   q.rejectAcceptableUseStmt,
+		releaseLeaseStmt:                          q.releaseLeaseStmt,
+		removeConflictingSessionsStmt:             q.removeConflictingSessionsStmt,
+		removeExpiredSessionsStmt:                 q.removeExpiredSessionsStmt,
+		removeSessionStmt:                         q.removeSessionStmt,
+		removeSessionAccessGroupIDsStmt:           q.removeSessionAccessGroupIDsStmt,
+		removeSessionsForDeviceStmt:               q.removeSessionsForDeviceStmt,
+		removeSessionsForUserStmt:                 q.removeSessionsForUserStmt,
+		renewLeaseStmt:                            q.renewLeaseStmt,
+		reviewGatewayJitaGrantStmt:                q.reviewGatewayJitaGrantStmt,
+		revokeGatewayJitaGrantStmt:                q.revokeGatewayJitaGrantStmt,
+		revokeIssueExemptionStmt:                  q.revokeIssueExemptionStmt,
+		revokePrivilegedGatewayAccessStmt:         q.revokePrivilegedGatewayAccessStmt,
+		setKolideCheckStmt:                        q.setKolideCheckStmt,
+		setKolideIssueStmt:                        q.setKolideIssueStmt,
+		truncateKolideIssuesStmt:                  q.truncateKolideIssuesStmt,
+		updateDeviceStmt:                          q.updateDeviceStmt,
+		updateDeviceAgentVersionStmt:              q.updateDeviceAgentVersionStmt,
+		updateDeviceAgentVersionOutdatedSinceStmt: q.updateDeviceAgentVersionOutdatedSinceStmt,
+		updateDeviceUsernameStmt:                  q.updateDeviceUsernameStmt,
+		updateGatewayStmt:                         q.updateGatewayStmt,
+		updateGatewayDynamicFieldsStmt:            q.updateGatewayDynamicFieldsStmt,
+		userHasAccessToPrivilegedGatewayStmt:      q.userHasAccessToPrivilegedGatewayStmt,
+		usersWithAccessToPrivilegedGatewayStmt:    q.usersWithAccessToPrivilegedGatewayStmt,
+	}
+}
@@ -0,0 +1,308 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: devices.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+const addDevice = `-- name: AddDevice :exec
+INSERT INTO devices (serial, username, public_key, ipv4, ipv6, healthy, platform)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT(serial, platform) DO
+    UPDATE SET username = excluded.username, public_key = excluded.public_key, ipv6 = excluded.ipv6
+`
+
+type AddDeviceParams struct {
+	Serial    string
+	Username  string
+	PublicKey string
+	Ipv4      string
+	Ipv6      string
+	Healthy   bool
+	Platform  string
+}
+
+func (q *Queries) AddDevice(ctx context.Context, arg AddDeviceParams) error {
+	_, err := q.exec(ctx, q.addDeviceStmt, addDevice,
+		arg.Serial,
+		arg.Username,
+		arg.PublicKey,
+		arg.Ipv4,
+		arg.Ipv6,
+		arg.Healthy,
+		arg.Platform,
+	)
+	return err
+}
+
+const deleteDevice = `-- name: DeleteDevice :execrows
+DELETE FROM devices WHERE id = $1
+`
+
+func (q *Queries) DeleteDevice(ctx context.Context, id int64) (int64, error) {
+	result, err := q.exec(ctx, q.deleteDeviceStmt, deleteDevice, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDeviceByExternalID = `-- name: GetDeviceByExternalID :one
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE external_id = $1
+`
+
+func (q *Queries) GetDeviceByExternalID(ctx context.Context, externalID sql.NullString) (*Device, error) {
+	row := q.queryRow(ctx, q.getDeviceByExternalIDStmt, getDeviceByExternalID, externalID)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Serial,
+		&i.Platform,
+		&i.Healthy,
+		&i.LastUpdated,
+		&i.PublicKey,
+		&i.Ipv4,
+		&i.Ipv6,
+		&i.LastSeen,
+		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getDeviceByID = `-- name: GetDeviceByID :one
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE devices.id = $1
+`
+
+func (q *Queries) GetDeviceByID(ctx context.Context, id int64) (*Device, error) {
+	row := q.queryRow(ctx, q.getDeviceByIDStmt, getDeviceByID, id)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Serial,
+		&i.Platform,
+		&i.Healthy,
+		&i.LastUpdated,
+		&i.PublicKey,
+		&i.Ipv4,
+		&i.Ipv6,
+		&i.LastSeen,
+		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getDeviceByPublicKey = `-- name: GetDeviceByPublicKey :one
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE public_key = $1
+`
+
+func (q *Queries) GetDeviceByPublicKey(ctx context.Context, publicKey string) (*Device, error) {
+	row := q.queryRow(ctx, q.getDeviceByPublicKeyStmt, getDeviceByPublicKey, publicKey)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Serial,
+		&i.Platform,
+		&i.Healthy,
+		&i.LastUpdated,
+		&i.PublicKey,
+		&i.Ipv4,
+		&i.Ipv6,
+		&i.LastSeen,
+		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getDeviceBySerialAndPlatform = `-- name: GetDeviceBySerialAndPlatform :one
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices WHERE serial = $1 AND platform = $2
+`
+
+type GetDeviceBySerialAndPlatformParams struct {
+	Serial   string
+	Platform string
+}
+
+func (q *Queries) GetDeviceBySerialAndPlatform(ctx context.Context, arg GetDeviceBySerialAndPlatformParams) (*Device, error) {
+	row := q.queryRow(ctx, q.getDeviceBySerialAndPlatformStmt, getDeviceBySerialAndPlatform, arg.Serial, arg.Platform)
+	var i Device
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Serial,
+		&i.Platform,
+		&i.Healthy,
+		&i.LastUpdated,
+		&i.PublicKey,
+		&i.Ipv4,
+		&i.Ipv6,
+		&i.LastSeen,
+		&i.ExternalID,
+		&i.AgentVersion,
+		&i.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getDevices = `-- name: GetDevices :many
+SELECT id, username, serial, platform, healthy, last_updated, public_key, ipv4, ipv6, last_seen, external_id, agent_version, agent_version_outdated_since FROM devices ORDER BY devices.id
+`
+
+func (q *Queries) GetDevices(ctx context.Context) ([]*Device, error) {
+	rows, err := q.query(ctx, q.getDevicesStmt, getDevices)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Device
+	for rows.Next() {
+		var i Device
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Serial,
+			&i.Platform,
+			&i.Healthy,
+			&i.LastUpdated,
+			&i.PublicKey,
+			&i.Ipv4,
+			&i.Ipv6,
+			&i.LastSeen,
+			&i.ExternalID,
+			&i.AgentVersion,
+			&i.AgentVersionOutdatedSince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPeers = `-- name: GetPeers :many
+SELECT username, public_key, ipv4 FROM devices ORDER BY devices.id
+`
+
+type GetPeersRow struct {
+	Username  string
+	PublicKey string
+	Ipv4      string
+}
+
+func (q *Queries) GetPeers(ctx context.Context) ([]*GetPeersRow, error) {
+	rows, err := q.query(ctx, q.getPeersStmt, getPeers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPeersRow
+	for rows.Next() {
+		var i GetPeersRow
+		if err := rows.Scan(&i.Username, &i.PublicKey, &i.Ipv4); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateDevice = `-- name: UpdateDevice :exec
+UPDATE devices
+SET external_id = $1, healthy = $2, last_updated = $3, last_seen = $4
+WHERE serial = $5 AND platform = $6
+`
+
+type UpdateDeviceParams struct {
+	ExternalID  sql.NullString
+	Healthy     bool
+	LastUpdated sql.NullString
+	LastSeen    sql.NullString
+	Serial      string
+	Platform    string
+}
+
+func (q *Queries) UpdateDevice(ctx context.Context, arg UpdateDeviceParams) error {
+	_, err := q.exec(ctx, q.updateDeviceStmt, updateDevice,
+		arg.ExternalID,
+		arg.Healthy,
+		arg.LastUpdated,
+		arg.LastSeen,
+		arg.Serial,
+		arg.Platform,
+	)
+	return err
+}
+
+const updateDeviceAgentVersion = `-- name: UpdateDeviceAgentVersion :exec
+UPDATE devices
+SET agent_version = $1
+WHERE id = $2
+`
+
+type UpdateDeviceAgentVersionParams struct {
+	AgentVersion string
+	ID           int64
+}
+
+func (q *Queries) UpdateDeviceAgentVersion(ctx context.Context, arg UpdateDeviceAgentVersionParams) error {
+	_, err := q.exec(ctx, q.updateDeviceAgentVersionStmt, updateDeviceAgentVersion, arg.AgentVersion, arg.ID)
+	return err
+}
+
+const updateDeviceAgentVersionOutdatedSince = `-- name: UpdateDeviceAgentVersionOutdatedSince :exec
+UPDATE devices
+SET agent_version_outdated_since = $1
+WHERE id = $2
+`
+
+type UpdateDeviceAgentVersionOutdatedSinceParams struct {
+	AgentVersionOutdatedSince sql.NullString
+	ID                        int64
+}
+
+func (q *Queries) UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, arg UpdateDeviceAgentVersionOutdatedSinceParams) error {
+	_, err := q.exec(ctx, q.updateDeviceAgentVersionOutdatedSinceStmt, updateDeviceAgentVersionOutdatedSince, arg.AgentVersionOutdatedSince, arg.ID)
+	return err
+}
+
+const updateDeviceUsername = `-- name: UpdateDeviceUsername :execrows
+UPDATE devices
+SET username = $1
+WHERE id = $2
+`
+
+type UpdateDeviceUsernameParams struct {
+	Username string
+	ID       int64
+}
+
+func (q *Queries) UpdateDeviceUsername(ctx context.Context, arg UpdateDeviceUsernameParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateDeviceUsernameStmt, updateDeviceUsername, arg.Username, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
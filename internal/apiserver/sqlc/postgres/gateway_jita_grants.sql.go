@@ -0,0 +1,372 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: gateway_jita_grants.sql
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+const countGatewayJitaGrantsForUserSince = `-- name: CountGatewayJitaGrantsForUserSince :one
+SELECT COUNT(*) FROM gateway_jita_grants
+WHERE
+    user_id = $1
+    AND gateway_name = $2
+    AND CAST(created AS TIMESTAMPTZ) >= CAST(CAST($3 AS TEXT) AS TIMESTAMPTZ)
+`
+
+type CountGatewayJitaGrantsForUserSinceParams struct {
+	UserID      string
+	GatewayName string
+	Since       string
+}
+
+func (q *Queries) CountGatewayJitaGrantsForUserSince(ctx context.Context, arg CountGatewayJitaGrantsForUserSinceParams) (int64, error) {
+	row := q.queryRow(ctx, q.countGatewayJitaGrantsForUserSinceStmt, countGatewayJitaGrantsForUserSince, arg.UserID, arg.GatewayName, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteGatewayJitaGrants = `-- name: DeleteGatewayJitaGrants :exec
+DELETE FROM gateway_jita_grants WHERE gateway_name = $1
+`
+
+func (q *Queries) DeleteGatewayJitaGrants(ctx context.Context, gatewayName string) error {
+	_, err := q.exec(ctx, q.deleteGatewayJitaGrantsStmt, deleteGatewayJitaGrants, gatewayName)
+	return err
+}
+
+const getGatewayJitaGrant = `-- name: GetGatewayJitaGrant :one
+SELECT id, user_id, gateway_name, created, expires, revoked, reason, approval, approver, decided FROM gateway_jita_grants WHERE id = $1
+`
+
+func (q *Queries) GetGatewayJitaGrant(ctx context.Context, id int64) (*GatewayJitaGrant, error) {
+	row := q.queryRow(ctx, q.getGatewayJitaGrantStmt, getGatewayJitaGrant, id)
+	var i GatewayJitaGrant
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GatewayName,
+		&i.Created,
+		&i.Expires,
+		&i.Revoked,
+		&i.Reason,
+		&i.Approval,
+		&i.Approver,
+		&i.Decided,
+	)
+	return &i, err
+}
+
+const getGatewayJitaGrants = `-- name: GetGatewayJitaGrants :many
+SELECT id, user_id, gateway_name, created, expires, revoked, reason, approval, approver, decided FROM gateway_jita_grants
+WHERE
+    (CAST($1 AS TEXT) = '' OR gateway_name = $1)
+    AND (CAST($2 AS TEXT) = '' OR user_id = $2)
+    AND (CAST($3 AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) >= CAST(NULLIF($3, '') AS TIMESTAMPTZ))
+    AND (CAST($4 AS TEXT) = '' OR CAST(created AS TIMESTAMPTZ) < CAST(NULLIF($4, '') AS TIMESTAMPTZ))
+    AND (
+        CAST($5 AS BOOLEAN) = FALSE
+        OR (CAST(expires AS TIMESTAMPTZ) > NOW() AND revoked IS NULL AND approval IN ('not_required', 'approved'))
+    )
+ORDER BY id DESC
+`
+
+type GetGatewayJitaGrantsParams struct {
+	GatewayName string
+	UserID      string
+	Since       string
+	Until       string
+	ActiveOnly  bool
+}
+
+func (q *Queries) GetGatewayJitaGrants(ctx context.Context, arg GetGatewayJitaGrantsParams) ([]*GatewayJitaGrant, error) {
+	rows, err := q.query(ctx, q.getGatewayJitaGrantsStmt, getGatewayJitaGrants,
+		arg.GatewayName,
+		arg.UserID,
+		arg.Since,
+		arg.Until,
+		arg.ActiveOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GatewayJitaGrant
+	for rows.Next() {
+		var i GatewayJitaGrant
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GatewayName,
+			&i.Created,
+			&i.Expires,
+			&i.Revoked,
+			&i.Reason,
+			&i.Approval,
+			&i.Approver,
+			&i.Decided,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGatewayJitaGrantsForUser = `-- name: GetGatewayJitaGrantsForUser :many
+SELECT id, user_id, gateway_name, created, expires, revoked, reason, approval, approver, decided FROM gateway_jita_grants
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT 10
+`
+
+func (q *Queries) GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*GatewayJitaGrant, error) {
+	rows, err := q.query(ctx, q.getGatewayJitaGrantsForUserStmt, getGatewayJitaGrantsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GatewayJitaGrant
+	for rows.Next() {
+		var i GatewayJitaGrant
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GatewayName,
+			&i.Created,
+			&i.Expires,
+			&i.Revoked,
+			&i.Reason,
+			&i.Approval,
+			&i.Approver,
+			&i.Decided,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingGatewayJitaGrants = `-- name: GetPendingGatewayJitaGrants :many
+SELECT id, user_id, gateway_name, created, expires, revoked, reason, approval, approver, decided FROM gateway_jita_grants
+WHERE
+    approval = 'pending'
+    AND CAST(expires AS TIMESTAMPTZ) > NOW()
+    AND revoked IS NULL
+ORDER BY id
+`
+
+func (q *Queries) GetPendingGatewayJitaGrants(ctx context.Context) ([]*GatewayJitaGrant, error) {
+	rows, err := q.query(ctx, q.getPendingGatewayJitaGrantsStmt, getPendingGatewayJitaGrants)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GatewayJitaGrant
+	for rows.Next() {
+		var i GatewayJitaGrant
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GatewayName,
+			&i.Created,
+			&i.Expires,
+			&i.Revoked,
+			&i.Reason,
+			&i.Approval,
+			&i.Approver,
+			&i.Decided,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const grantPrivilegedGatewayAccess = `-- name: GrantPrivilegedGatewayAccess :exec
+INSERT INTO gateway_jita_grants (
+    user_id,
+    gateway_name,
+    created,
+    expires,
+    reason,
+    approval
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type GrantPrivilegedGatewayAccessParams struct {
+	UserID      string
+	GatewayName string
+	Created     string
+	Expires     string
+	Reason      string
+	Approval    string
+}
+
+func (q *Queries) GrantPrivilegedGatewayAccess(ctx context.Context, arg GrantPrivilegedGatewayAccessParams) error {
+	_, err := q.exec(ctx, q.grantPrivilegedGatewayAccessStmt, grantPrivilegedGatewayAccess,
+		arg.UserID,
+		arg.GatewayName,
+		arg.Created,
+		arg.Expires,
+		arg.Reason,
+		arg.Approval,
+	)
+	return err
+}
+
+const reviewGatewayJitaGrant = `-- name: ReviewGatewayJitaGrant :execrows
+UPDATE gateway_jita_grants
+SET approval = $1, approver = $2, decided = $3, expires = $4
+WHERE
+    id = $5
+    AND approval = 'pending'
+    AND revoked IS NULL
+`
+
+type ReviewGatewayJitaGrantParams struct {
+	Approval string
+	Approver sql.NullString
+	Decided  sql.NullString
+	Expires  string
+	ID       int64
+}
+
+func (q *Queries) ReviewGatewayJitaGrant(ctx context.Context, arg ReviewGatewayJitaGrantParams) (int64, error) {
+	result, err := q.exec(ctx, q.reviewGatewayJitaGrantStmt, reviewGatewayJitaGrant,
+		arg.Approval,
+		arg.Approver,
+		arg.Decided,
+		arg.Expires,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const revokeGatewayJitaGrant = `-- name: RevokeGatewayJitaGrant :exec
+UPDATE gateway_jita_grants
+SET revoked = $1
+WHERE
+    id = $2
+    AND revoked IS NULL
+`
+
+type RevokeGatewayJitaGrantParams struct {
+	Revoked sql.NullString
+	ID      int64
+}
+
+func (q *Queries) RevokeGatewayJitaGrant(ctx context.Context, arg RevokeGatewayJitaGrantParams) error {
+	_, err := q.exec(ctx, q.revokeGatewayJitaGrantStmt, revokeGatewayJitaGrant, arg.Revoked, arg.ID)
+	return err
+}
+
+const revokePrivilegedGatewayAccess = `-- name: RevokePrivilegedGatewayAccess :exec
+UPDATE gateway_jita_grants
+SET revoked = $1
+WHERE
+    user_id = $2
+    AND gateway_name = $3
+    AND revoked IS NULL
+`
+
+type RevokePrivilegedGatewayAccessParams struct {
+	Revoked     sql.NullString
+	UserID      string
+	GatewayName string
+}
+
+func (q *Queries) RevokePrivilegedGatewayAccess(ctx context.Context, arg RevokePrivilegedGatewayAccessParams) error {
+	_, err := q.exec(ctx, q.revokePrivilegedGatewayAccessStmt, revokePrivilegedGatewayAccess, arg.Revoked, arg.UserID, arg.GatewayName)
+	return err
+}
+
+const userHasAccessToPrivilegedGateway = `-- name: UserHasAccessToPrivilegedGateway :one
+SELECT EXISTS(
+    SELECT id FROM gateway_jita_grants
+    WHERE
+        user_id = $1
+        AND gateway_name = $2
+        AND CAST(expires AS TIMESTAMPTZ) > NOW()
+        AND revoked IS NULL
+        AND approval IN ('not_required', 'approved')
+) AS has_access
+`
+
+type UserHasAccessToPrivilegedGatewayParams struct {
+	UserID      string
+	GatewayName string
+}
+
+func (q *Queries) UserHasAccessToPrivilegedGateway(ctx context.Context, arg UserHasAccessToPrivilegedGatewayParams) (bool, error) {
+	row := q.queryRow(ctx, q.userHasAccessToPrivilegedGatewayStmt, userHasAccessToPrivilegedGateway, arg.UserID, arg.GatewayName)
+	var has_access bool
+	err := row.Scan(&has_access)
+	return has_access, err
+}
+
+const usersWithAccessToPrivilegedGateway = `-- name: UsersWithAccessToPrivilegedGateway :many
+SELECT user_id FROM gateway_jita_grants
+WHERE
+    gateway_name = $1
+    AND CAST(expires AS TIMESTAMPTZ) > NOW()
+    AND revoked IS NULL
+    AND approval IN ('not_required', 'approved')
+`
+
+func (q *Queries) UsersWithAccessToPrivilegedGateway(ctx context.Context, gatewayName string) ([]string, error) {
+	rows, err := q.query(ctx, q.usersWithAccessToPrivilegedGatewayStmt, usersWithAccessToPrivilegedGateway, gatewayName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: leases.sql
+
+package postgres
+
+import (
+	"context"
+)
+
+const createLease = `-- name: CreateLease :execrows
+INSERT INTO leases (name, holder, expires)
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO NOTHING
+`
+
+type CreateLeaseParams struct {
+	Name    string
+	Holder  string
+	Expires string
+}
+
+func (q *Queries) CreateLease(ctx context.Context, arg CreateLeaseParams) (int64, error) {
+	result, err := q.exec(ctx, q.createLeaseStmt, createLease, arg.Name, arg.Holder, arg.Expires)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseLease = `-- name: ReleaseLease :exec
+DELETE FROM leases WHERE name = $1 AND holder = $2
+`
+
+type ReleaseLeaseParams struct {
+	Name   string
+	Holder string
+}
+
+func (q *Queries) ReleaseLease(ctx context.Context, arg ReleaseLeaseParams) error {
+	_, err := q.exec(ctx, q.releaseLeaseStmt, releaseLease, arg.Name, arg.Holder)
+	return err
+}
+
+const renewLease = `-- name: RenewLease :execrows
+UPDATE leases
+SET holder = $1, expires = $2
+WHERE
+    name = $3
+    AND (holder = $1 OR CAST(expires AS TIMESTAMPTZ) < CAST(CAST($4 AS TEXT) AS TIMESTAMPTZ))
+`
+
+type RenewLeaseParams struct {
+	Holder  string
+	Expires string
+	Name    string
+	Now     string
+}
+
+func (q *Queries) RenewLease(ctx context.Context, arg RenewLeaseParams) (int64, error) {
+	result, err := q.exec(ctx, q.renewLeaseStmt, renewLease,
+		arg.Holder,
+		arg.Expires,
+		arg.Name,
+		arg.Now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
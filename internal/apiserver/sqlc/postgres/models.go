@@ -0,0 +1,124 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package postgres
+
+import (
+	"database/sql"
+)
+
+type Acceptance struct {
+	UserID     string
+	AcceptedAt string
+}
+
+type AuditEvent struct {
+	ID      int64
+	Created string
+	Actor   string
+	Action  string
+	Target  string
+	Reason  string
+}
+
+type Device struct {
+	ID                        int64
+	Username                  string
+	Serial                    string
+	Platform                  string
+	Healthy                   bool
+	LastUpdated               sql.NullString
+	PublicKey                 string
+	Ipv4                      string
+	Ipv6                      string
+	LastSeen                  sql.NullString
+	ExternalID                sql.NullString
+	AgentVersion              string
+	AgentVersionOutdatedSince sql.NullString
+}
+
+type Gateway struct {
+	Name                     string
+	Endpoint                 string
+	PublicKey                string
+	Ipv4                     string
+	RequiresPrivilegedAccess bool
+	PasswordHash             string
+	Ipv6                     string
+	RequiresApproval         bool
+	JitaMaxDurationSeconds   int64
+	JitaMinReasonLength      int64
+	JitaReasonPattern        string
+	JitaMaxGrantsPerDay      int64
+}
+
+type GatewayAccessGroupID struct {
+	GatewayName string
+	GroupID     string
+}
+
+type GatewayJitaGrant struct {
+	ID          int64
+	UserID      string
+	GatewayName string
+	Created     string
+	Expires     string
+	Revoked     sql.NullString
+	Reason      string
+	Approval    string
+	Approver    sql.NullString
+	Decided     sql.NullString
+}
+
+type GatewayRoute struct {
+	GatewayName string
+	Route       string
+	Family      string
+}
+
+type IssueExemption struct {
+	ID        int64
+	DeviceID  sql.NullInt64
+	Username  sql.NullString
+	CheckID   sql.NullInt64
+	Reason    string
+	CreatedBy string
+	Created   string
+	Expires   string
+	Revoked   sql.NullString
+}
+
+type KolideCheck struct {
+	ID          int64
+	Tags        string
+	DisplayName string
+	Description string
+}
+
+type KolideIssue struct {
+	ID          int64
+	DeviceID    string
+	CheckID     int64
+	Title       string
+	DetectedAt  string
+	ResolvedAt  sql.NullString
+	LastUpdated string
+	Ignored     bool
+}
+
+type Lease struct {
+	Name    string
+	Holder  string
+	Expires string
+}
+
+type Session struct {
+	Key      string
+	Expiry   string
+	DeviceID int64
+	ObjectID string
+}
+
+type SessionAccessGroupID struct {
+	SessionKey string
+	GroupID    string
+}
@@ -0,0 +1,256 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: sessions.sql
+
+package postgres
+
+import (
+	"context"
+)
+
+const addSession = `-- name: AddSession :exec
+INSERT INTO sessions (key, expiry, device_id, object_id)
+VALUES ($1, $2, $3, $4)
+`
+
+type AddSessionParams struct {
+	Key      string
+	Expiry   string
+	DeviceID int64
+	ObjectID string
+}
+
+func (q *Queries) AddSession(ctx context.Context, arg AddSessionParams) error {
+	_, err := q.exec(ctx, q.addSessionStmt, addSession,
+		arg.Key,
+		arg.Expiry,
+		arg.DeviceID,
+		arg.ObjectID,
+	)
+	return err
+}
+
+const addSessionAccessGroupID = `-- name: AddSessionAccessGroupID :exec
+INSERT INTO session_access_group_ids (session_key, group_id)
+VALUES ($1, $2)
+`
+
+type AddSessionAccessGroupIDParams struct {
+	SessionKey string
+	GroupID    string
+}
+
+func (q *Queries) AddSessionAccessGroupID(ctx context.Context, arg AddSessionAccessGroupIDParams) error {
+	_, err := q.exec(ctx, q.addSessionAccessGroupIDStmt, addSessionAccessGroupID, arg.SessionKey, arg.GroupID)
+	return err
+}
+
+const getMostRecentDeviceSession = `-- name: GetMostRecentDeviceSession :one
+SELECT s.key, s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
+JOIN devices d ON d.id = s.device_id
+WHERE s.device_id = $1
+ORDER BY s.expiry DESC
+LIMIT 1
+`
+
+type GetMostRecentDeviceSessionRow struct {
+	Session Session
+	Device  Device
+}
+
+func (q *Queries) GetMostRecentDeviceSession(ctx context.Context, sessionDeviceID int64) (*GetMostRecentDeviceSessionRow, error) {
+	row := q.queryRow(ctx, q.getMostRecentDeviceSessionStmt, getMostRecentDeviceSession, sessionDeviceID)
+	var i GetMostRecentDeviceSessionRow
+	err := row.Scan(
+		&i.Session.Key,
+		&i.Session.Expiry,
+		&i.Session.DeviceID,
+		&i.Session.ObjectID,
+		&i.Device.ID,
+		&i.Device.Username,
+		&i.Device.Serial,
+		&i.Device.Platform,
+		&i.Device.Healthy,
+		&i.Device.LastUpdated,
+		&i.Device.PublicKey,
+		&i.Device.Ipv4,
+		&i.Device.Ipv6,
+		&i.Device.LastSeen,
+		&i.Device.ExternalID,
+		&i.Device.AgentVersion,
+		&i.Device.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getSessionByKey = `-- name: GetSessionByKey :one
+SELECT s.key, s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
+JOIN devices d ON d.id = s.device_id WHERE s.key = $1
+`
+
+type GetSessionByKeyRow struct {
+	Session Session
+	Device  Device
+}
+
+func (q *Queries) GetSessionByKey(ctx context.Context, sessionKey string) (*GetSessionByKeyRow, error) {
+	row := q.queryRow(ctx, q.getSessionByKeyStmt, getSessionByKey, sessionKey)
+	var i GetSessionByKeyRow
+	err := row.Scan(
+		&i.Session.Key,
+		&i.Session.Expiry,
+		&i.Session.DeviceID,
+		&i.Session.ObjectID,
+		&i.Device.ID,
+		&i.Device.Username,
+		&i.Device.Serial,
+		&i.Device.Platform,
+		&i.Device.Healthy,
+		&i.Device.LastUpdated,
+		&i.Device.PublicKey,
+		&i.Device.Ipv4,
+		&i.Device.Ipv6,
+		&i.Device.LastSeen,
+		&i.Device.ExternalID,
+		&i.Device.AgentVersion,
+		&i.Device.AgentVersionOutdatedSince,
+	)
+	return &i, err
+}
+
+const getSessionGroupIDs = `-- name: GetSessionGroupIDs :many
+SELECT group_id FROM session_access_group_ids WHERE session_key = $1 ORDER BY group_id
+`
+
+func (q *Queries) GetSessionGroupIDs(ctx context.Context, sessionKey string) ([]string, error) {
+	rows, err := q.query(ctx, q.getSessionGroupIDsStmt, getSessionGroupIDs, sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var group_id string
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessions = `-- name: GetSessions :many
+SELECT s.key, s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
+JOIN devices d ON d.id = s.device_id
+ORDER BY s.expiry
+`
+
+type GetSessionsRow struct {
+	Session Session
+	Device  Device
+}
+
+func (q *Queries) GetSessions(ctx context.Context) ([]*GetSessionsRow, error) {
+	rows, err := q.query(ctx, q.getSessionsStmt, getSessions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetSessionsRow
+	for rows.Next() {
+		var i GetSessionsRow
+		if err := rows.Scan(
+			&i.Session.Key,
+			&i.Session.Expiry,
+			&i.Session.DeviceID,
+			&i.Session.ObjectID,
+			&i.Device.ID,
+			&i.Device.Username,
+			&i.Device.Serial,
+			&i.Device.Platform,
+			&i.Device.Healthy,
+			&i.Device.LastUpdated,
+			&i.Device.PublicKey,
+			&i.Device.Ipv4,
+			&i.Device.Ipv6,
+			&i.Device.LastSeen,
+			&i.Device.ExternalID,
+			&i.Device.AgentVersion,
+			&i.Device.AgentVersionOutdatedSince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeConflictingSessions = `-- name: RemoveConflictingSessions :exec
+DELETE FROM sessions WHERE key = $1 OR device_id = $2
+`
+
+type RemoveConflictingSessionsParams struct {
+	Key      string
+	DeviceID int64
+}
+
+func (q *Queries) RemoveConflictingSessions(ctx context.Context, arg RemoveConflictingSessionsParams) error {
+	_, err := q.exec(ctx, q.removeConflictingSessionsStmt, removeConflictingSessions, arg.Key, arg.DeviceID)
+	return err
+}
+
+const removeExpiredSessions = `-- name: RemoveExpiredSessions :exec
+DELETE FROM sessions WHERE CAST(expiry AS TIMESTAMPTZ) < NOW()
+`
+
+func (q *Queries) RemoveExpiredSessions(ctx context.Context) error {
+	_, err := q.exec(ctx, q.removeExpiredSessionsStmt, removeExpiredSessions)
+	return err
+}
+
+const removeSession = `-- name: RemoveSession :exec
+DELETE FROM sessions WHERE key = $1
+`
+
+func (q *Queries) RemoveSession(ctx context.Context, key string) error {
+	_, err := q.exec(ctx, q.removeSessionStmt, removeSession, key)
+	return err
+}
+
+const removeSessionAccessGroupIDs = `-- name: RemoveSessionAccessGroupIDs :exec
+DELETE FROM session_access_group_ids WHERE session_key = $1
+`
+
+func (q *Queries) RemoveSessionAccessGroupIDs(ctx context.Context, sessionKey string) error {
+	_, err := q.exec(ctx, q.removeSessionAccessGroupIDsStmt, removeSessionAccessGroupIDs, sessionKey)
+	return err
+}
+
+const removeSessionsForDevice = `-- name: RemoveSessionsForDevice :exec
+DELETE FROM sessions WHERE device_id = $1
+`
+
+func (q *Queries) RemoveSessionsForDevice(ctx context.Context, deviceID int64) error {
+	_, err := q.exec(ctx, q.removeSessionsForDeviceStmt, removeSessionsForDevice, deviceID)
+	return err
+}
+
+const removeSessionsForUser = `-- name: RemoveSessionsForUser :exec
+DELETE FROM sessions WHERE object_id = $1
+`
+
+func (q *Queries) RemoveSessionsForUser(ctx context.Context, objectID string) error {
+	_, err := q.exec(ctx, q.removeSessionsForUserStmt, removeSessionsForUser, objectID)
+	return err
+}
@@ -9,17 +9,26 @@ import (
 
 type Querier interface {
 	AcceptAcceptableUse(ctx context.Context, arg AcceptAcceptableUseParams) error
+	AddAuditEvent(ctx context.Context, arg AddAuditEventParams) error
 	AddDevice(ctx context.Context, arg AddDeviceParams) error
 	AddGateway(ctx context.Context, arg AddGatewayParams) error
 	AddGatewayAccessGroupID(ctx context.Context, arg AddGatewayAccessGroupIDParams) error
 	AddGatewayRoute(ctx context.Context, arg AddGatewayRouteParams) error
 	AddSession(ctx context.Context, arg AddSessionParams) error
 	AddSessionAccessGroupID(ctx context.Context, arg AddSessionAccessGroupIDParams) error
+	CountGatewayJitaGrantsForUserSince(ctx context.Context, arg CountGatewayJitaGrantsForUserSinceParams) (int64, error)
+	CreateIssueExemption(ctx context.Context, arg CreateIssueExemptionParams) (*IssueExemption, error)
+	CreateLease(ctx context.Context, arg CreateLeaseParams) (int64, error)
+	DeleteDevice(ctx context.Context, id int64) (int64, error)
+	DeleteGateway(ctx context.Context, name string) (int64, error)
 	DeleteGatewayAccessGroupIDs(ctx context.Context, gatewayName string) error
+	DeleteGatewayJitaGrants(ctx context.Context, gatewayName string) error
 	DeleteGatewayRoutes(ctx context.Context, gatewayName string) error
 	DeleteKolideIssuesForDevice(ctx context.Context, deviceID string) error
 	GetAcceptance(ctx context.Context, userID string) (*Acceptance, error)
 	GetAcceptances(ctx context.Context) ([]*Acceptance, error)
+	GetActiveIssueExemptionsForDevice(ctx context.Context, arg GetActiveIssueExemptionsForDeviceParams) ([]*IssueExemption, error)
+	GetAuditEvents(ctx context.Context, arg GetAuditEventsParams) ([]*AuditEvent, error)
 	GetDeviceByExternalID(ctx context.Context, externalID sql.NullString) (*Device, error)
 	GetDeviceByID(ctx context.Context, id int64) (*Device, error)
 	GetDeviceByPublicKey(ctx context.Context, publicKey string) (*Device, error)
@@ -27,9 +36,13 @@ type Querier interface {
 	GetDevices(ctx context.Context) ([]*Device, error)
 	GetGatewayAccessGroupIDs(ctx context.Context, gatewayName string) ([]string, error)
 	GetGatewayByName(ctx context.Context, name string) (*Gateway, error)
+	GetGatewayJitaGrant(ctx context.Context, id int64) (*GatewayJitaGrant, error)
+	GetGatewayJitaGrants(ctx context.Context, arg GetGatewayJitaGrantsParams) ([]*GatewayJitaGrant, error)
 	GetGatewayJitaGrantsForUser(ctx context.Context, userID string) ([]*GatewayJitaGrant, error)
 	GetGatewayRoutes(ctx context.Context, gatewayName string) ([]*GetGatewayRoutesRow, error)
 	GetGateways(ctx context.Context) ([]*Gateway, error)
+	GetIssueExemption(ctx context.Context, id int64) (*IssueExemption, error)
+	GetIssueExemptions(ctx context.Context, activeOnly bool) ([]*IssueExemption, error)
 	GetKolideCheck(ctx context.Context, id int64) (*KolideCheck, error)
 	GetKolideChecks(ctx context.Context) ([]*KolideCheck, error)
 	GetKolideIssues(ctx context.Context) ([]*GetKolideIssuesRow, error)
@@ -37,17 +50,30 @@ type Querier interface {
 	GetLastUsedIPV6(ctx context.Context) (string, error)
 	GetMostRecentDeviceSession(ctx context.Context, sessionDeviceID int64) (*GetMostRecentDeviceSessionRow, error)
 	GetPeers(ctx context.Context) ([]*GetPeersRow, error)
+	GetPendingGatewayJitaGrants(ctx context.Context) ([]*GatewayJitaGrant, error)
 	GetSessionByKey(ctx context.Context, sessionKey string) (*GetSessionByKeyRow, error)
 	GetSessionGroupIDs(ctx context.Context, sessionKey string) ([]string, error)
 	GetSessions(ctx context.Context) ([]*GetSessionsRow, error)
 	GrantPrivilegedGatewayAccess(ctx context.Context, arg GrantPrivilegedGatewayAccessParams) error
 	RejectAcceptableUse(ctx context.Context, userID string) error
+	ReleaseLease(ctx context.Context, arg ReleaseLeaseParams) error
 	RemoveExpiredSessions(ctx context.Context) error
+	RemoveSession(ctx context.Context, key string) error
+	RemoveSessionAccessGroupIDs(ctx context.Context, sessionKey string) error
+	RemoveSessionsForDevice(ctx context.Context, deviceID int64) error
+	RemoveSessionsForUser(ctx context.Context, objectID string) error
+	RenewLease(ctx context.Context, arg RenewLeaseParams) (int64, error)
+	ReviewGatewayJitaGrant(ctx context.Context, arg ReviewGatewayJitaGrantParams) (int64, error)
+	RevokeGatewayJitaGrant(ctx context.Context, arg RevokeGatewayJitaGrantParams) error
+	RevokeIssueExemption(ctx context.Context, arg RevokeIssueExemptionParams) error
 	RevokePrivilegedGatewayAccess(ctx context.Context, arg RevokePrivilegedGatewayAccessParams) error
 	SetKolideCheck(ctx context.Context, arg SetKolideCheckParams) error
 	SetKolideIssue(ctx context.Context, arg SetKolideIssueParams) error
 	TruncateKolideIssues(ctx context.Context) error
 	UpdateDevice(ctx context.Context, arg UpdateDeviceParams) error
+	UpdateDeviceAgentVersion(ctx context.Context, arg UpdateDeviceAgentVersionParams) error
+	UpdateDeviceAgentVersionOutdatedSince(ctx context.Context, arg UpdateDeviceAgentVersionOutdatedSinceParams) error
+	UpdateDeviceUsername(ctx context.Context, arg UpdateDeviceUsernameParams) (int64, error)
 	UpdateGateway(ctx context.Context, arg UpdateGatewayParams) error
 	UpdateGatewayDynamicFields(ctx context.Context, arg UpdateGatewayDynamicFieldsParams) error
 	UserHasAccessToPrivilegedGateway(ctx context.Context, arg UserHasAccessToPrivilegedGatewayParams) (int64, error)
@@ -45,7 +45,7 @@ func (q *Queries) AddSessionAccessGroupID(ctx context.Context, arg AddSessionAcc
 }
 
 const getMostRecentDeviceSession = `-- name: GetMostRecentDeviceSession :one
-SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id FROM sessions s
+SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
 JOIN devices d ON d.id = s.device_id
 WHERE s.device_id = ?1
 ORDER BY s.expiry DESC
@@ -76,12 +76,14 @@ func (q *Queries) GetMostRecentDeviceSession(ctx context.Context, sessionDeviceI
 		&i.Device.Ipv6,
 		&i.Device.LastSeen,
 		&i.Device.ExternalID,
+		&i.Device.AgentVersion,
+		&i.Device.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
 
 const getSessionByKey = `-- name: GetSessionByKey :one
-SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id FROM sessions s
+SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
 JOIN devices d ON d.id = s.device_id WHERE s.key = ?1
 `
 
@@ -109,6 +111,8 @@ func (q *Queries) GetSessionByKey(ctx context.Context, sessionKey string) (*GetS
 		&i.Device.Ipv6,
 		&i.Device.LastSeen,
 		&i.Device.ExternalID,
+		&i.Device.AgentVersion,
+		&i.Device.AgentVersionOutdatedSince,
 	)
 	return &i, err
 }
@@ -141,7 +145,7 @@ func (q *Queries) GetSessionGroupIDs(ctx context.Context, sessionKey string) ([]
 }
 
 const getSessions = `-- name: GetSessions :many
-SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id FROM sessions s
+SELECT s."key", s.expiry, s.device_id, s.object_id, d.id, d.username, d.serial, d.platform, d.healthy, d.last_updated, d.public_key, d.ipv4, d.ipv6, d.last_seen, d.external_id, d.agent_version, d.agent_version_outdated_since FROM sessions s
 JOIN devices d ON d.id = s.device_id
 ORDER BY s.expiry
 `
@@ -176,6 +180,8 @@ func (q *Queries) GetSessions(ctx context.Context) ([]*GetSessionsRow, error) {
 			&i.Device.Ipv6,
 			&i.Device.LastSeen,
 			&i.Device.ExternalID,
+			&i.Device.AgentVersion,
+			&i.Device.AgentVersionOutdatedSince,
 		); err != nil {
 			return nil, err
 		}
@@ -198,3 +204,39 @@ func (q *Queries) RemoveExpiredSessions(ctx context.Context) error {
 	_, err := q.exec(ctx, q.removeExpiredSessionsStmt, removeExpiredSessions)
 	return err
 }
+
+const removeSession = `-- name: RemoveSession :exec
+DELETE FROM sessions WHERE key = ?1
+`
+
+func (q *Queries) RemoveSession(ctx context.Context, key string) error {
+	_, err := q.exec(ctx, q.removeSessionStmt, removeSession, key)
+	return err
+}
+
+const removeSessionAccessGroupIDs = `-- name: RemoveSessionAccessGroupIDs :exec
+DELETE FROM session_access_group_ids WHERE session_key = ?1
+`
+
+func (q *Queries) RemoveSessionAccessGroupIDs(ctx context.Context, sessionKey string) error {
+	_, err := q.exec(ctx, q.removeSessionAccessGroupIDsStmt, removeSessionAccessGroupIDs, sessionKey)
+	return err
+}
+
+const removeSessionsForDevice = `-- name: RemoveSessionsForDevice :exec
+DELETE FROM sessions WHERE device_id = ?1
+`
+
+func (q *Queries) RemoveSessionsForDevice(ctx context.Context, deviceID int64) error {
+	_, err := q.exec(ctx, q.removeSessionsForDeviceStmt, removeSessionsForDevice, deviceID)
+	return err
+}
+
+const removeSessionsForUser = `-- name: RemoveSessionsForUser :exec
+DELETE FROM sessions WHERE object_id = ?1
+`
+
+func (q *Queries) RemoveSessionsForUser(ctx context.Context, objectID string) error {
+	_, err := q.exec(ctx, q.removeSessionsForUserStmt, removeSessionsForUser, objectID)
+	return err
+}
@@ -0,0 +1,21 @@
+package basicauth
+
+import (
+	"context"
+	"encoding/base64"
+)
+
+// Credentials authenticates gRPC requests with basic authorization metadata.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+func (c Credentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	credentials := base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+	return map[string]string{"authorization": "Basic " + credentials}, nil
+}
+
+func (c Credentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,68 @@
+package controlplanecli
+
+import (
+	"fmt"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	FlagActor     = "actor"
+	FlagAction    = "action"
+	FlagTarget    = "target"
+	FlagSince     = "since"
+	FlagUntil     = "until"
+	FlagPageSize  = "page-size"
+	FlagPageToken = "page-token"
+	FlagAll       = "all"
+)
+
+func ListAuditEvents(c *cli.Context) error {
+	req := &pb.ListAuditEventsRequest{
+		Actor:     c.String(FlagActor),
+		Action:    c.String(FlagAction),
+		Target:    c.String(FlagTarget),
+		PageSize:  int32(c.Int(FlagPageSize)),
+		PageToken: c.Int64(FlagPageToken),
+	}
+	if since := c.Timestamp(FlagSince); since != nil {
+		req.Since = timestamppb.New(*since)
+	}
+	if until := c.Timestamp(FlagUntil); until != nil {
+		req.Until = timestamppb.New(*until)
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	out := &pb.ListAuditEventsResponse{}
+	for {
+		resp, err := client.ListAuditEvents(c.Context, req)
+		if err != nil {
+			return err
+		}
+
+		out.Events = append(out.Events, resp.GetEvents()...)
+		out.NextPageToken = resp.GetNextPageToken()
+
+		if !c.Bool(FlagAll) || resp.GetNextPageToken() == 0 {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	b, err := protojson.MarshalOptions{Indent: "  "}.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshal audit events: %w", err)
+	}
+
+	fmt.Println(string(b))
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package controlplanecli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/nais/device/internal/apiserver/database"
+	"github.com/nais/device/pkg/pb"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	FlagOutput      = "output"
+	FlagPostgresURL = "postgres-url"
+	FlagSQLitePath  = "sqlite-path"
+)
+
+// CopySQLiteToPostgres copies an apiserver SQLite database into an empty PostgreSQL database.
+// The apiserver should be stopped while copying, so that no changes are lost.
+func CopySQLiteToPostgres(c *cli.Context) error {
+	log := logrus.StandardLogger().WithField("component", "copy-to-postgres")
+
+	err := database.CopySQLiteToPostgres(c.Context, c.String(FlagSQLitePath), c.String(FlagPostgresURL), log)
+	if err != nil {
+		return fmt.Errorf("copy database: %w", err)
+	}
+
+	fmt.Println("database copied")
+
+	return nil
+}
+
+// SnapshotDatabase downloads a consistent copy of the apiserver database.
+func SnapshotDatabase(c *cli.Context) error {
+	output := c.String(FlagOutput)
+	if output == "" {
+		output = "naisdevice-" + time.Now().UTC().Format("20060102T150405Z") + ".db"
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := pb.NewAPIServerClient(conn)
+	stream, err := client.SnapshotDatabase(c.Context, &pb.SnapshotDatabaseRequest{})
+	if err != nil {
+		return err
+	}
+
+	// download to a temporary file, so that an interrupted download does not leave a broken snapshot behind
+	tmpOutput := output + ".tmp"
+	f, err := os.OpenFile(tmpOutput, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpOutput)
+
+	size := 0
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			_ = f.Close()
+			return fmt.Errorf("receive snapshot: %w", err)
+		}
+
+		n, err := f.Write(resp.GetData())
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		size += n
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	version, _, err := database.SnapshotSchemaVersion(tmpOutput)
+	if err != nil {
+		return fmt.Errorf("check snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmpOutput, output); err != nil {
+		return err
+	}
+
+	fmt.Printf("wrote snapshot to %s (%d bytes, schema version %d)\n", output, size, version)
+
+	return nil
+}
+
+// RestoreDatabase replaces the apiserver SQLite database with a snapshot.
+// It must run on the apiserver host while the apiserver is stopped.
+func RestoreDatabase(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("expected exactly one snapshot file")
+	}
+
+	log := logrus.StandardLogger().WithField("component", "restore")
+
+	backupPath, err := database.RestoreSnapshot(c.Args().First(), c.String(FlagSQLitePath), log)
+	if err != nil {
+		return fmt.Errorf("restore database: %w", err)
+	}
+
+	if backupPath == "" {
+		fmt.Println("database restored")
+	} else {
+		fmt.Printf("database restored, previous database kept as %s\n", backupPath)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package controlplanecli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	FlagDeviceID = "id"
+	FlagHealth   = "health"
+	FlagPlatform = "platform"
+	FlagUsername = "username"
+)
+
+func ListDevices(c *cli.Context) error {
+	var health pb.DeviceHealthFilter
+	switch c.String(FlagHealth) {
+	case "":
+		health = pb.DeviceHealthFilter_DeviceHealthAny
+	case "healthy":
+		health = pb.DeviceHealthFilter_DeviceHealthHealthy
+	case "unhealthy":
+		health = pb.DeviceHealthFilter_DeviceHealthUnhealthy
+	default:
+		return fmt.Errorf("invalid health filter %q, must be one of: healthy, unhealthy", c.String(FlagHealth))
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.ListDevices(c.Context, &pb.ListDevicesRequest{
+		DeviceUsername: c.String(FlagUsername),
+		Platform:       c.String(FlagPlatform),
+		Health:         health,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, d := range resp.GetDevices() {
+		fmt.Printf("id: %d, user: %s, serial: %s, platform: %s, version: %s, lastSeen: %v, healthy: %t, issues: %d\n",
+			d.GetId(),
+			d.GetUsername(),
+			d.GetSerial(),
+			d.GetPlatform(),
+			d.GetAgentVersion(),
+			d.GetLastSeen().AsTime(),
+			d.Healthy(),
+			len(d.GetIssues()),
+		)
+	}
+
+	return nil
+}
+
+func GetDevice(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	d, err := client.GetDevice(c.Context, &pb.GetDeviceRequest{
+		DeviceID: c.Int64(FlagDeviceID),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("id..........: %d\n", d.GetId())
+	fmt.Printf("username....: %s\n", d.GetUsername())
+	fmt.Printf("serial......: %s\n", d.GetSerial())
+	fmt.Printf("platform....: %s\n", d.GetPlatform())
+	fmt.Printf("publickey...: %s\n", d.GetPublicKey())
+	fmt.Printf("ipv4........: %s\n", d.GetIpv4())
+	fmt.Printf("ipv6........: %s\n", d.GetIpv6())
+	fmt.Printf("externalid..: %s\n", d.GetExternalID())
+	fmt.Printf("lastseen....: %v\n", d.GetLastSeen().AsTime())
+	fmt.Printf("lastupdated.: %v\n", d.GetLastUpdated().AsTime())
+	fmt.Printf("version.....: %s\n", d.GetAgentVersion())
+	if d.GetAgentVersionOutdatedSince() != nil {
+		fmt.Printf("outdated....: since %v\n", d.GetAgentVersionOutdatedSince().AsTime())
+	}
+	fmt.Printf("healthy.....: %t\n", d.Healthy())
+	for _, issue := range d.GetIssues() {
+		fmt.Printf("issue.......: [%s] %s (resolve before %v)\n", issue.GetSeverity(), issue.GetTitle(), issue.GetResolveBefore().AsTime())
+		if issue.Suppressed() {
+			fmt.Printf("suppressed..: by exemption %d until %v: %s\n", issue.GetExemption().GetId(), issue.GetExemption().GetExpires().AsTime(), issue.GetExemption().GetReason())
+		}
+	}
+
+	return nil
+}
+
+func DeleteDevice(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	_, err = client.DeleteDevice(c.Context, &pb.DeleteDeviceRequest{
+		DeviceID: c.Int64(FlagDeviceID),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Device %d deleted.\n", c.Int64(FlagDeviceID))
+
+	return nil
+}
+
+func ReassignDevice(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.ReassignDevice(c.Context, &pb.ReassignDeviceRequest{
+		DeviceID:          c.Int64(FlagDeviceID),
+		NewDeviceUsername: c.String(FlagUsername),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Device %d reassigned to %s.\n", resp.GetDevice().GetId(), resp.GetDevice().GetUsername())
+
+	return nil
+}
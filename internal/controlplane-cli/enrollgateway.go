@@ -11,8 +11,6 @@ import (
 	"github.com/nais/device/internal/passwordhash"
 	"github.com/nais/device/pkg/pb"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -28,19 +26,13 @@ const (
 const AdminUsername = "admin"
 
 func ListGateways(c *cli.Context) error {
-	conn, err := grpc.NewClient(
-		c.String(FlagAPIServer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialAPIServer(c)
 	if err != nil {
 		return err
 	}
 
 	client := pb.NewAPIServerClient(conn)
-	stream, err := client.ListGateways(c.Context, &pb.ListGatewayRequest{
-		Username: AdminUsername,
-		Password: c.String(FlagAdminPassword),
-	})
+	stream, err := client.ListGateways(c.Context, &pb.ListGatewayRequest{})
 	if err != nil {
 		return err
 	}
@@ -75,10 +67,7 @@ func HashPassword(c *cli.Context) error {
 }
 
 func EditGateway(c *cli.Context) error {
-	conn, err := grpc.NewClient(
-		c.String(FlagAPIServer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialAPIServer(c)
 	if err != nil {
 		return err
 	}
@@ -86,8 +75,6 @@ func EditGateway(c *cli.Context) error {
 	client := pb.NewAPIServerClient(conn)
 
 	req := &pb.ModifyGatewayRequest{
-		Username: AdminUsername,
-		Password: c.String(FlagAdminPassword),
 		Gateway: &pb.Gateway{
 			Name: c.String(FlagName),
 		},
@@ -117,6 +104,28 @@ func EditGateway(c *cli.Context) error {
 	return err
 }
 
+func DeleteGateway(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+
+	_, err = client.DeleteGateway(c.Context, &pb.ModifyGatewayRequest{
+		Gateway: &pb.Gateway{
+			Name: c.String(FlagName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Gateway %q deleted.\n", c.String(FlagName))
+
+	return nil
+}
+
 func EnrollGateway(c *cli.Context) error {
 	passwordBytes, err := passwordhash.RandomBytes(32)
 	if err != nil {
@@ -137,8 +146,6 @@ func EnrollGateway(c *cli.Context) error {
 	publicKey := wireguard.PublicKey(privateKey)
 
 	req := &pb.ModifyGatewayRequest{
-		Username: AdminUsername,
-		Password: c.String(FlagAdminPassword),
 		Gateway: &pb.Gateway{
 			Name:         c.String(FlagName),
 			PublicKey:    string(publicKey),
@@ -154,10 +161,7 @@ func EnrollGateway(c *cli.Context) error {
 	fmt.Fprintf(os.Stderr, "passhash....: %s\n", req.Gateway.PasswordHash)
 	fmt.Fprintf(os.Stderr, "\n")
 
-	conn, err := grpc.NewClient(
-		c.String(FlagAPIServer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialAPIServer(c)
 	if err != nil {
 		return err
 	}
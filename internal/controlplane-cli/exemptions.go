@@ -0,0 +1,104 @@
+package controlplanecli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	FlagDuration         = "duration"
+	FlagExemptionID      = "id"
+	FlagExemptedDeviceID = "device-id"
+)
+
+func CreateIssueExemption(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	exemption, err := client.CreateIssueExemption(c.Context, &pb.CreateIssueExemptionRequest{
+		DeviceID: c.Int64(FlagExemptedDeviceID),
+		User:     c.String(FlagUsername),
+		CheckID:  c.Int64(FlagCheckID),
+		Reason:   c.String(FlagReason),
+		Expires:  timestamppb.New(time.Now().Add(c.Duration(FlagDuration))),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created exemption %d for %s, expires %s\n", exemption.GetId(), exemptionScope(exemption), formatTimestamp(exemption.GetExpires()))
+
+	return nil
+}
+
+func ListIssueExemptions(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.ListIssueExemptions(c.Context, &pb.ListIssueExemptionsRequest{
+		ActiveOnly: c.Bool(FlagActive),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range resp.GetExemptions() {
+		fmt.Printf("id: %d, scope: %s, createdBy: %s, created: %s, expires: %s, revoked: %s, reason: %q\n",
+			e.GetId(),
+			exemptionScope(e),
+			e.GetCreatedBy(),
+			formatTimestamp(e.GetCreated()),
+			formatTimestamp(e.GetExpires()),
+			formatTimestamp(e.GetRevoked()),
+			e.GetReason(),
+		)
+	}
+
+	return nil
+}
+
+func RevokeIssueExemption(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	exemption, err := client.RevokeIssueExemption(c.Context, &pb.RevokeIssueExemptionRequest{
+		Id:     c.Int64(FlagExemptionID),
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("revoked exemption %d for %s\n", exemption.GetId(), exemptionScope(exemption))
+
+	return nil
+}
+
+func exemptionScope(e *pb.IssueExemption) string {
+	device, user, check := "any", "any", "any"
+	if e.GetDeviceID() != 0 {
+		device = strconv.FormatInt(e.GetDeviceID(), 10)
+	}
+	if e.GetUsername() != "" {
+		user = e.GetUsername()
+	}
+	if e.GetCheckID() != 0 {
+		check = strconv.FormatInt(e.GetCheckID(), 10)
+	}
+
+	return fmt.Sprintf("device %s, user %s, check %s", device, user, check)
+}
@@ -0,0 +1,143 @@
+package controlplanecli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+
+	"github.com/nais/device/internal/apiserver/gatewayconfigurer"
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+)
+
+const FlagWireGuardPrefix = "wireguard-prefix"
+
+// ValidateGatewayConfig checks a gateway config file against the gateways and WireGuard networks known to the apiserver,
+// and prints all problems found.
+func ValidateGatewayConfig(c *cli.Context) error {
+	gatewayConfigs, err := readGatewayConfigFile(c)
+	if err != nil {
+		return err
+	}
+
+	wireguardPrefixes, err := readWireGuardPrefixes(c)
+	if err != nil {
+		return err
+	}
+
+	gateways, err := readGateways(c)
+	if err != nil {
+		return err
+	}
+
+	problems := gatewayconfigurer.Validate(gatewayConfigs, gateways, wireguardPrefixes)
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+
+	if err := problems.Err(); err != nil {
+		return fmt.Errorf("gateway config is invalid")
+	}
+
+	fmt.Printf("gateway config is valid (%d warnings)\n", len(problems))
+
+	return nil
+}
+
+// DiffGatewayConfig prints the changes applying a gateway config file would make to the gateways in the apiserver.
+func DiffGatewayConfig(c *cli.Context) error {
+	gatewayConfigs, err := readGatewayConfigFile(c)
+	if err != nil {
+		return err
+	}
+
+	gateways, err := readGateways(c)
+	if err != nil {
+		return err
+	}
+
+	diffs, err := gatewayconfigurer.Diff(gatewayConfigs, gateways)
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 {
+		fmt.Println("no changes")
+		return nil
+	}
+
+	for _, diff := range diffs {
+		fmt.Printf("gateway %s:\n", diff.Gateway)
+		for _, change := range diff.Changes {
+			fmt.Printf("  %s\n", change)
+		}
+	}
+
+	return nil
+}
+
+func readGatewayConfigFile(c *cli.Context) (map[string]gatewayconfigurer.GatewayConfig, error) {
+	if c.NArg() != 1 {
+		return nil, fmt.Errorf("expected exactly one gateway config file")
+	}
+
+	return gatewayconfigurer.ReadFile(c.Args().First())
+}
+
+func readGateways(c *cli.Context) ([]*pb.Gateway, error) {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := pb.NewAPIServerClient(conn)
+	stream, err := client.ListGateways(c.Context, &pb.ListGatewayRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var gateways []*pb.Gateway
+	for {
+		gw, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return gateways, nil
+		} else if err != nil {
+			return nil, err
+		}
+		gateways = append(gateways, gw)
+	}
+}
+
+// readWireGuardPrefixes returns the WireGuard networks given with FlagWireGuardPrefix, or else the networks used by the apiserver.
+func readWireGuardPrefixes(c *cli.Context) ([]netip.Prefix, error) {
+	if c.IsSet(FlagWireGuardPrefix) {
+		return parsePrefixes(c.StringSlice(FlagWireGuardPrefix))
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := pb.NewAPIServerClient(conn).GetWireGuardPrefixes(c.Context, &pb.GetWireGuardPrefixesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get wireguard prefixes: %w", err)
+	}
+
+	return parsePrefixes(resp.GetPrefixes())
+}
+
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, len(values))
+	for i, value := range values {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", FlagWireGuardPrefix, err)
+		}
+		prefixes[i] = prefix.Masked()
+	}
+	return prefixes, nil
+}
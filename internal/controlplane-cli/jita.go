@@ -0,0 +1,85 @@
+package controlplanecli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	FlagActive  = "active"
+	FlagGateway = "gateway"
+	FlagGrantID = "id"
+	FlagReason  = "reason"
+)
+
+func ListGatewayJitaGrants(c *cli.Context) error {
+	req := &pb.ListGatewayJitaGrantsRequest{
+		Gateway:    c.String(FlagGateway),
+		UserID:     c.String(FlagObjectID),
+		ActiveOnly: c.Bool(FlagActive),
+	}
+	if since := c.Timestamp(FlagSince); since != nil {
+		req.Since = timestamppb.New(*since)
+	}
+	if until := c.Timestamp(FlagUntil); until != nil {
+		req.Until = timestamppb.New(*until)
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.ListGatewayJitaGrants(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range resp.GetGatewayJitaGrants() {
+		fmt.Printf("id: %d, gateway: %s, user: %s, objectId: %s, created: %s, expires: %s, revoked: %s, reason: %q\n",
+			g.GetId(),
+			g.GetGateway(),
+			g.GetUsername(),
+			g.GetUserID(),
+			formatTimestamp(g.GetCreated()),
+			formatTimestamp(g.GetExpires()),
+			formatTimestamp(g.GetRevoked()),
+			g.GetReason(),
+		)
+	}
+
+	return nil
+}
+
+func RevokeGatewayJitaGrant(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.RevokeGatewayJitaGrant(c.Context, &pb.RevokeGatewayJitaGrantRequest{
+		Id:     c.Int64(FlagGrantID),
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return err
+	}
+
+	g := resp.GetGatewayJitaGrant()
+	fmt.Printf("revoked grant %d for user %s on gateway %s\n", g.GetId(), g.GetUserID(), g.GetGateway())
+
+	return nil
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
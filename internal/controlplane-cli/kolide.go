@@ -6,24 +6,16 @@ import (
 
 	"github.com/nais/device/pkg/pb"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func GetKolideCache(c *cli.Context) error {
-	conn, err := grpc.NewClient(
-		c.String(FlagAPIServer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialAPIServer(c)
 	if err != nil {
 		return err
 	}
 
 	client := pb.NewAPIServerClient(conn)
-	resp, err := client.GetKolideCache(c.Context, &pb.GetKolideCacheRequest{
-		Username: AdminUsername,
-		Password: c.String(FlagAdminPassword),
-	})
+	resp, err := client.GetKolideCache(c.Context, &pb.GetKolideCacheRequest{})
 	if err != nil {
 		return err
 	}
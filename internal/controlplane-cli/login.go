@@ -0,0 +1,277 @@
+package controlplanecli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/nais/device/internal/basicauth"
+	agentconfig "github.com/nais/device/internal/deviceagent/config"
+	"github.com/nais/device/internal/deviceagent/open"
+	"github.com/nais/device/internal/random"
+	"github.com/nais/device/internal/tlsconfig"
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+)
+
+const (
+	FlagAdminToken    = "admin-token"
+	FlagDeviceCode    = "device-code"
+	FlagTLS           = "tls"
+	FlagTLSCAFile     = "tls-ca-file"
+	FlagTLSCertFile   = "tls-cert-file"
+	FlagTLSKeyFile    = "tls-key-file"
+	FlagTLSServerName = "tls-server-name"
+
+	tokenCacheFile = "controlplane-cli-token.json"
+)
+
+// dialAPIServer connects to the apiserver. Requests carry an OIDC token if one is given or cached by login,
+// and the admin password if one is given instead. With a client certificate, neither is needed.
+func dialAPIServer(c *cli.Context) (*grpc.ClientConn, error) {
+	transportCredentials, err := tlsconfig.Client{
+		Enabled:    c.Bool(FlagTLS),
+		CAFile:     c.String(FlagTLSCAFile),
+		CertFile:   c.String(FlagTLSCertFile),
+		KeyFile:    c.String(FlagTLSKeyFile),
+		ServerName: c.String(FlagTLSServerName),
+	}.TransportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+	}
+
+	token, err := adminToken(c)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	} else if password := c.String(FlagAdminPassword); password != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(basicauth.Credentials{Username: AdminUsername, Password: password}))
+	}
+
+	return grpc.NewClient(c.String(FlagAPIServer), opts...)
+}
+
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+func adminToken(c *cli.Context) (string, error) {
+	if token := c.String(FlagAdminToken); token != "" {
+		return token, nil
+	}
+
+	if c.String(FlagAdminPassword) != "" || c.String(FlagTLSCertFile) != "" {
+		return "", nil
+	}
+
+	token, err := readCachedToken()
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	oauthConfig, err := azureOAuth2Config()
+	if err != nil {
+		return "", err
+	}
+
+	// refreshes the token if it has expired
+	fresh, err := oauthConfig.TokenSource(c.Context, token).Token()
+	if err != nil {
+		return "", fmt.Errorf("token from 'controlplane-cli login' has expired, log in again: %w", err)
+	}
+
+	if fresh.AccessToken != token.AccessToken {
+		if err := writeCachedToken(fresh); err != nil {
+			return "", err
+		}
+	}
+
+	return fresh.AccessToken, nil
+}
+
+// Login gets an OIDC token for the apiserver through a browser, or with a device code for machines without one,
+// and caches it for later commands.
+func Login(c *cli.Context) error {
+	oauthConfig, err := azureOAuth2Config()
+	if err != nil {
+		return err
+	}
+
+	var token *oauth2.Token
+	if c.Bool(FlagDeviceCode) {
+		token, err = deviceCodeLogin(c.Context, oauthConfig)
+	} else {
+		token, err = browserLogin(c.Context, oauthConfig)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := writeCachedToken(token); err != nil {
+		return err
+	}
+
+	fmt.Println("logged in, token cached until", token.Expiry.Local().Format("2006-01-02 15:04:05"))
+	return nil
+}
+
+func Logout(_ *cli.Context) error {
+	path, err := tokenCachePath()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func browserLogin(ctx context.Context, oauthConfig oauth2.Config) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listen for login redirect: %w", err)
+	}
+	defer listener.Close()
+
+	// same redirect path as the naisdevice agent, which uses the same app registration
+	oauthConfig.RedirectURL = fmt.Sprintf("http://localhost:%d/auth/azure", listener.Addr().(*net.TCPAddr).Port)
+
+	state := random.RandomString(16, random.LettersAndNumbers)
+	verifier := oauth2.GenerateVerifier()
+
+	type result struct {
+		token *oauth2.Token
+		err   error
+	}
+	results := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /auth/azure", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != state {
+			http.Error(w, "invalid state, try again", http.StatusBadRequest)
+			results <- result{err: fmt.Errorf("invalid state in login redirect")}
+			return
+		}
+
+		token, err := oauthConfig.Exchange(r.Context(), r.URL.Query().Get("code"), oauth2.VerifierOption(verifier))
+		if err != nil {
+			http.Error(w, "login failed: "+err.Error(), http.StatusInternalServerError)
+			results <- result{err: fmt.Errorf("exchange code for token: %w", err)}
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, "Logged in, you can close this window.")
+		results <- result{token: token}
+	})
+
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Close()
+
+	url := oauthConfig.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.S256ChallengeOption(verifier))
+	open.Open(url)
+	fmt.Println("if the browser didn't open, visit this url to log in:", url)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		return res.token, res.err
+	}
+}
+
+func deviceCodeLogin(ctx context.Context, oauthConfig oauth2.Config) (*oauth2.Token, error) {
+	auth, err := oauthConfig.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start device code login: %w", err)
+	}
+
+	fmt.Printf("visit %s and enter the code %s to log in\n", auth.VerificationURI, auth.UserCode)
+
+	token, err := oauthConfig.DeviceAccessToken(ctx, auth)
+	if err != nil {
+		return nil, fmt.Errorf("device code login: %w", err)
+	}
+
+	return token, nil
+}
+
+// azureOAuth2Config is the OAuth2 config of the naisdevice agent, which gets tokens for the apiserver.
+func azureOAuth2Config() (oauth2.Config, error) {
+	cfg, err := agentconfig.DefaultConfig()
+	if err != nil {
+		return oauth2.Config{}, err
+	}
+
+	return cfg.OAuth2Config(pb.AuthProvider_Azure), nil
+}
+
+func tokenCachePath() (string, error) {
+	cfg, err := agentconfig.DefaultConfig()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cfg.ConfigDir, tokenCacheFile), nil
+}
+
+func readCachedToken() (*oauth2.Token, error) {
+	path, err := tokenCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("parse cached token %s: %w", path, err)
+	}
+
+	return token, nil
+}
+
+func writeCachedToken(token *oauth2.Token) error {
+	path, err := tokenCachePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
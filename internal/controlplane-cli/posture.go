@@ -0,0 +1,39 @@
+package controlplanecli
+
+import (
+	"fmt"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	FlagCheckID = "check-id"
+	FlagTag     = "tag"
+)
+
+func GetPosturePolicy(c *cli.Context) error {
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.GetPosturePolicy(c.Context, &pb.GetPosturePolicyRequest{
+		CheckID: c.Int64(FlagCheckID),
+		Tags:    c.StringSlice(FlagTag),
+	})
+	if err != nil {
+		return err
+	}
+
+	b, err := protojson.MarshalOptions{Indent: "  "}.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("marshal posture policy: %w", err)
+	}
+
+	fmt.Println(string(b))
+
+	return nil
+}
@@ -2,27 +2,69 @@ package controlplanecli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/nais/device/pkg/pb"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+const (
+	FlagObjectID   = "object-id"
+	FlagSessionKey = "key"
+)
+
+func RevokeSessions(c *cli.Context) error {
+	req := &pb.RevokeSessionsRequest{}
+
+	targets := 0
+	if c.IsSet(FlagSessionKey) {
+		targets++
+		req.Target = &pb.RevokeSessionsRequest_SessionKey{SessionKey: c.String(FlagSessionKey)}
+	}
+	if c.IsSet(FlagDeviceID) {
+		targets++
+		req.Target = &pb.RevokeSessionsRequest_DeviceID{DeviceID: c.Int64(FlagDeviceID)}
+	}
+	if c.IsSet(FlagObjectID) {
+		targets++
+		req.Target = &pb.RevokeSessionsRequest_ObjectID{ObjectID: c.String(FlagObjectID)}
+	}
+	if targets != 1 {
+		return fmt.Errorf("exactly one of --%s, --%s or --%s must be specified", FlagSessionKey, FlagDeviceID, FlagObjectID)
+	}
+
+	conn, err := dialAPIServer(c)
+	if err != nil {
+		return err
+	}
+
+	client := pb.NewAPIServerClient(conn)
+	resp, err := client.RevokeSessions(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range resp.GetSessions() {
+		fmt.Printf("revoked session for user: %s, device id: %d, serial: %s\n",
+			s.GetDevice().GetUsername(),
+			s.GetDevice().GetId(),
+			s.GetDevice().GetSerial(),
+		)
+	}
+
+	fmt.Fprintf(os.Stderr, "%d session(s) revoked.\n", len(resp.GetSessions()))
+
+	return nil
+}
+
 func ListSessions(c *cli.Context) error {
-	conn, err := grpc.NewClient(
-		c.String(FlagAPIServer),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialAPIServer(c)
 	if err != nil {
 		return err
 	}
 
 	client := pb.NewAPIServerClient(conn)
-	resp, err := client.GetSessions(c.Context, &pb.GetSessionsRequest{
-		Username: AdminUsername,
-		Password: c.String(FlagAdminPassword),
-	})
+	resp, err := client.GetSessions(c.Context, &pb.GetSessionsRequest{})
 	if err != nil {
 		return err
 	}
@@ -24,9 +24,8 @@ type Handler struct {
 func (h *Handler) index(w http.ResponseWriter, req *http.Request) {
 	var acceptedAt time.Time
 	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
-		if resp, err := apiserver.GetAcceptableUseAcceptedAt(req.Context(), &pb.GetAcceptableUseAcceptedAtRequest{
-			SessionKey: key,
-		}); err != nil {
+		ctx := pb.WithSessionKey(req.Context(), key)
+		if resp, err := apiserver.GetAcceptableUseAcceptedAt(ctx, &pb.GetAcceptableUseAcceptedAtRequest{}); err != nil {
 			return err
 		} else if resp.AcceptedAt != nil {
 			acceptedAt = resp.AcceptedAt.AsTime()
@@ -64,9 +63,9 @@ func (h *Handler) set(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
-		_, err := apiserver.SetAcceptableUseAccepted(req.Context(), &pb.SetAcceptableUseAcceptedRequest{
-			SessionKey: key,
-			Accepted:   accepted,
+		ctx := pb.WithSessionKey(req.Context(), key)
+		_, err := apiserver.SetAcceptableUseAccepted(ctx, &pb.SetAcceptableUseAcceptedRequest{
+			Accepted: accepted,
 		})
 		return err
 	}); err != nil {
@@ -40,6 +40,8 @@ type Config struct {
 	LocalAPIServer          bool
 	CustomEnrollURL         string
 	NoHelper                bool
+	APIServerTLS            bool
+	APIServerCAFile         string
 }
 
 func (c *Config) SetDefaults() {
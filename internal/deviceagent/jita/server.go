@@ -49,17 +49,30 @@ func (h *Handler) index(w http.ResponseWriter, req *http.Request) {
 
 	var hasActiveRequest bool
 	var grants []*pb.GatewayJitaGrant
+	var pendingApprovals []*pb.GatewayJitaGrant
+	var policy *pb.JitaPolicy
 	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
-		hasAccessResp, err := apiserver.UserHasAccessToPrivilegedGateway(req.Context(), &pb.UserHasAccessToPrivilegedGatewayRequest{
-			SessionKey: key,
-			Gateway:    gateway,
+		ctx := pb.WithSessionKey(req.Context(), key)
+		hasAccessResp, err := apiserver.UserHasAccessToPrivilegedGateway(ctx, &pb.UserHasAccessToPrivilegedGatewayRequest{
+			Gateway: gateway,
 		})
 		if err != nil {
 			return err
 		}
 
-		grantsResp, err := apiserver.GetGatewayJitaGrantsForUser(req.Context(), &pb.GetGatewayJitaGrantsForUserRequest{
-			SessionKey: key,
+		grantsResp, err := apiserver.GetGatewayJitaGrantsForUser(ctx, &pb.GetGatewayJitaGrantsForUserRequest{})
+		if err != nil {
+			return err
+		}
+
+		pendingResp, err := apiserver.GetPendingPrivilegedGatewayAccessRequests(ctx, &pb.GetPendingPrivilegedGatewayAccessRequestsRequest{})
+		// users outside the approver groups are not allowed to see pending requests
+		if err != nil && status.Code(err) != codes.PermissionDenied {
+			return err
+		}
+
+		policyResp, err := apiserver.GetPrivilegedGatewayAccessPolicy(ctx, &pb.GetPrivilegedGatewayAccessPolicyRequest{
+			Gateway: gateway,
 		})
 		if err != nil {
 			return err
@@ -67,6 +80,8 @@ func (h *Handler) index(w http.ResponseWriter, req *http.Request) {
 
 		hasActiveRequest = hasAccessResp.HasAccess
 		grants = grantsResp.GatewayJitaGrants
+		pendingApprovals = pendingResp.GetGatewayJitaGrants()
+		policy = policyResp.GetPolicy()
 
 		return nil
 	}); err != nil {
@@ -84,21 +99,79 @@ func (h *Handler) index(w http.ResponseWriter, req *http.Request) {
 		Reason     string
 		IsRevoked  bool
 		HasExpired bool
+		IsPending  bool
+		IsDenied   bool
+		Approver   string
+	}
+
+	type pendingApproval struct {
+		ID       int64
+		Created  time.Time
+		Duration time.Duration
+		Gateway  string
+		User     string
+		Reason   string
+	}
+
+	hasPendingRequest := false
+	for _, grant := range grants {
+		if grant.Gateway == gateway && grant.Approval == pb.JitaApproval_JitaApprovalPending && grant.Revoked == nil && grant.Expires.AsTime().After(time.Now()) {
+			hasPendingRequest = true
+		}
 	}
 
 	data := struct {
 		GrantGatewayAccessRequestFormAction string
 		RevokeGatewayAccessFormAction       string
+		ReviewGatewayAccessFormAction       string
 		Gateway                             string
+		MaxDurationHours                    int
+		DurationChoices                     []int
+		MinReasonLength                     int32
+		ReasonPattern                       string
+		MaxGrantsPerDay                     int32
 		HasActiveAccessRequest              bool
+		HasPendingAccessRequest             bool
 		Grants                              []accessGrant
+		PendingApprovals                    []pendingApproval
 		ErrorMessage                        string
 		StatusMessage                       string
 	}{
 		GrantGatewayAccessRequestFormAction: agenthttp.Path("/jita/grantGatewayAccessRequest", true),
 		RevokeGatewayAccessFormAction:       agenthttp.Path("/jita/revokeGatewayAccess", true),
+		ReviewGatewayAccessFormAction:       agenthttp.Path("/jita/reviewGatewayAccess", true),
 		Gateway:                             gateway,
-		HasActiveAccessRequest:              hasActiveRequest,
+		MaxDurationHours:                    maxDurationHours(policy),
+		DurationChoices: func() []int {
+			choices := make([]int, maxDurationHours(policy))
+			for i := range choices {
+				choices[i] = i + 1
+			}
+			return choices
+		}(),
+		MinReasonLength:         policy.GetMinReasonLength(),
+		ReasonPattern:           policy.GetReasonPattern(),
+		MaxGrantsPerDay:         policy.GetMaxGrantsPerDay(),
+		HasActiveAccessRequest:  hasActiveRequest,
+		HasPendingAccessRequest: hasPendingRequest,
+		PendingApprovals: func(grants []*pb.GatewayJitaGrant) []pendingApproval {
+			ret := make([]pendingApproval, len(grants))
+			for i, grant := range grants {
+				user := grant.Username
+				if user == "" {
+					user = grant.UserID
+				}
+				ret[i] = pendingApproval{
+					ID:       grant.Id,
+					Created:  grant.Created.AsTime().Local(),
+					Duration: grant.Expires.AsTime().Sub(grant.Created.AsTime()).Round(time.Minute),
+					Gateway:  grant.Gateway,
+					User:     user,
+					Reason:   grant.Reason,
+				}
+			}
+			return ret
+		}(pendingApprovals),
 		Grants: func(grants []*pb.GatewayJitaGrant) []accessGrant {
 			ret := make([]accessGrant, len(grants))
 			for i, grant := range grants {
@@ -116,6 +189,9 @@ func (h *Handler) index(w http.ResponseWriter, req *http.Request) {
 					Reason:     grant.Reason,
 					IsRevoked:  grant.Revoked != nil,
 					HasExpired: expires.Before(time.Now()),
+					IsPending:  grant.Approval == pb.JitaApproval_JitaApprovalPending,
+					IsDenied:   grant.Approval == pb.JitaApproval_JitaApprovalDenied,
+					Approver:   grant.Approver,
 				}
 			}
 			return ret
@@ -150,13 +226,10 @@ func (h *Handler) grant(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	duration, err := strconv.Atoi(req.FormValue("duration"))
+	hours, err := strconv.Atoi(req.FormValue("duration"))
 	if err != nil {
 		redirectToIndexWithErrorMessage("Missing or invalid duration parameter.", w, req)
 		return
-	} else if duration < 1 || duration > 8 {
-		redirectToIndexWithErrorMessage("Invalid duration parameter, must be between 1 and 8, inclusive.", w, req)
-		return
 	}
 
 	token := h.rc.GetJitaToken(req.Context())
@@ -165,24 +238,64 @@ func (h *Handler) grant(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	var pendingApproval bool
+	var invalidDurationMessage string
 	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
-		_, err := apiserver.GrantPrivilegedGatewayAccess(req.Context(), &pb.GrantPrivilegedGatewayAccessRequest{
-			SessionKey: key,
-			Token:      token.AccessToken,
+		ctx := pb.WithSessionKey(req.Context(), key)
+		policyResp, err := apiserver.GetPrivilegedGatewayAccessPolicy(ctx, &pb.GetPrivilegedGatewayAccessPolicyRequest{
+			Gateway: gateway,
+		})
+		if err != nil {
+			return err
+		}
+
+		policy := policyResp.GetPolicy()
+		if maxHours := maxDurationHours(policy); hours < 1 || hours > maxHours {
+			invalidDurationMessage = fmt.Sprintf("Invalid duration parameter, must be between 1 and %d, inclusive.", maxHours)
+			return nil
+		}
+
+		duration := time.Hour * time.Duration(hours)
+		// policies shorter than an hour are offered as a single one hour choice
+		if maxDuration := policy.GetMaxDuration().AsDuration(); maxDuration > 0 && duration > maxDuration {
+			duration = maxDuration
+		}
+
+		resp, err := apiserver.GrantPrivilegedGatewayAccess(ctx, &pb.GrantPrivilegedGatewayAccessRequest{
+			Token: token.AccessToken,
 			NewPrivilegedGatewayAccess: &pb.NewPrivilegedGatewayAccess{
 				Gateway: gateway,
-				Expires: timestamppb.New(time.Now().Add(time.Hour * time.Duration(duration))),
+				Expires: timestamppb.New(time.Now().Add(duration)),
 				Reason:  reason,
 			},
 		})
-		return err
+		if err != nil {
+			return err
+		}
+
+		pendingApproval = resp.PendingApproval
+		return nil
 	}); err != nil {
 		h.log.WithError(err).Errorf("unable to communicate with apiserver")
 		h.verifyToken(err)
+		if status.Code(err) == codes.InvalidArgument {
+			redirectToIndexWithErrorMessage(status.Convert(err).Message(), w, req)
+			return
+		}
 		redirectToIndexWithErrorMessage("Unable to communicate with apiserver.", w, req)
 		return
 	}
 
+	if invalidDurationMessage != "" {
+		redirectToIndexWithErrorMessage(invalidDurationMessage, w, req)
+		return
+	}
+
+	if pendingApproval {
+		redirectToIndexWithStatusMessage("Your request has been sent for approval. Ask one of the approvers to review it; the gateway will connect once approved.", w, req)
+		return
+	}
+
 	redirectToIndexWithStatusMessage("You have been granted access. The gateway will connect shortly.", w, req)
 }
 
@@ -194,9 +307,9 @@ func (h *Handler) revoke(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
-		_, err := apiserver.RevokePrivilegedGatewayAccess(req.Context(), &pb.RevokePrivilegedGatewayAccessRequest{
-			SessionKey: key,
-			Gateway:    gatewayToRevoke,
+		ctx := pb.WithSessionKey(req.Context(), key)
+		_, err := apiserver.RevokePrivilegedGatewayAccess(ctx, &pb.RevokePrivilegedGatewayAccessRequest{
+			Gateway: gatewayToRevoke,
 		})
 		return err
 	}); err != nil {
@@ -209,6 +322,71 @@ func (h *Handler) revoke(w http.ResponseWriter, req *http.Request) {
 	redirectToIndexWithStatusMessage("The access to the gateway has been revoked.", w, req)
 }
 
+func (h *Handler) review(w http.ResponseWriter, req *http.Request) {
+	grantID, err := strconv.ParseInt(req.FormValue("grantID"), 10, 64)
+	if err != nil {
+		redirectToIndexWithErrorMessage("Missing or invalid grantID parameter.", w, req)
+		return
+	}
+
+	var approved bool
+	switch req.FormValue("decision") {
+	case "approve":
+		approved = true
+	case "deny":
+		approved = false
+	default:
+		redirectToIndexWithErrorMessage("Missing or invalid decision parameter.", w, req)
+		return
+	}
+
+	token := h.rc.GetJitaToken(req.Context())
+	if token == nil {
+		http.Error(w, "No token found. Make sure you open this page using the systray / cli.", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.rc.WithAPIServer(func(apiserver pb.APIServerClient, key string) error {
+		ctx := pb.WithSessionKey(req.Context(), key)
+		_, err := apiserver.ReviewPrivilegedGatewayAccess(ctx, &pb.ReviewPrivilegedGatewayAccessRequest{
+			Token:    token.AccessToken,
+			GrantID:  grantID,
+			Approved: approved,
+		})
+		return err
+	}); err != nil {
+		h.log.WithError(err).Errorf("unable to review access request")
+		h.verifyToken(err)
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			redirectToIndexWithErrorMessage("The request is no longer pending approval.", w, req)
+		case codes.PermissionDenied:
+			redirectToIndexWithErrorMessage("You are not allowed to review this request.", w, req)
+		default:
+			redirectToIndexWithErrorMessage("Unable to communicate with apiserver.", w, req)
+		}
+		return
+	}
+
+	if approved {
+		redirectToIndexWithStatusMessage("The request has been approved.", w, req)
+	} else {
+		redirectToIndexWithStatusMessage("The request has been denied.", w, req)
+	}
+}
+
+// defaultMaxDurationHours is offered when the gateway has no maximum duration in its policy
+const defaultMaxDurationHours = 8
+
+// maxDurationHours returns the longest duration offered, in whole hours.
+func maxDurationHours(policy *pb.JitaPolicy) int {
+	maxDuration := policy.GetMaxDuration().AsDuration()
+	if maxDuration <= 0 {
+		return defaultMaxDurationHours
+	}
+	return max(1, int(maxDuration/time.Hour))
+}
+
 func New(rc runtimeconfig.RuntimeConfig, log logrus.FieldLogger) *Handler {
 	return &Handler{
 		rc:  rc,
@@ -221,4 +399,5 @@ func (h *Handler) Register(registerFunc func(pattern string, handler http.Handle
 	registerFunc("GET /jita", h.index)
 	registerFunc("POST /jita/grantGatewayAccessRequest", h.grant)
 	registerFunc("POST /jita/revokeGatewayAccess", h.revoke)
+	registerFunc("POST /jita/reviewGatewayAccess", h.review)
 }
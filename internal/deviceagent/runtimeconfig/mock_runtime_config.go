@@ -727,6 +727,66 @@ func (_c *MockRuntimeConfig_PopulateTenants_Call) RunAndReturn(run func(context1
 	return _c
 }
 
+// RefreshToken provides a mock function for the type MockRuntimeConfig
+func (_mock *MockRuntimeConfig) RefreshToken(context1 context.Context) (string, error) {
+	ret := _mock.Called(context1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(context1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(context1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRuntimeConfig_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type MockRuntimeConfig_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - context1 context.Context
+func (_e *MockRuntimeConfig_Expecter) RefreshToken(context1 interface{}) *MockRuntimeConfig_RefreshToken_Call {
+	return &MockRuntimeConfig_RefreshToken_Call{Call: _e.mock.On("RefreshToken", context1)}
+}
+
+func (_c *MockRuntimeConfig_RefreshToken_Call) Run(run func(context1 context.Context)) *MockRuntimeConfig_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRuntimeConfig_RefreshToken_Call) Return(s string, err error) *MockRuntimeConfig_RefreshToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRuntimeConfig_RefreshToken_Call) RunAndReturn(run func(context1 context.Context) (string, error)) *MockRuntimeConfig_RefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetEnrollConfig provides a mock function for the type MockRuntimeConfig
 func (_mock *MockRuntimeConfig) ResetEnrollConfig() {
 	_mock.Called()
@@ -24,6 +24,7 @@ import (
 	"github.com/nais/device/internal/enroll"
 	"github.com/nais/device/internal/ioconvenience"
 	"github.com/nais/device/internal/otel"
+	"github.com/nais/device/internal/tlsconfig"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
@@ -31,7 +32,6 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	grpcstatus "google.golang.org/grpc/status"
 )
@@ -60,6 +60,7 @@ type RuntimeConfig interface {
 
 	GetDomainFromToken() string
 	GetToken(context.Context) (string, error)
+	RefreshToken(context.Context) (string, error)
 	SetToken(*auth.Tokens)
 	HasToken() bool
 	SetTenantSession(*pb.Session) error
@@ -82,6 +83,8 @@ type runtimeConfig struct {
 	tenants      []*pb.Tenant
 	log          *logrus.Entry
 
+	tokensLock sync.RWMutex
+
 	jitaToken     *oauth2.Token
 	jitaTokenLock sync.RWMutex
 
@@ -118,10 +121,18 @@ func (rc *runtimeConfig) WithAPIServer(f func(pb.APIServerClient, string) error)
 }
 
 func (rc *runtimeConfig) DialAPIServer(ctx context.Context) (*grpc.ClientConn, error) {
+	transportCredentials, err := tlsconfig.Client{
+		Enabled: rc.config.APIServerTLS,
+		CAFile:  rc.config.APIServerCAFile,
+	}.TransportCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("apiserver TLS: %w", err)
+	}
+
 	rc.log.WithField("address", rc.apiServerGRPCAddress()).Info("setting up gRPC connection to apiserver")
 	return grpc.NewClient(
 		rc.apiServerGRPCAddress(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(transportCredentials),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                time.Second * 10,
 			Timeout:             time.Second * 2,
@@ -462,14 +473,21 @@ func (rc *runtimeConfig) GetTenantSession() (*pb.Session, error) {
 }
 
 func (rc *runtimeConfig) SetToken(token *auth.Tokens) {
+	rc.tokensLock.Lock()
+	defer rc.tokensLock.Unlock()
 	rc.tokens = token
 }
 
 func (rc *runtimeConfig) HasToken() bool {
+	rc.tokensLock.RLock()
+	defer rc.tokensLock.RUnlock()
 	return rc.tokens != nil
 }
 
 func (rc *runtimeConfig) GetToken(ctx context.Context) (string, error) {
+	rc.tokensLock.RLock()
+	defer rc.tokensLock.RUnlock()
+
 	if rc.tokens == nil {
 		return "", fmt.Errorf("no tokens in runtimeconfig")
 	}
@@ -480,3 +498,29 @@ func (rc *runtimeConfig) GetToken(ctx context.Context) (string, error) {
 
 	return rc.tokens.Token.AccessToken, nil
 }
+
+// RefreshToken returns a token that has not expired, for renewing the session without the user logging in again.
+// An expired access token is refreshed with the refresh token, which only Azure tenants have.
+func (rc *runtimeConfig) RefreshToken(ctx context.Context) (string, error) {
+	rc.tokensLock.Lock()
+	defer rc.tokensLock.Unlock()
+
+	if rc.tokens == nil || rc.tokens.Token == nil {
+		return "", fmt.Errorf("no tokens in runtimeconfig")
+	}
+
+	provider := rc.GetActiveTenant().AuthProvider
+	if provider == pb.AuthProvider_Google {
+		return "", fmt.Errorf("refreshing tokens is not supported for Google tenants")
+	}
+
+	oauth2Config := rc.config.OAuth2Config(provider)
+	token, err := oauth2Config.TokenSource(ctx, rc.tokens.Token).Token()
+	if err != nil {
+		return "", fmt.Errorf("refresh token: %w", err)
+	}
+
+	rc.tokens = &auth.Tokens{Token: token, IDToken: rc.tokens.IDToken}
+
+	return token.AccessToken, nil
+}
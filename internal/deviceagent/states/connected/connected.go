@@ -7,6 +7,7 @@ import (
 	"io"
 	"math"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -27,9 +28,10 @@ import (
 )
 
 const (
-	apiServerRetryInterval = time.Millisecond * 10
-	healthCheckInterval    = 20 * time.Second // how often to healthcheck gateways
-	helperTimeout          = 20 * time.Second
+	apiServerRetryInterval      = time.Millisecond * 10
+	healthCheckInterval         = 20 * time.Second // how often to healthcheck gateways
+	helperTimeout               = 20 * time.Second
+	sessionRenewalRetryInterval = time.Minute
 )
 
 type Connected struct {
@@ -61,6 +63,7 @@ var (
 	ErrUnauthenticated = errors.New("unauthenticated")
 	ErrUnavailable     = errors.New("unavailable")
 	ErrLostConnection  = errors.New("lost connection")
+	ErrSessionRenewed  = errors.New("session renewed")
 )
 
 func (c *Connected) Enter(ctx context.Context) state.EventWithSpan {
@@ -112,6 +115,9 @@ func (c *Connected) Enter(ctx context.Context) state.EventWithSpan {
 		case errors.Is(e, ErrLostConnection):
 			c.logger.WithError(e).Info("lost connection, reconnecting...")
 			attempt = 0
+		case errors.Is(e, ErrSessionRenewed):
+			c.logger.Info("session renewed, reconnecting with the new session...")
+			attempt = 0
 		case errors.Is(e, context.DeadlineExceeded):
 			c.logger.WithError(e).Info("syncConfigLoop deadline exceeded")
 			return state.SpanEvent(ctx, state.EventDisconnect)
@@ -196,7 +202,11 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 		}
 	}
 
-	stream, cancel, err := c.syncSetup(ctx)
+	// the stream is restarted with ErrSessionRenewed when the session has been renewed
+	streamContext, restartStream := context.WithCancelCause(ctx)
+	defer restartStream(nil)
+
+	stream, cancel, err := c.syncSetup(streamContext)
 	if err != nil {
 		return fmt.Errorf("setup gateway stream(%w): %w", toInternalError(err), err)
 	}
@@ -204,6 +214,7 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 
 	var healthCheckCancel context.CancelFunc = func() {}
 	var previousStatus pb.DeviceConfigurationStatus
+	renewing := false
 	for ctx.Err() == nil {
 		err := func() error {
 			cfg, err := stream.Recv()
@@ -213,6 +224,10 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 			span.RecordError(err)
 
 			if err != nil {
+				if errors.Is(context.Cause(streamContext), ErrSessionRenewed) {
+					return ErrSessionRenewed
+				}
+
 				internalErr := toInternalError(err)
 				if internalErr == ErrUnavailable {
 					// indicate that we had a working connection
@@ -224,6 +239,12 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 			defer func() { previousStatus = cfg.Status }()
 			c.logger.Info("received gateway configuration from API server")
 
+			// Google tenants have no refresh token, so their sessions end with a new login instead
+			if cfg.GetRenewSession() && !renewing && c.rc.GetActiveTenant().GetAuthProvider() != pb.AuthProvider_Google {
+				renewing = true
+				go c.renewSession(streamContext, restartStream)
+			}
+
 			switch cfg.Status {
 			case pb.DeviceConfigurationStatus_InvalidSession:
 				span.AddEvent("session.invalid")
@@ -236,14 +257,16 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 					c.logger.WithField("issue", issue).Error("issue detected")
 				}
 				// notify unless we've already notified about this.
+				// suppressed issues are kept in the status, but do not cause the device to be unhealthy
 				if cfg.Status != previousStatus {
-					if len(cfg.Issues) > 0 {
-						if hasKolideNotLinkedIssue(cfg.Issues) {
+					issues := slices.DeleteFunc(slices.Clone(cfg.Issues), (*pb.DeviceIssue).Suppressed)
+					if len(issues) > 0 {
+						if hasKolideNotLinkedIssue(issues) {
 							c.notifier.Errorf("Kolide is not linked to your device. Install Kolide according to the documentation at https://doc.nais.io/operate/naisdevice/how-to/install")
-						} else if hasAcceptableUseNotAcceptedIssue(cfg.Issues) {
+						} else if hasAcceptableUseNotAcceptedIssue(issues) {
 							c.notifier.Errorf("You must accept the Do's and don'ts. Click on the naisdevice icon and select 'Acceptable use policy'.")
 						} else {
-							c.notifier.Errorf("Found %d issue(s) on your device. Check Kolide icon in the systray for further investigation.", len(cfg.Issues))
+							c.notifier.Errorf("Found %d issue(s) on your device. Check Kolide icon in the systray for further investigation.", len(issues))
 						}
 					}
 				}
@@ -289,6 +312,62 @@ func (c *Connected) defaultSyncConfigLoop(ctx context.Context) error {
 	return ctx.Err()
 }
 
+// renewSession renews the session in the background while the stream keeps running, retrying until it succeeds
+// or the stream ends. The stream is then restarted with the new session key, and the tunnel is kept up throughout.
+func (c *Connected) renewSession(ctx context.Context, restartStream context.CancelCauseFunc) {
+	for {
+		err := c.renew(ctx)
+		if err == nil {
+			restartStream(ErrSessionRenewed)
+			return
+		}
+
+		c.logger.WithError(err).Warn("renew session")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sessionRenewalRetryInterval):
+		}
+	}
+}
+
+func (c *Connected) renew(ctx context.Context) error {
+	ctx, span := otel.Start(ctx, "RenewSession")
+	defer span.End()
+
+	token, err := c.rc.RefreshToken(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	var session *pb.Session
+	err = c.rc.WithAPIServer(func(apiserverClient pb.APIServerClient, key string) error {
+		resp, err := apiserverClient.RenewSession(pb.WithSessionKey(ctx, key), &pb.RenewSessionRequest{
+			Token: token,
+		})
+		if err != nil {
+			return err
+		}
+		session = resp.GetSession()
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := c.rc.SetTenantSession(session); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.logger.WithField("expiry", session.GetExpiry().AsTime()).Info("session renewed")
+
+	return nil
+}
+
 func (c *Connected) syncSetup(ctx context.Context) (pb.APIServer_GetDeviceConfigurationClient, context.CancelFunc, error) {
 	ctx, span := otel.Start(ctx, "SyncConfigLoop/setup")
 	defer span.End()
@@ -313,8 +392,8 @@ func (c *Connected) syncSetup(ctx context.Context) (pb.APIServer_GetDeviceConfig
 
 	streamContext, cancel := context.WithDeadline(ctx, session.Expiry.AsTime())
 
-	stream, err := apiserverClient.GetDeviceConfiguration(streamContext, &pb.GetDeviceConfigurationRequest{
-		SessionKey: session.Key,
+	stream, err := apiserverClient.GetDeviceConfiguration(pb.WithSessionKey(streamContext, session.Key), &pb.GetDeviceConfigurationRequest{
+		Version: version.Version,
 	})
 	if err != nil {
 		cancel()
@@ -347,10 +426,11 @@ func (c Connected) Status() *pb.AgentStatus {
 	}
 
 	return &pb.AgentStatus{
-		ConnectedSince:  c.connectedSince,
-		Gateways:        c.cfg.GetGateways(),
-		Issues:          c.cfg.GetIssues(),
-		ConnectionState: state,
+		ConnectedSince:      c.connectedSince,
+		Gateways:            c.cfg.GetGateways(),
+		Issues:              c.cfg.GetIssues(),
+		ConnectionState:     state,
+		NewVersionAvailable: c.cfg.GetNewVersionAvailable(),
 	}
 }
 
@@ -156,6 +156,32 @@ func TestConnected_Enter(t *testing.T) {
 			assert.Equal(t, state.EventWaitForExternalEvent, event)
 		})
 
+		t.Run("returns ErrSessionRenewed", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			rc, deviceHelper, notifier := setupMocks(t)
+
+			calls := 0
+
+			c := &Connected{
+				rc:           rc,
+				logger:       logger,
+				notifier:     notifier,
+				deviceHelper: deviceHelper,
+				syncConfigLoop: func(ctx context.Context) error {
+					calls++
+					if calls > 1 {
+						return context.Canceled
+					}
+					return ErrSessionRenewed
+				},
+			}
+			event := c.Enter(ctx).Event
+			assert.Equal(t, state.EventWaitForExternalEvent, event)
+			assert.Equal(t, 2, calls)
+		})
+
 		t.Run("returns ErrLostConnection", func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
@@ -349,6 +375,94 @@ func TestConnected_defaultSyncConfigLoop(t *testing.T) {
 			assert.ErrorIs(t, err, ErrUnauthenticated)
 		})
 
+		t.Run("renews session and restarts stream", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			rc, deviceHelper, getDeviceConfigClient := setupMocks()
+
+			c := &Connected{
+				rc:           rc,
+				logger:       logger,
+				deviceHelper: deviceHelper,
+			}
+			apiServerPeer := &pb.Gateway{}
+			rc.EXPECT().APIServerPeer().Return(apiServerPeer)
+			configuration := &pb.Configuration{}
+			rc.EXPECT().BuildHelperConfiguration([]*pb.Gateway{apiServerPeer}).Return(configuration)
+			deviceHelper.EXPECT().Configure(mock.Anything, configuration).Return(&pb.ConfigureResponse{}, nil)
+
+			renewed := &pb.Session{Expiry: timestamppb.New(time.Now().Add(10 * time.Hour)), Key: "renewed-key"}
+			apiServerClient := pb.NewMockAPIServerClient(t)
+			apiServerClient.EXPECT().RenewSession(mock.Anything, &pb.RenewSessionRequest{Token: "fresh-token"}).Return(&pb.RenewSessionResponse{Session: renewed}, nil)
+
+			rc.EXPECT().GetActiveTenant().Return(&pb.Tenant{AuthProvider: pb.AuthProvider_Azure})
+			rc.EXPECT().RefreshToken(mock.Anything).Return("fresh-token", nil)
+			rc.EXPECT().WithAPIServer(mock.Anything).RunAndReturn(func(f func(pb.APIServerClient, string) error) error {
+				return f(apiServerClient, "key")
+			})
+			sessionStored := make(chan struct{})
+			rc.EXPECT().SetTenantSession(renewed).RunAndReturn(func(*pb.Session) error {
+				close(sessionStored)
+				return nil
+			})
+
+			alreadyCalled := false
+			getDeviceConfigClient.EXPECT().Recv().RunAndReturn(func() (*pb.GetDeviceConfigurationResponse, error) {
+				if alreadyCalled {
+					// the stream is canceled once the renewed session is stored
+					<-sessionStored
+					return nil, grpcstatus.Error(codes.Canceled, "context canceled")
+				}
+				alreadyCalled = true
+				return &pb.GetDeviceConfigurationResponse{
+					Status:       pb.DeviceConfigurationStatus_DeviceHealthy,
+					Gateways:     []*pb.Gateway{},
+					RenewSession: true,
+				}, nil
+			})
+
+			err := c.defaultSyncConfigLoop(ctx)
+			assert.ErrorIs(t, err, ErrSessionRenewed)
+		})
+
+		t.Run("does not renew google sessions", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			rc, deviceHelper, getDeviceConfigClient := setupMocks()
+
+			c := &Connected{
+				rc:           rc,
+				logger:       logger,
+				deviceHelper: deviceHelper,
+			}
+			apiServerPeer := &pb.Gateway{}
+			rc.EXPECT().APIServerPeer().Return(apiServerPeer)
+			configuration := &pb.Configuration{}
+			rc.EXPECT().BuildHelperConfiguration([]*pb.Gateway{apiServerPeer}).Return(configuration)
+			deviceHelper.EXPECT().Configure(mock.Anything, configuration).Return(&pb.ConfigureResponse{}, nil)
+			rc.EXPECT().GetActiveTenant().Return(&pb.Tenant{AuthProvider: pb.AuthProvider_Google})
+
+			alreadyCalled := false
+			stopTestErr := errors.New("stop test")
+			getDeviceConfigClient.EXPECT().Recv().RunAndReturn(func() (*pb.GetDeviceConfigurationResponse, error) {
+				if alreadyCalled {
+					return nil, stopTestErr
+				}
+				alreadyCalled = true
+				return &pb.GetDeviceConfigurationResponse{
+					Status:       pb.DeviceConfigurationStatus_DeviceHealthy,
+					Gateways:     []*pb.Gateway{},
+					RenewSession: true,
+				}, nil
+			})
+
+			// RefreshToken is not expected on the mock
+			err := c.defaultSyncConfigLoop(ctx)
+			assert.ErrorIs(t, err, stopTestErr)
+		})
+
 		t.Run("session timeout", func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
@@ -2,38 +2,65 @@ package gateway_agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/nais/device/internal/basicauth"
 	"github.com/nais/device/internal/wireguard"
 	"github.com/nais/device/pkg/pb"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 type ErrGRPCConnection error
 
+var ErrGenerationGap = errors.New("gateway configuration generation gap")
+
 func SyncFromStream(ctx context.Context, log *logrus.Entry, name, password string, staticPeers []wireguard.Peer, apiserverClient pb.APIServerClient, netConf wireguard.NetworkConfigurer) error {
+	credentials := basicauth.Credentials{Username: name, Password: password}
 	stream, err := apiserverClient.GetGatewayConfiguration(ctx, &pb.GetGatewayConfigurationRequest{
-		Gateway:  name,
-		Password: password,
-	})
+		Mode: pb.GatewayConfigurationMode_GatewayConfigurationModeDelta,
+	}, grpc.PerRPCCredentials(credentials))
 	if err != nil {
 		return err
 	}
 
 	log.Info("authenticated with API server and streaming configuration updates")
 
+	var generation uint64
 	for {
 		gwConfig, err := stream.Recv()
 		if err != nil {
 			return fmt.Errorf("get gateway config: %w", err)
 		}
 
-		log.Info("received updated configuration")
+		// apiservers without delta support always send full configurations without a generation
+		if gwConfig.GetGeneration() == 0 || gwConfig.GetFullSnapshot() {
+			log.WithField("generation", gwConfig.GetGeneration()).Info("received full configuration")
 
-		err = applyGatewayConfig(netConf, gwConfig, staticPeers...)
-		if err != nil {
-			return fmt.Errorf("apply gateway config: %w", err)
+			err = applyGatewayConfig(netConf, gwConfig, staticPeers...)
+			if err != nil {
+				return fmt.Errorf("apply gateway config: %w", err)
+			}
+		} else {
+			if gwConfig.GetGeneration() != generation+1 {
+				// returning makes the caller reconnect, which starts with a full snapshot
+				return fmt.Errorf("%w: expected generation %d, got %d", ErrGenerationGap, generation+1, gwConfig.GetGeneration())
+			}
+
+			log.WithFields(logrus.Fields{
+				"generation": gwConfig.GetGeneration(),
+				"added":      len(gwConfig.GetAddedDevices()),
+				"removed":    len(gwConfig.GetRemovedPublicKeys()),
+			}).Info("received configuration delta")
+
+			err = applyGatewayConfigDelta(netConf, gwConfig)
+			if err != nil {
+				return fmt.Errorf("apply gateway config delta: %w", err)
+			}
 		}
+
+		generation = gwConfig.GetGeneration()
 	}
 }
 
@@ -60,3 +87,26 @@ func applyGatewayConfig(configurer wireguard.NetworkConfigurer, gatewayConfig *p
 
 	return nil
 }
+
+func applyGatewayConfigDelta(configurer wireguard.NetworkConfigurer, delta *pb.GetGatewayConfigurationResponse) error {
+	err := configurer.UpdateWireGuardPeers(wireguard.CastPeerList(delta.GetAddedDevices()), delta.GetRemovedPublicKeys())
+	if err != nil {
+		return fmt.Errorf("updating WireGuard peers: %w", err)
+	}
+
+	if !delta.GetRoutesChanged() {
+		return nil
+	}
+
+	err = configurer.ForwardRoutesV4(delta.GetRoutesIPv4())
+	if err != nil {
+		return fmt.Errorf("forwarding IPv4 routes: %w", err)
+	}
+
+	err = configurer.ForwardRoutesV6(delta.GetRoutesIPv6())
+	if err != nil {
+		return fmt.Errorf("forwarding IPv6 routes: %w", err)
+	}
+
+	return nil
+}
@@ -28,8 +28,7 @@ func TestSyncFromStream(t *testing.T) {
 		defer cancel()
 
 		req := &pb.GetGatewayConfigurationRequest{
-			Gateway:  name,
-			Password: password,
+			Mode: pb.GatewayConfigurationMode_GatewayConfigurationModeDelta,
 		}
 		resp := &pb.GetGatewayConfigurationResponse{
 			Devices:    []*pb.Device{},
@@ -48,6 +47,7 @@ func TestSyncFromStream(t *testing.T) {
 		client.On("GetGatewayConfiguration",
 			mock.Anything,
 			req,
+			mock.Anything,
 		).Return(stream, nil)
 
 		staticPeers := cfg.StaticPeers()
@@ -63,4 +63,73 @@ func TestSyncFromStream(t *testing.T) {
 
 		assert.ErrorIs(t, err, knownError)
 	})
+
+	t.Run("applies deltas on top of a full snapshot", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+
+		device1 := &pb.Device{Serial: "device1", PublicKey: "key1", Ipv4: "10.255.240.2"}
+		device2 := &pb.Device{Serial: "device2", PublicKey: "key2", Ipv4: "10.255.240.3"}
+
+		snapshot := &pb.GetGatewayConfigurationResponse{
+			Devices:      []*pb.Device{device1},
+			RoutesIPv4:   []string{"1.2.3.4/32"},
+			Generation:   1,
+			FullSnapshot: true,
+		}
+		peerDelta := &pb.GetGatewayConfigurationResponse{
+			AddedDevices:      []*pb.Device{device2},
+			RemovedPublicKeys: []string{"key1"},
+			Generation:        2,
+		}
+		routeDelta := &pb.GetGatewayConfigurationResponse{
+			RoutesIPv4:    []string{"5.6.7.8/32"},
+			RoutesChanged: true,
+			Generation:    3,
+		}
+
+		stream := pb.NewMockAPIServer_GetGatewayConfigurationClient(t)
+		stream.EXPECT().Recv().Return(snapshot, nil).Once()
+		stream.EXPECT().Recv().Return(peerDelta, nil).Once()
+		stream.EXPECT().Recv().Return(routeDelta, nil).Once()
+		stream.EXPECT().Recv().Return(nil, knownError).Once()
+
+		client := pb.NewMockAPIServerClient(t)
+		client.EXPECT().GetGatewayConfiguration(mock.Anything, mock.Anything, mock.Anything).Return(stream, nil)
+
+		netConf := wireguard.NewMockNetworkConfigurer(t)
+		netConf.EXPECT().ApplyWireGuardConfig(wireguard.CastPeerList(snapshot.Devices)).Return(nil).Once()
+		netConf.EXPECT().ForwardRoutesV4(snapshot.RoutesIPv4).Return(nil).Once()
+		netConf.EXPECT().ForwardRoutesV6([]string(nil)).Return(nil).Twice()
+		netConf.EXPECT().UpdateWireGuardPeers(wireguard.CastPeerList(peerDelta.AddedDevices), peerDelta.RemovedPublicKeys).Return(nil).Once()
+		netConf.EXPECT().UpdateWireGuardPeers([]wireguard.Peer{}, []string(nil)).Return(nil).Once()
+		netConf.EXPECT().ForwardRoutesV4(routeDelta.RoutesIPv4).Return(nil).Once()
+
+		gwLogger := logrus.StandardLogger().WithField("component", "gateway-agent")
+		err := gateway_agent.SyncFromStream(ctx, gwLogger, name, password, nil, client, netConf)
+
+		assert.ErrorIs(t, err, knownError)
+	})
+
+	t.Run("generation gap aborts the stream", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+
+		stream := pb.NewMockAPIServer_GetGatewayConfigurationClient(t)
+		stream.EXPECT().Recv().Return(&pb.GetGatewayConfigurationResponse{Generation: 1, FullSnapshot: true}, nil).Once()
+		stream.EXPECT().Recv().Return(&pb.GetGatewayConfigurationResponse{Generation: 3, RemovedPublicKeys: []string{"key1"}}, nil).Once()
+
+		client := pb.NewMockAPIServerClient(t)
+		client.EXPECT().GetGatewayConfiguration(mock.Anything, mock.Anything, mock.Anything).Return(stream, nil)
+
+		netConf := wireguard.NewMockNetworkConfigurer(t)
+		netConf.EXPECT().ApplyWireGuardConfig(mock.Anything).Return(nil).Once()
+		netConf.EXPECT().ForwardRoutesV4(mock.Anything).Return(nil).Once()
+		netConf.EXPECT().ForwardRoutesV6(mock.Anything).Return(nil).Once()
+
+		gwLogger := logrus.StandardLogger().WithField("component", "gateway-agent")
+		err := gateway_agent.SyncFromStream(ctx, gwLogger, name, password, nil, client, netConf)
+
+		assert.ErrorIs(t, err, gateway_agent.ErrGenerationGap)
+	})
 }
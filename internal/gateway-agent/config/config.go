@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/netip"
 
+	"github.com/nais/device/internal/tlsconfig"
 	"github.com/nais/device/internal/wireguard"
 	"github.com/nais/device/pkg/pb"
 )
@@ -28,6 +29,7 @@ type Config struct {
 	WireGuardIPv4       *netip.Prefix `ignored:"true"`
 	WireGuardIPv6       *netip.Prefix `ignored:"true"`
 	AutoEnroll          bool
+	APIServerTLS        tlsconfig.Client
 }
 
 func DefaultConfig() Config {
@@ -78,7 +80,10 @@ func (c Config) ValidateWireGuard() error {
 	}
 
 	err = check("apiserver-endpoint", c.APIServerEndpoint)
-	err = check("apiserver-password", c.APIServerPassword)
+	// gateways with a client certificate are authenticated by it instead of the password
+	if c.APIServerTLS.CertFile == "" {
+		err = check("apiserver-password", c.APIServerPassword)
+	}
 	err = check("apiserver-public-key", c.APIServerPublicKey)
 	err = check("apiserver-private-ip", c.APIServerPrivateIP)
 	err = check("device-ip", c.DeviceIPv4)
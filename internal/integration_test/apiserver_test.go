@@ -19,7 +19,7 @@ func NewAPIServer(t *testing.T, ctx context.Context, log *logrus.Entry, db datab
 	gatewayAuth := auth.NewMockAPIKeyAuthenticator()
 
 	impl := api.NewGRPCServer(ctx, log, db, deviceAuth, nil, gatewayAuth, nil, sessions, kolideClient, true)
-	server := grpc.NewServer()
+	server := grpc.NewServer(impl.ServerOptions()...)
 	pb.RegisterAPIServerServer(server, impl)
 
 	return server
@@ -6,6 +6,7 @@ import (
 	"net/netip"
 
 	"github.com/nais/device/internal/ioconvenience"
+	"github.com/nais/device/internal/tlsconfig"
 )
 
 type Config struct {
@@ -24,6 +25,7 @@ type Config struct {
 	WireGuardConfigPath string
 	WireGuardIPv4       *netip.Prefix `ignored:"true"`
 	WireGuardIPv6       *netip.Prefix `ignored:"true"`
+	APIServerTLS        tlsconfig.Client
 }
 
 func DefaultConfig() Config {
@@ -0,0 +1,61 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Client is the TLS configuration of a gRPC client of the apiserver.
+type Client struct {
+	// Enabled connects with TLS. Setting a CA or client certificate enables TLS as well.
+	Enabled bool
+	// CAFile is a CA bundle for verifying the server, instead of the system roots.
+	CAFile string
+	// CertFile and KeyFile are a client certificate presented to the server. They are reloaded when they change.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the name the server certificate is verified against, e.g. when dialing an IP address.
+	ServerName string
+}
+
+func (c Client) enabled() bool {
+	return c.Enabled || c.CAFile != "" || c.CertFile != ""
+}
+
+// TransportCredentials returns TLS credentials for dialing the apiserver, or insecure credentials if TLS is not enabled.
+func (c Client) TransportCredentials() (credentials.TransportCredentials, error) {
+	if !c.enabled() {
+		return insecure.NewCredentials(), nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.CAFile != "" {
+		ca, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = ca
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		certificate, err := NewReloader(c.CertFile, c.KeyFile, "")
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		config.GetClientCertificate = func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			// handshakes are rare, so look for a renewed certificate on each of them.
+			// A certificate that fails to load is ignored, and the previous one presented instead.
+			_, _ = certificate.Reload()
+			return certificate.GetClientCertificate(info)
+		}
+	}
+
+	return credentials.NewTLS(config), nil
+}
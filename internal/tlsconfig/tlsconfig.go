@@ -0,0 +1,153 @@
+// Package tlsconfig sets up TLS for the gRPC servers and clients of naisdevice.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader serves a certificate and key, and optionally a CA bundle for verifying peers, from files that
+// may be replaced while running, e.g. by cert-manager. Call Reload to pick up new files.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	lock        sync.RWMutex
+	certificate *tls.Certificate
+	ca          *x509.CertPool
+	modified    time.Time
+}
+
+// NewReloader loads the certificate and key, and the CA bundle if caFile is set.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both certificate and key file must be set")
+	}
+
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload loads the files again if any of them has changed since the last load, and reports whether they were loaded.
+// The previous certificate is kept if the new files can not be loaded.
+func (r *Reloader) Reload() (bool, error) {
+	modified, err := lastModified(r.certFile, r.keyFile, r.caFile)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.RLock()
+	unchanged := r.certificate != nil && modified.Equal(r.modified)
+	r.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("load certificate: %w", err)
+	}
+
+	var ca *x509.CertPool
+	if r.caFile != "" {
+		ca, err = loadCertPool(r.caFile)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.certificate = &certificate
+	r.ca = ca
+	r.modified = modified
+
+	return true, nil
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.certificate, r.ca
+}
+
+// ServerConfig returns a server config presenting the current certificate. If a CA bundle is set,
+// clients may present a certificate issued by it, which is then verified. Clients without a certificate are still accepted,
+// and have to authenticate in other ways.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certificate, ca := r.current()
+
+			// this config replaces the one set up by the gRPC credentials, so it has to offer HTTP/2 itself
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*certificate},
+				ClientAuth:   tls.NoClientCert,
+				NextProtos:   []string{"h2"},
+			}
+			if ca != nil {
+				config.ClientCAs = ca
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+
+			return config, nil
+		},
+	}
+}
+
+// GetClientCertificate presents the current certificate as a client certificate.
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	certificate, _ := r.current()
+	return certificate, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in CA bundle %s", path)
+	}
+
+	return pool, nil
+}
+
+func lastModified(paths ...string) (time.Time, error) {
+	var modified time.Time
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if info.ModTime().After(modified) {
+			modified = info.ModTime()
+		}
+	}
+
+	return modified, nil
+}
@@ -0,0 +1,159 @@
+package tlsconfig_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nais/device/internal/tlsconfig"
+	"github.com/nais/device/internal/tlsconfig/tlsconfigtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+)
+
+// handshake connects client to a server using serverConfig, and returns the certificates each side verified of the other.
+func handshake(t *testing.T, serverConfig *tls.Config, client tlsconfig.Client) (serverCertificate, clientCertificate *x509.Certificate, err error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	accepted := make(chan *x509.Certificate, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			accepted <- nil
+			return
+		}
+		defer conn.Close()
+
+		tlsConn := conn.(*tls.Conn)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			accepted <- nil
+			return
+		}
+
+		state := tlsConn.ConnectionState()
+		if len(state.VerifiedChains) == 0 {
+			accepted <- nil
+			return
+		}
+		accepted <- state.VerifiedChains[0][0]
+	}()
+
+	transportCredentials, err := client.TransportCredentials()
+	require.NoError(t, err)
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tlsConn, authInfo, err := transportCredentials.ClientHandshake(ctx, "localhost", conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tlsConn.Close()
+
+	state := authInfo.(credentials.TLSInfo).State
+	return state.PeerCertificates[0], <-accepted, nil
+}
+
+func TestClientCertificates(t *testing.T) {
+	ca := tlsconfigtest.NewCA(t)
+	serverFiles := ca.Issue(t, "apiserver")
+	clientFiles := ca.Issue(t, "gateway-1")
+
+	server, err := tlsconfig.NewReloader(serverFiles.CertFile, serverFiles.KeyFile, ca.File)
+	require.NoError(t, err)
+
+	serverCertificate, clientCertificate, err := handshake(t, server.ServerConfig(), tlsconfig.Client{
+		CAFile:   ca.File,
+		CertFile: clientFiles.CertFile,
+		KeyFile:  clientFiles.KeyFile,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "apiserver", serverCertificate.Subject.CommonName)
+	require.NotNil(t, clientCertificate)
+	assert.Equal(t, "gateway-1", clientCertificate.Subject.CommonName)
+
+	// clients without a certificate authenticate in other ways
+	_, clientCertificate, err = handshake(t, server.ServerConfig(), tlsconfig.Client{CAFile: ca.File})
+	require.NoError(t, err)
+	assert.Nil(t, clientCertificate)
+
+	// certificates from other CAs are rejected by the server. With TLS 1.3 the client may finish its
+	// handshake before that, so only the server side is checked
+	otherFiles := tlsconfigtest.NewCA(t).Issue(t, "gateway-1")
+	_, clientCertificate, _ = handshake(t, server.ServerConfig(), tlsconfig.Client{
+		CAFile:   ca.File,
+		CertFile: otherFiles.CertFile,
+		KeyFile:  otherFiles.KeyFile,
+	})
+	assert.Nil(t, clientCertificate)
+}
+
+func TestServerRequiresTrustedCertificate(t *testing.T) {
+	ca := tlsconfigtest.NewCA(t)
+	serverFiles := ca.Issue(t, "apiserver")
+
+	server, err := tlsconfig.NewReloader(serverFiles.CertFile, serverFiles.KeyFile, "")
+	require.NoError(t, err)
+
+	// the test CA is not among the system roots
+	_, _, err = handshake(t, server.ServerConfig(), tlsconfig.Client{Enabled: true})
+	assert.Error(t, err)
+}
+
+func TestReload(t *testing.T) {
+	ca := tlsconfigtest.NewCA(t)
+	serverFiles := ca.Issue(t, "apiserver")
+
+	server, err := tlsconfig.NewReloader(serverFiles.CertFile, serverFiles.KeyFile, "")
+	require.NoError(t, err)
+
+	reloaded, err := server.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// replace the files, as e.g. cert-manager does when renewing the certificate
+	renewedFiles := ca.Issue(t, "renewed-apiserver")
+	modified := time.Now().Add(time.Minute)
+	for from, to := range map[string]string{
+		renewedFiles.CertFile: serverFiles.CertFile,
+		renewedFiles.KeyFile:  serverFiles.KeyFile,
+	} {
+		data, err := os.ReadFile(from)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(to, data, 0o600))
+		require.NoError(t, os.Chtimes(to, modified, modified))
+	}
+
+	reloaded, err = server.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+
+	serverCertificate, _, err := handshake(t, server.ServerConfig(), tlsconfig.Client{CAFile: ca.File})
+	require.NoError(t, err)
+	assert.Equal(t, "renewed-apiserver", serverCertificate.Subject.CommonName)
+
+	// a broken certificate is not loaded, and the previous one is still served
+	modified = modified.Add(time.Minute)
+	require.NoError(t, os.WriteFile(serverFiles.CertFile, []byte("garbage"), 0o600))
+	require.NoError(t, os.Chtimes(serverFiles.CertFile, modified, modified))
+
+	_, err = server.Reload()
+	assert.Error(t, err)
+
+	serverCertificate, _, err = handshake(t, server.ServerConfig(), tlsconfig.Client{CAFile: ca.File})
+	require.NoError(t, err)
+	assert.Equal(t, "renewed-apiserver", serverCertificate.Subject.CommonName)
+}
@@ -28,6 +28,27 @@ func CastPeerList[T Peer](peers []T) []Peer {
 	return result
 }
 
+// updatePeers returns peers with the peers in remove taken out, and the peers in upsert added or replaced,
+// matching on public key.
+func updatePeers(peers, upsert []Peer, remove []string) []Peer {
+	drop := make(map[string]struct{}, len(remove)+len(upsert))
+	for _, publicKey := range remove {
+		drop[publicKey] = struct{}{}
+	}
+	for _, peer := range upsert {
+		drop[peer.GetPublicKey()] = struct{}{}
+	}
+
+	result := make([]Peer, 0, len(peers)+len(upsert))
+	for _, peer := range peers {
+		if _, ok := drop[peer.GetPublicKey()]; !ok {
+			result = append(result, peer)
+		}
+	}
+
+	return append(result, upsert...)
+}
+
 type Config struct {
 	AddressV4  string
 	AddressV6  string
@@ -2,6 +2,7 @@ package wireguard
 
 type NetworkConfigurer interface {
 	ApplyWireGuardConfig(peers []Peer) error
+	UpdateWireGuardPeers(upsert []Peer, remove []string) error
 	ForwardRoutesV4(routes []string) error
 	ForwardRoutesV6(routes []string) error
 	SetupInterface() error
@@ -276,3 +276,60 @@ func (_c *MockNetworkConfigurer_SetupInterface_Call) RunAndReturn(run func() err
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateWireGuardPeers provides a mock function for the type MockNetworkConfigurer
+func (_mock *MockNetworkConfigurer) UpdateWireGuardPeers(upsert []Peer, remove []string) error {
+	ret := _mock.Called(upsert, remove)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWireGuardPeers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]Peer, []string) error); ok {
+		r0 = returnFunc(upsert, remove)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNetworkConfigurer_UpdateWireGuardPeers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWireGuardPeers'
+type MockNetworkConfigurer_UpdateWireGuardPeers_Call struct {
+	*mock.Call
+}
+
+// UpdateWireGuardPeers is a helper method to define mock.On call
+//   - upsert []Peer
+//   - remove []string
+func (_e *MockNetworkConfigurer_Expecter) UpdateWireGuardPeers(upsert interface{}, remove interface{}) *MockNetworkConfigurer_UpdateWireGuardPeers_Call {
+	return &MockNetworkConfigurer_UpdateWireGuardPeers_Call{Call: _e.mock.On("UpdateWireGuardPeers", upsert, remove)}
+}
+
+func (_c *MockNetworkConfigurer_UpdateWireGuardPeers_Call) Run(run func(upsert []Peer, remove []string)) *MockNetworkConfigurer_UpdateWireGuardPeers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []Peer
+		if args[0] != nil {
+			arg0 = args[0].([]Peer)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNetworkConfigurer_UpdateWireGuardPeers_Call) Return(err error) *MockNetworkConfigurer_UpdateWireGuardPeers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNetworkConfigurer_UpdateWireGuardPeers_Call) RunAndReturn(run func(upsert []Peer, remove []string) error) *MockNetworkConfigurer_UpdateWireGuardPeers_Call {
+	_c.Call.Return(run)
+	return _c
+}
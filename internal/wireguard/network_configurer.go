@@ -8,6 +8,7 @@ import (
 	"net/netip"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/google/gopacket/routing"
@@ -169,3 +170,36 @@ func (nc *networkConfigurer) ApplyWireGuardConfig(peers []Peer) error {
 
 	return nil
 }
+
+// UpdateWireGuardPeers adds, changes and removes individual peers on the running interface using `wg set`,
+// without rewriting the config file or touching any other peers.
+func (nc *networkConfigurer) UpdateWireGuardPeers(upsert []Peer, remove []string) error {
+	if len(upsert) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	args := []string{"set", nc.wireguardInterface}
+	for _, publicKey := range remove {
+		args = append(args, "peer", publicKey, "remove")
+	}
+	for _, peer := range upsert {
+		args = append(args, "peer", peer.GetPublicKey(), "allowed-ips", strings.Join(peer.GetAllowedIPs(), ","))
+		if endpoint := peer.GetEndpoint(); endpoint != "" {
+			args = append(args, "endpoint", endpoint)
+		}
+	}
+
+	cmd := exec.Command("wg", args...)
+	nc.log.Debugln(cmd.String())
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("update WireGuard peers: %w: out: %v", err, string(out))
+	}
+
+	nc.config.Peers = updatePeers(nc.config.Peers, upsert, remove)
+
+	nc.log.WithField("upserted", len(upsert)).WithField("removed", len(remove)).Debug("updated WireGuard peers")
+
+	return nil
+}
@@ -20,6 +20,11 @@ func (n *noopConfigurer) ApplyWireGuardConfig(peers []Peer) error {
 	return nil
 }
 
+func (n *noopConfigurer) UpdateWireGuardPeers(upsert []Peer, remove []string) error {
+	n.log.WithField("num_upsert", len(upsert)).WithField("num_remove", len(remove)).Debug("updating WireGuard peers")
+	return nil
+}
+
 func (n *noopConfigurer) ForwardRoutesV4(routes []string) error {
 	n.log.WithField("num_routes", len(routes)).Debug("applying forwarding routes")
 	for _, route := range routes {
@@ -6,6 +6,9 @@ const timeFormat = "15:04:05"
 // IssueTitleDosDontsNotAccepted is the canonical title for the Do's and don'ts acceptance issue.
 const IssueTitleDosDontsNotAccepted = "Do's and don'ts not accepted"
 
+// IssueTitleAgentOutdated is the canonical title for the issue raised when the agent is older than the minimum version.
+const IssueTitleAgentOutdated = "naisdevice is outdated"
+
 // ConnectionStateString returns a  human-friendly connection status
 func (x *AgentStatus) ConnectionStateString() string {
 	switch x.ConnectionState {
@@ -13,8 +16,13 @@ func (x *AgentStatus) ConnectionStateString() string {
 		return "Bootstrapping device"
 	case AgentState_Unhealthy:
 		for _, issue := range x.Issues {
-			if issue.GetTitle() == IssueTitleDosDontsNotAccepted {
+			switch issue.GetTitle() {
+			case IssueTitleDosDontsNotAccepted:
 				return "No access: accept Do's and don'ts"
+			case IssueTitleAgentOutdated:
+				if AfterGracePeriod(issue) {
+					return "No access: update naisdevice"
+				}
 			}
 		}
 
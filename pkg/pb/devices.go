@@ -24,8 +24,19 @@ func (x *Device) GetEndpoint() string {
 	return ""
 }
 
+// AfterGracePeriod reports whether the issue makes the device unhealthy. Suppressed issues never do.
 func AfterGracePeriod(d *DeviceIssue) bool {
-	return time.Now().After(d.GetResolveBefore().AsTime())
+	return !d.Suppressed() && time.Now().After(d.GetResolveBefore().AsTime())
+}
+
+// Suppressed reports whether the issue is covered by an exemption that has not expired or been revoked.
+func (x *DeviceIssue) Suppressed() bool {
+	exemption := x.GetExemption()
+	if exemption == nil || exemption.GetRevoked() != nil {
+		return false
+	}
+
+	return time.Now().Before(exemption.GetExpires().AsTime())
 }
 
 func (x *Device) Healthy() bool {
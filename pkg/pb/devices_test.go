@@ -0,0 +1,41 @@
+package pb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHealthyWithSuppressedIssues(t *testing.T) {
+	overdue := func(exemption *pb.IssueExemption) *pb.DeviceIssue {
+		return &pb.DeviceIssue{
+			Title:         "overdue",
+			ResolveBefore: timestamppb.New(time.Now().Add(-time.Hour)),
+			Exemption:     exemption,
+		}
+	}
+	later := timestamppb.New(time.Now().Add(time.Hour))
+	earlier := timestamppb.New(time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name    string
+		issue   *pb.DeviceIssue
+		healthy bool
+	}{
+		{"not exempted", overdue(nil), false},
+		{"exempted", overdue(&pb.IssueExemption{Expires: later}), true},
+		{"exemption expired", overdue(&pb.IssueExemption{Expires: earlier}), false},
+		{"exemption revoked", overdue(&pb.IssueExemption{Expires: later, Revoked: earlier}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := &pb.Device{Issues: []*pb.DeviceIssue{tt.issue}}
+			assert.Equal(t, tt.healthy, device.Healthy())
+			assert.Equal(t, tt.healthy, tt.issue.Suppressed())
+		})
+	}
+}
@@ -48,6 +48,7 @@ func (x *Gateway) Equal(other *Gateway) bool {
 		x.GetEndpoint() == other.GetEndpoint() &&
 		x.GetPublicKey() == other.GetPublicKey() &&
 		x.GetRequiresPrivilegedAccess() == other.GetRequiresPrivilegedAccess() &&
+		x.GetRequiresApproval() == other.GetRequiresApproval() &&
 		slices.Equal(x.GetRoutesIPv4(), other.GetRoutesIPv4()) &&
 		slices.Equal(x.GetRoutesIPv6(), other.GetRoutesIPv6()) &&
 		slices.Equal(x.GetAllowedIPs(), other.GetAllowedIPs()) &&
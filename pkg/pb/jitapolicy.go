@@ -0,0 +1,35 @@
+package pb
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// jitaClockSkew is added to the maximum duration, as the expiry is calculated from the client's clock.
+const jitaClockSkew = time.Minute
+
+// CheckRequest returns an error describing how a grant with the given duration and reason violates the policy.
+// A nil policy allows everything.
+func (x *JitaPolicy) CheckRequest(duration time.Duration, reason string) error {
+	if maxDuration := x.GetMaxDuration().AsDuration(); maxDuration > 0 && duration > maxDuration+jitaClockSkew {
+		return fmt.Errorf("duration %v exceeds the maximum of %v", duration.Round(time.Minute), maxDuration)
+	}
+
+	if minLength := int(x.GetMinReasonLength()); len([]rune(reason)) < minLength {
+		return fmt.Errorf("reason must be at least %d characters", minLength)
+	}
+
+	if pattern := x.GetReasonPattern(); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid reason pattern %q: %w", pattern, err)
+		}
+
+		if !re.MatchString(reason) {
+			return fmt.Errorf("reason must match %q", pattern)
+		}
+	}
+
+	return nil
+}
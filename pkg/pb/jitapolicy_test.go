@@ -0,0 +1,26 @@
+package pb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/device/pkg/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestJitaPolicy_CheckRequest(t *testing.T) {
+	var nilPolicy *pb.JitaPolicy
+	assert.NoError(t, nilPolicy.CheckRequest(24*time.Hour, ""))
+
+	policy := &pb.JitaPolicy{
+		MaxDuration:     durationpb.New(4 * time.Hour),
+		MinReasonLength: 10,
+		ReasonPattern:   `INC-\d+`,
+	}
+
+	assert.NoError(t, policy.CheckRequest(4*time.Hour, "fixing INC-1234"))
+	assert.EqualError(t, policy.CheckRequest(5*time.Hour, "fixing INC-1234"), "duration 5h0m0s exceeds the maximum of 4h0m0s")
+	assert.EqualError(t, policy.CheckRequest(time.Hour, "INC-1"), "reason must be at least 10 characters")
+	assert.EqualError(t, policy.CheckRequest(time.Hour, "fixing stuff"), `reason must match "INC-\\d+"`)
+}
@@ -16,7 +16,8 @@ import (
 func NewMockAPIServerClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockAPIServerClient {
+},
+) *MockAPIServerClient {
 	mock := &MockAPIServerClient{}
 	mock.Mock.Test(t)
 
@@ -38,6 +39,267 @@ func (_m *MockAPIServerClient) EXPECT() *MockAPIServerClient_Expecter {
 	return &MockAPIServerClient_Expecter{mock: &_m.Mock}
 }
 
+// CreateIssueExemption provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) CreateIssueExemption(ctx context.Context, in *CreateIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIssueExemption")
+	}
+
+	var r0 *IssueExemption
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreateIssueExemptionRequest, ...grpc.CallOption) (*IssueExemption, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreateIssueExemptionRequest, ...grpc.CallOption) *IssueExemption); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*IssueExemption)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *CreateIssueExemptionRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_CreateIssueExemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIssueExemption'
+type MockAPIServerClient_CreateIssueExemption_Call struct {
+	*mock.Call
+}
+
+// CreateIssueExemption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *CreateIssueExemptionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) CreateIssueExemption(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_CreateIssueExemption_Call {
+	return &MockAPIServerClient_CreateIssueExemption_Call{Call: _e.mock.On("CreateIssueExemption",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_CreateIssueExemption_Call) Run(run func(ctx context.Context, in *CreateIssueExemptionRequest, opts ...grpc.CallOption)) *MockAPIServerClient_CreateIssueExemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CreateIssueExemptionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*CreateIssueExemptionRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_CreateIssueExemption_Call) Return(issueExemption *IssueExemption, err error) *MockAPIServerClient_CreateIssueExemption_Call {
+	_c.Call.Return(issueExemption, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_CreateIssueExemption_Call) RunAndReturn(run func(ctx context.Context, in *CreateIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error)) *MockAPIServerClient_CreateIssueExemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDevice provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDevice")
+	}
+
+	var r0 *DeleteDeviceResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeleteDeviceRequest, ...grpc.CallOption) (*DeleteDeviceResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeleteDeviceRequest, ...grpc.CallOption) *DeleteDeviceResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeleteDeviceResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *DeleteDeviceRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_DeleteDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDevice'
+type MockAPIServerClient_DeleteDevice_Call struct {
+	*mock.Call
+}
+
+// DeleteDevice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *DeleteDeviceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) DeleteDevice(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_DeleteDevice_Call {
+	return &MockAPIServerClient_DeleteDevice_Call{Call: _e.mock.On("DeleteDevice",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_DeleteDevice_Call) Run(run func(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption)) *MockAPIServerClient_DeleteDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *DeleteDeviceRequest
+		if args[1] != nil {
+			arg1 = args[1].(*DeleteDeviceRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_DeleteDevice_Call) Return(deleteDeviceResponse *DeleteDeviceResponse, err error) *MockAPIServerClient_DeleteDevice_Call {
+	_c.Call.Return(deleteDeviceResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_DeleteDevice_Call) RunAndReturn(run func(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error)) *MockAPIServerClient_DeleteDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGateway provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) DeleteGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGateway")
+	}
+
+	var r0 *DeleteGatewayResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) (*DeleteGatewayResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) *DeleteGatewayResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeleteGatewayResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_DeleteGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGateway'
+type MockAPIServerClient_DeleteGateway_Call struct {
+	*mock.Call
+}
+
+// DeleteGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ModifyGatewayRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) DeleteGateway(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_DeleteGateway_Call {
+	return &MockAPIServerClient_DeleteGateway_Call{Call: _e.mock.On("DeleteGateway",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_DeleteGateway_Call) Run(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption)) *MockAPIServerClient_DeleteGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ModifyGatewayRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ModifyGatewayRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_DeleteGateway_Call) Return(deleteGatewayResponse *DeleteGatewayResponse, err error) *MockAPIServerClient_DeleteGateway_Call {
+	_c.Call.Return(deleteGatewayResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_DeleteGateway_Call) RunAndReturn(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error)) *MockAPIServerClient_DeleteGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnrollGateway provides a mock function for the type MockAPIServerClient
 func (_mock *MockAPIServerClient) EnrollGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*ModifyGatewayResponse, error) {
 	// grpc.CallOption
@@ -212,8 +474,8 @@ func (_c *MockAPIServerClient_GetAcceptableUseAcceptedAt_Call) RunAndReturn(run
 	return _c
 }
 
-// GetDeviceConfiguration provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetDeviceConfiguration(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error) {
+// GetDevice provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -225,22 +487,22 @@ func (_mock *MockAPIServerClient) GetDeviceConfiguration(ctx context.Context, in
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDeviceConfiguration")
+		panic("no return value specified for GetDevice")
 	}
 
-	var r0 grpc.ServerStreamingClient[GetDeviceConfigurationResponse]
+	var r0 *Device
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceRequest, ...grpc.CallOption) (*Device, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) grpc.ServerStreamingClient[GetDeviceConfigurationResponse]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceRequest, ...grpc.CallOption) *Device); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(grpc.ServerStreamingClient[GetDeviceConfigurationResponse])
+			r0 = ret.Get(0).(*Device)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetDeviceRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -248,29 +510,29 @@ func (_mock *MockAPIServerClient) GetDeviceConfiguration(ctx context.Context, in
 	return r0, r1
 }
 
-// MockAPIServerClient_GetDeviceConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceConfiguration'
-type MockAPIServerClient_GetDeviceConfiguration_Call struct {
+// MockAPIServerClient_GetDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDevice'
+type MockAPIServerClient_GetDevice_Call struct {
 	*mock.Call
 }
 
-// GetDeviceConfiguration is a helper method to define mock.On call
+// GetDevice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetDeviceConfigurationRequest
+//   - in *GetDeviceRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetDeviceConfiguration(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetDeviceConfiguration_Call {
-	return &MockAPIServerClient_GetDeviceConfiguration_Call{Call: _e.mock.On("GetDeviceConfiguration",
+func (_e *MockAPIServerClient_Expecter) GetDevice(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetDevice_Call {
+	return &MockAPIServerClient_GetDevice_Call{Call: _e.mock.On("GetDevice",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) Run(run func(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetDeviceConfiguration_Call {
+func (_c *MockAPIServerClient_GetDevice_Call) Run(run func(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetDeviceConfigurationRequest
+		var arg1 *GetDeviceRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetDeviceConfigurationRequest)
+			arg1 = args[1].(*GetDeviceRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -289,18 +551,18 @@ func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) Return(serverStreamingClient grpc.ServerStreamingClient[GetDeviceConfigurationResponse], err error) *MockAPIServerClient_GetDeviceConfiguration_Call {
-	_c.Call.Return(serverStreamingClient, err)
+func (_c *MockAPIServerClient_GetDevice_Call) Return(device *Device, err error) *MockAPIServerClient_GetDevice_Call {
+	_c.Call.Return(device, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) RunAndReturn(run func(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error)) *MockAPIServerClient_GetDeviceConfiguration_Call {
+func (_c *MockAPIServerClient_GetDevice_Call) RunAndReturn(run func(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error)) *MockAPIServerClient_GetDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGateway provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*Gateway, error) {
+// GetDeviceConfiguration provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetDeviceConfiguration(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -312,22 +574,1414 @@ func (_mock *MockAPIServerClient) GetGateway(ctx context.Context, in *ModifyGate
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGateway")
+		panic("no return value specified for GetDeviceConfiguration")
+	}
+
+	var r0 grpc.ServerStreamingClient[GetDeviceConfigurationResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) grpc.ServerStreamingClient[GetDeviceConfigurationResponse]); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[GetDeviceConfigurationResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetDeviceConfigurationRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetDeviceConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeviceConfiguration'
+type MockAPIServerClient_GetDeviceConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetDeviceConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetDeviceConfigurationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetDeviceConfiguration(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetDeviceConfiguration_Call {
+	return &MockAPIServerClient_GetDeviceConfiguration_Call{Call: _e.mock.On("GetDeviceConfiguration",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) Run(run func(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetDeviceConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetDeviceConfigurationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetDeviceConfigurationRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) Return(serverStreamingClient grpc.ServerStreamingClient[GetDeviceConfigurationResponse], err error) *MockAPIServerClient_GetDeviceConfiguration_Call {
+	_c.Call.Return(serverStreamingClient, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetDeviceConfiguration_Call) RunAndReturn(run func(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error)) *MockAPIServerClient_GetDeviceConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGateway provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*Gateway, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGateway")
+	}
+
+	var r0 *Gateway
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) (*Gateway, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) *Gateway); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Gateway)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGateway'
+type MockAPIServerClient_GetGateway_Call struct {
+	*mock.Call
+}
+
+// GetGateway is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ModifyGatewayRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetGateway(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGateway_Call {
+	return &MockAPIServerClient_GetGateway_Call{Call: _e.mock.On("GetGateway",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetGateway_Call) Run(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGateway_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ModifyGatewayRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ModifyGatewayRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGateway_Call) Return(gateway *Gateway, err error) *MockAPIServerClient_GetGateway_Call {
+	_c.Call.Return(gateway, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGateway_Call) RunAndReturn(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*Gateway, error)) *MockAPIServerClient_GetGateway_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGatewayConfiguration provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetGatewayConfiguration(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGatewayConfiguration")
+	}
+
+	var r0 grpc.ServerStreamingClient[GetGatewayConfigurationResponse]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) grpc.ServerStreamingClient[GetGatewayConfigurationResponse]); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[GetGatewayConfigurationResponse])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetGatewayConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayConfiguration'
+type MockAPIServerClient_GetGatewayConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetGatewayConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetGatewayConfigurationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetGatewayConfiguration(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGatewayConfiguration_Call {
+	return &MockAPIServerClient_GetGatewayConfiguration_Call{Call: _e.mock.On("GetGatewayConfiguration",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) Run(run func(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGatewayConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetGatewayConfigurationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetGatewayConfigurationRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) Return(serverStreamingClient grpc.ServerStreamingClient[GetGatewayConfigurationResponse], err error) *MockAPIServerClient_GetGatewayConfiguration_Call {
+	_c.Call.Return(serverStreamingClient, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) RunAndReturn(run func(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error)) *MockAPIServerClient_GetGatewayConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGatewayJitaGrantsForUser provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetGatewayJitaGrantsForUser(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGatewayJitaGrantsForUser")
+	}
+
+	var r0 *GetGatewayJitaGrantsForUserResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) *GetGatewayJitaGrantsForUserResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetGatewayJitaGrantsForUserResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetGatewayJitaGrantsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayJitaGrantsForUser'
+type MockAPIServerClient_GetGatewayJitaGrantsForUser_Call struct {
+	*mock.Call
+}
+
+// GetGatewayJitaGrantsForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetGatewayJitaGrantsForUserRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetGatewayJitaGrantsForUser(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+	return &MockAPIServerClient_GetGatewayJitaGrantsForUser_Call{Call: _e.mock.On("GetGatewayJitaGrantsForUser",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetGatewayJitaGrantsForUserRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetGatewayJitaGrantsForUserRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) Return(getGatewayJitaGrantsForUserResponse *GetGatewayJitaGrantsForUserResponse, err error) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+	_c.Call.Return(getGatewayJitaGrantsForUserResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) RunAndReturn(run func(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error)) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKolideCache provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetKolideCache(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKolideCache")
+	}
+
+	var r0 *GetKolideCacheResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) (*GetKolideCacheResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) *GetKolideCacheResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetKolideCacheResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetKolideCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKolideCache'
+type MockAPIServerClient_GetKolideCache_Call struct {
+	*mock.Call
+}
+
+// GetKolideCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetKolideCacheRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetKolideCache(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetKolideCache_Call {
+	return &MockAPIServerClient_GetKolideCache_Call{Call: _e.mock.On("GetKolideCache",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetKolideCache_Call) Run(run func(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetKolideCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetKolideCacheRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetKolideCacheRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetKolideCache_Call) Return(getKolideCacheResponse *GetKolideCacheResponse, err error) *MockAPIServerClient_GetKolideCache_Call {
+	_c.Call.Return(getKolideCacheResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetKolideCache_Call) RunAndReturn(run func(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error)) *MockAPIServerClient_GetKolideCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingPrivilegedGatewayAccessRequests provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetPendingPrivilegedGatewayAccessRequests(ctx context.Context, in *GetPendingPrivilegedGatewayAccessRequestsRequest, opts ...grpc.CallOption) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingPrivilegedGatewayAccessRequests")
+	}
+
+	var r0 *GetPendingPrivilegedGatewayAccessRequestsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPendingPrivilegedGatewayAccessRequestsRequest, ...grpc.CallOption) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPendingPrivilegedGatewayAccessRequestsRequest, ...grpc.CallOption) *GetPendingPrivilegedGatewayAccessRequestsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetPendingPrivilegedGatewayAccessRequestsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetPendingPrivilegedGatewayAccessRequestsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingPrivilegedGatewayAccessRequests'
+type MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call struct {
+	*mock.Call
+}
+
+// GetPendingPrivilegedGatewayAccessRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetPendingPrivilegedGatewayAccessRequestsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetPendingPrivilegedGatewayAccessRequests(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call {
+	return &MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call{Call: _e.mock.On("GetPendingPrivilegedGatewayAccessRequests",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call) Run(run func(ctx context.Context, in *GetPendingPrivilegedGatewayAccessRequestsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetPendingPrivilegedGatewayAccessRequestsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetPendingPrivilegedGatewayAccessRequestsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call) Return(getPendingPrivilegedGatewayAccessRequestsResponse *GetPendingPrivilegedGatewayAccessRequestsResponse, err error) *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call {
+	_c.Call.Return(getPendingPrivilegedGatewayAccessRequestsResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call) RunAndReturn(run func(ctx context.Context, in *GetPendingPrivilegedGatewayAccessRequestsRequest, opts ...grpc.CallOption) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error)) *MockAPIServerClient_GetPendingPrivilegedGatewayAccessRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPosturePolicy provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetPosturePolicy(ctx context.Context, in *GetPosturePolicyRequest, opts ...grpc.CallOption) (*GetPosturePolicyResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPosturePolicy")
+	}
+
+	var r0 *GetPosturePolicyResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPosturePolicyRequest, ...grpc.CallOption) (*GetPosturePolicyResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPosturePolicyRequest, ...grpc.CallOption) *GetPosturePolicyResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetPosturePolicyResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetPosturePolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetPosturePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPosturePolicy'
+type MockAPIServerClient_GetPosturePolicy_Call struct {
+	*mock.Call
+}
+
+// GetPosturePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetPosturePolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetPosturePolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetPosturePolicy_Call {
+	return &MockAPIServerClient_GetPosturePolicy_Call{Call: _e.mock.On("GetPosturePolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetPosturePolicy_Call) Run(run func(ctx context.Context, in *GetPosturePolicyRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetPosturePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetPosturePolicyRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetPosturePolicyRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPosturePolicy_Call) Return(getPosturePolicyResponse *GetPosturePolicyResponse, err error) *MockAPIServerClient_GetPosturePolicy_Call {
+	_c.Call.Return(getPosturePolicyResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPosturePolicy_Call) RunAndReturn(run func(ctx context.Context, in *GetPosturePolicyRequest, opts ...grpc.CallOption) (*GetPosturePolicyResponse, error)) *MockAPIServerClient_GetPosturePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegedGatewayAccessPolicy provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetPrivilegedGatewayAccessPolicy(ctx context.Context, in *GetPrivilegedGatewayAccessPolicyRequest, opts ...grpc.CallOption) (*GetPrivilegedGatewayAccessPolicyResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegedGatewayAccessPolicy")
+	}
+
+	var r0 *GetPrivilegedGatewayAccessPolicyResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPrivilegedGatewayAccessPolicyRequest, ...grpc.CallOption) (*GetPrivilegedGatewayAccessPolicyResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPrivilegedGatewayAccessPolicyRequest, ...grpc.CallOption) *GetPrivilegedGatewayAccessPolicyResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetPrivilegedGatewayAccessPolicyResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetPrivilegedGatewayAccessPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegedGatewayAccessPolicy'
+type MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegedGatewayAccessPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetPrivilegedGatewayAccessPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetPrivilegedGatewayAccessPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call {
+	return &MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call{Call: _e.mock.On("GetPrivilegedGatewayAccessPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call) Run(run func(ctx context.Context, in *GetPrivilegedGatewayAccessPolicyRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetPrivilegedGatewayAccessPolicyRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetPrivilegedGatewayAccessPolicyRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call) Return(getPrivilegedGatewayAccessPolicyResponse *GetPrivilegedGatewayAccessPolicyResponse, err error) *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call {
+	_c.Call.Return(getPrivilegedGatewayAccessPolicyResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call) RunAndReturn(run func(ctx context.Context, in *GetPrivilegedGatewayAccessPolicyRequest, opts ...grpc.CallOption) (*GetPrivilegedGatewayAccessPolicyResponse, error)) *MockAPIServerClient_GetPrivilegedGatewayAccessPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessions provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 *GetSessionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) (*GetSessionsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) *GetSessionsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetSessionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
+type MockAPIServerClient_GetSessions_Call struct {
+	*mock.Call
+}
+
+// GetSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetSessionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetSessions(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetSessions_Call {
+	return &MockAPIServerClient_GetSessions_Call{Call: _e.mock.On("GetSessions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetSessions_Call) Run(run func(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetSessionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetSessionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetSessions_Call) Return(getSessionsResponse *GetSessionsResponse, err error) *MockAPIServerClient_GetSessions_Call {
+	_c.Call.Return(getSessionsResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetSessions_Call) RunAndReturn(run func(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error)) *MockAPIServerClient_GetSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWireGuardPrefixes provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GetWireGuardPrefixes(ctx context.Context, in *GetWireGuardPrefixesRequest, opts ...grpc.CallOption) (*GetWireGuardPrefixesResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWireGuardPrefixes")
+	}
+
+	var r0 *GetWireGuardPrefixesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetWireGuardPrefixesRequest, ...grpc.CallOption) (*GetWireGuardPrefixesResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetWireGuardPrefixesRequest, ...grpc.CallOption) *GetWireGuardPrefixesResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetWireGuardPrefixesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetWireGuardPrefixesRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GetWireGuardPrefixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWireGuardPrefixes'
+type MockAPIServerClient_GetWireGuardPrefixes_Call struct {
+	*mock.Call
+}
+
+// GetWireGuardPrefixes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetWireGuardPrefixesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GetWireGuardPrefixes(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetWireGuardPrefixes_Call {
+	return &MockAPIServerClient_GetWireGuardPrefixes_Call{Call: _e.mock.On("GetWireGuardPrefixes",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GetWireGuardPrefixes_Call) Run(run func(ctx context.Context, in *GetWireGuardPrefixesRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetWireGuardPrefixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetWireGuardPrefixesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetWireGuardPrefixesRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetWireGuardPrefixes_Call) Return(getWireGuardPrefixesResponse *GetWireGuardPrefixesResponse, err error) *MockAPIServerClient_GetWireGuardPrefixes_Call {
+	_c.Call.Return(getWireGuardPrefixesResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GetWireGuardPrefixes_Call) RunAndReturn(run func(ctx context.Context, in *GetWireGuardPrefixesRequest, opts ...grpc.CallOption) (*GetWireGuardPrefixesResponse, error)) *MockAPIServerClient_GetWireGuardPrefixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantPrivilegedGatewayAccess provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) GrantPrivilegedGatewayAccess(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantPrivilegedGatewayAccess")
+	}
+
+	var r0 *GrantPrivilegedGatewayAccessResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) *GrantPrivilegedGatewayAccessResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GrantPrivilegedGatewayAccessResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_GrantPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantPrivilegedGatewayAccess'
+type MockAPIServerClient_GrantPrivilegedGatewayAccess_Call struct {
+	*mock.Call
+}
+
+// GrantPrivilegedGatewayAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GrantPrivilegedGatewayAccessRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) GrantPrivilegedGatewayAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+	return &MockAPIServerClient_GrantPrivilegedGatewayAccess_Call{Call: _e.mock.On("GrantPrivilegedGatewayAccess",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GrantPrivilegedGatewayAccessRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GrantPrivilegedGatewayAccessRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) Return(grantPrivilegedGatewayAccessResponse *GrantPrivilegedGatewayAccessResponse, err error) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+	_c.Call.Return(grantPrivilegedGatewayAccessResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error)) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 *ListAuditEventsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListAuditEventsRequest, ...grpc.CallOption) (*ListAuditEventsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListAuditEventsRequest, ...grpc.CallOption) *ListAuditEventsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListAuditEventsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListAuditEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type MockAPIServerClient_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListAuditEventsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) ListAuditEvents(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListAuditEvents_Call {
+	return &MockAPIServerClient_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_ListAuditEvents_Call) Run(run func(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListAuditEventsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListAuditEventsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListAuditEvents_Call) Return(listAuditEventsResponse *ListAuditEventsResponse, err error) *MockAPIServerClient_ListAuditEvents_Call {
+	_c.Call.Return(listAuditEventsResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListAuditEvents_Call) RunAndReturn(run func(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)) *MockAPIServerClient_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDevices provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDevices")
+	}
+
+	var r0 *ListDevicesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListDevicesRequest, ...grpc.CallOption) (*ListDevicesResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListDevicesRequest, ...grpc.CallOption) *ListDevicesResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListDevicesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListDevicesRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_ListDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevices'
+type MockAPIServerClient_ListDevices_Call struct {
+	*mock.Call
+}
+
+// ListDevices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListDevicesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) ListDevices(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListDevices_Call {
+	return &MockAPIServerClient_ListDevices_Call{Call: _e.mock.On("ListDevices",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_ListDevices_Call) Run(run func(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListDevicesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListDevicesRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListDevices_Call) Return(listDevicesResponse *ListDevicesResponse, err error) *MockAPIServerClient_ListDevices_Call {
+	_c.Call.Return(listDevicesResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListDevices_Call) RunAndReturn(run func(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)) *MockAPIServerClient_ListDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGatewayJitaGrants provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ListGatewayJitaGrants(ctx context.Context, in *ListGatewayJitaGrantsRequest, opts ...grpc.CallOption) (*ListGatewayJitaGrantsResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGatewayJitaGrants")
+	}
+
+	var r0 *ListGatewayJitaGrantsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayJitaGrantsRequest, ...grpc.CallOption) (*ListGatewayJitaGrantsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayJitaGrantsRequest, ...grpc.CallOption) *ListGatewayJitaGrantsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListGatewayJitaGrantsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListGatewayJitaGrantsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_ListGatewayJitaGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGatewayJitaGrants'
+type MockAPIServerClient_ListGatewayJitaGrants_Call struct {
+	*mock.Call
+}
+
+// ListGatewayJitaGrants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListGatewayJitaGrantsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) ListGatewayJitaGrants(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListGatewayJitaGrants_Call {
+	return &MockAPIServerClient_ListGatewayJitaGrants_Call{Call: _e.mock.On("ListGatewayJitaGrants",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_ListGatewayJitaGrants_Call) Run(run func(ctx context.Context, in *ListGatewayJitaGrantsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListGatewayJitaGrants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListGatewayJitaGrantsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListGatewayJitaGrantsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListGatewayJitaGrants_Call) Return(listGatewayJitaGrantsResponse *ListGatewayJitaGrantsResponse, err error) *MockAPIServerClient_ListGatewayJitaGrants_Call {
+	_c.Call.Return(listGatewayJitaGrantsResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListGatewayJitaGrants_Call) RunAndReturn(run func(ctx context.Context, in *ListGatewayJitaGrantsRequest, opts ...grpc.CallOption) (*ListGatewayJitaGrantsResponse, error)) *MockAPIServerClient_ListGatewayJitaGrants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGateways provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ListGateways(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGateways")
+	}
+
+	var r0 grpc.ServerStreamingClient[Gateway]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) grpc.ServerStreamingClient[Gateway]); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[Gateway])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_ListGateways_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGateways'
+type MockAPIServerClient_ListGateways_Call struct {
+	*mock.Call
+}
+
+// ListGateways is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListGatewayRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) ListGateways(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListGateways_Call {
+	return &MockAPIServerClient_ListGateways_Call{Call: _e.mock.On("ListGateways",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_ListGateways_Call) Run(run func(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListGateways_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListGatewayRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListGatewayRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListGateways_Call) Return(serverStreamingClient grpc.ServerStreamingClient[Gateway], err error) *MockAPIServerClient_ListGateways_Call {
+	_c.Call.Return(serverStreamingClient, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListGateways_Call) RunAndReturn(run func(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error)) *MockAPIServerClient_ListGateways_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIssueExemptions provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ListIssueExemptions(ctx context.Context, in *ListIssueExemptionsRequest, opts ...grpc.CallOption) (*ListIssueExemptionsResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIssueExemptions")
+	}
+
+	var r0 *ListIssueExemptionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListIssueExemptionsRequest, ...grpc.CallOption) (*ListIssueExemptionsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListIssueExemptionsRequest, ...grpc.CallOption) *ListIssueExemptionsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListIssueExemptionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListIssueExemptionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIServerClient_ListIssueExemptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIssueExemptions'
+type MockAPIServerClient_ListIssueExemptions_Call struct {
+	*mock.Call
+}
+
+// ListIssueExemptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListIssueExemptionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockAPIServerClient_Expecter) ListIssueExemptions(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListIssueExemptions_Call {
+	return &MockAPIServerClient_ListIssueExemptions_Call{Call: _e.mock.On("ListIssueExemptions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockAPIServerClient_ListIssueExemptions_Call) Run(run func(ctx context.Context, in *ListIssueExemptionsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListIssueExemptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListIssueExemptionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListIssueExemptionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListIssueExemptions_Call) Return(listIssueExemptionsResponse *ListIssueExemptionsResponse, err error) *MockAPIServerClient_ListIssueExemptions_Call {
+	_c.Call.Return(listIssueExemptionsResponse, err)
+	return _c
+}
+
+func (_c *MockAPIServerClient_ListIssueExemptions_Call) RunAndReturn(run func(ctx context.Context, in *ListIssueExemptionsRequest, opts ...grpc.CallOption) (*ListIssueExemptionsResponse, error)) *MockAPIServerClient_ListIssueExemptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) Login(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption) (*APIServerLoginResponse, error) {
+	// grpc.CallOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
 	}
 
-	var r0 *Gateway
+	var r0 *APIServerLoginResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) (*Gateway, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) (*APIServerLoginResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) *Gateway); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) *APIServerLoginResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Gateway)
+			r0 = ret.Get(0).(*APIServerLoginResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *ModifyGatewayRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -335,29 +1989,29 @@ func (_mock *MockAPIServerClient) GetGateway(ctx context.Context, in *ModifyGate
 	return r0, r1
 }
 
-// MockAPIServerClient_GetGateway_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGateway'
-type MockAPIServerClient_GetGateway_Call struct {
+// MockAPIServerClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAPIServerClient_Login_Call struct {
 	*mock.Call
 }
 
-// GetGateway is a helper method to define mock.On call
+// Login is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *ModifyGatewayRequest
+//   - in *APIServerLoginRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetGateway(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGateway_Call {
-	return &MockAPIServerClient_GetGateway_Call{Call: _e.mock.On("GetGateway",
+func (_e *MockAPIServerClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_Login_Call {
+	return &MockAPIServerClient_Login_Call{Call: _e.mock.On("Login",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetGateway_Call) Run(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGateway_Call {
+func (_c *MockAPIServerClient_Login_Call) Run(run func(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption)) *MockAPIServerClient_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *ModifyGatewayRequest
+		var arg1 *APIServerLoginRequest
 		if args[1] != nil {
-			arg1 = args[1].(*ModifyGatewayRequest)
+			arg1 = args[1].(*APIServerLoginRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -376,18 +2030,18 @@ func (_c *MockAPIServerClient_GetGateway_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGateway_Call) Return(gateway *Gateway, err error) *MockAPIServerClient_GetGateway_Call {
-	_c.Call.Return(gateway, err)
+func (_c *MockAPIServerClient_Login_Call) Return(aPIServerLoginResponse *APIServerLoginResponse, err error) *MockAPIServerClient_Login_Call {
+	_c.Call.Return(aPIServerLoginResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGateway_Call) RunAndReturn(run func(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*Gateway, error)) *MockAPIServerClient_GetGateway_Call {
+func (_c *MockAPIServerClient_Login_Call) RunAndReturn(run func(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption) (*APIServerLoginResponse, error)) *MockAPIServerClient_Login_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGatewayConfiguration provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetGatewayConfiguration(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error) {
+// ReassignDevice provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ReassignDevice(ctx context.Context, in *ReassignDeviceRequest, opts ...grpc.CallOption) (*ReassignDeviceResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -399,22 +2053,22 @@ func (_mock *MockAPIServerClient) GetGatewayConfiguration(ctx context.Context, i
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGatewayConfiguration")
+		panic("no return value specified for ReassignDevice")
 	}
 
-	var r0 grpc.ServerStreamingClient[GetGatewayConfigurationResponse]
+	var r0 *ReassignDeviceResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReassignDeviceRequest, ...grpc.CallOption) (*ReassignDeviceResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) grpc.ServerStreamingClient[GetGatewayConfigurationResponse]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReassignDeviceRequest, ...grpc.CallOption) *ReassignDeviceResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(grpc.ServerStreamingClient[GetGatewayConfigurationResponse])
+			r0 = ret.Get(0).(*ReassignDeviceResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetGatewayConfigurationRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ReassignDeviceRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -422,29 +2076,29 @@ func (_mock *MockAPIServerClient) GetGatewayConfiguration(ctx context.Context, i
 	return r0, r1
 }
 
-// MockAPIServerClient_GetGatewayConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayConfiguration'
-type MockAPIServerClient_GetGatewayConfiguration_Call struct {
+// MockAPIServerClient_ReassignDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignDevice'
+type MockAPIServerClient_ReassignDevice_Call struct {
 	*mock.Call
 }
 
-// GetGatewayConfiguration is a helper method to define mock.On call
+// ReassignDevice is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetGatewayConfigurationRequest
+//   - in *ReassignDeviceRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetGatewayConfiguration(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGatewayConfiguration_Call {
-	return &MockAPIServerClient_GetGatewayConfiguration_Call{Call: _e.mock.On("GetGatewayConfiguration",
+func (_e *MockAPIServerClient_Expecter) ReassignDevice(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ReassignDevice_Call {
+	return &MockAPIServerClient_ReassignDevice_Call{Call: _e.mock.On("ReassignDevice",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) Run(run func(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGatewayConfiguration_Call {
+func (_c *MockAPIServerClient_ReassignDevice_Call) Run(run func(ctx context.Context, in *ReassignDeviceRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ReassignDevice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetGatewayConfigurationRequest
+		var arg1 *ReassignDeviceRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetGatewayConfigurationRequest)
+			arg1 = args[1].(*ReassignDeviceRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -463,18 +2117,18 @@ func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) Return(serverStreamingClient grpc.ServerStreamingClient[GetGatewayConfigurationResponse], err error) *MockAPIServerClient_GetGatewayConfiguration_Call {
-	_c.Call.Return(serverStreamingClient, err)
+func (_c *MockAPIServerClient_ReassignDevice_Call) Return(reassignDeviceResponse *ReassignDeviceResponse, err error) *MockAPIServerClient_ReassignDevice_Call {
+	_c.Call.Return(reassignDeviceResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGatewayConfiguration_Call) RunAndReturn(run func(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGatewayConfigurationResponse], error)) *MockAPIServerClient_GetGatewayConfiguration_Call {
+func (_c *MockAPIServerClient_ReassignDevice_Call) RunAndReturn(run func(ctx context.Context, in *ReassignDeviceRequest, opts ...grpc.CallOption) (*ReassignDeviceResponse, error)) *MockAPIServerClient_ReassignDevice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGatewayJitaGrantsForUser provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetGatewayJitaGrantsForUser(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error) {
+// RenewSession provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) RenewSession(ctx context.Context, in *RenewSessionRequest, opts ...grpc.CallOption) (*RenewSessionResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -486,22 +2140,22 @@ func (_mock *MockAPIServerClient) GetGatewayJitaGrantsForUser(ctx context.Contex
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGatewayJitaGrantsForUser")
+		panic("no return value specified for RenewSession")
 	}
 
-	var r0 *GetGatewayJitaGrantsForUserResponse
+	var r0 *RenewSessionResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RenewSessionRequest, ...grpc.CallOption) (*RenewSessionResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) *GetGatewayJitaGrantsForUserResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RenewSessionRequest, ...grpc.CallOption) *RenewSessionResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetGatewayJitaGrantsForUserResponse)
+			r0 = ret.Get(0).(*RenewSessionResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetGatewayJitaGrantsForUserRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RenewSessionRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -509,29 +2163,29 @@ func (_mock *MockAPIServerClient) GetGatewayJitaGrantsForUser(ctx context.Contex
 	return r0, r1
 }
 
-// MockAPIServerClient_GetGatewayJitaGrantsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGatewayJitaGrantsForUser'
-type MockAPIServerClient_GetGatewayJitaGrantsForUser_Call struct {
+// MockAPIServerClient_RenewSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewSession'
+type MockAPIServerClient_RenewSession_Call struct {
 	*mock.Call
 }
 
-// GetGatewayJitaGrantsForUser is a helper method to define mock.On call
+// RenewSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetGatewayJitaGrantsForUserRequest
+//   - in *RenewSessionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetGatewayJitaGrantsForUser(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
-	return &MockAPIServerClient_GetGatewayJitaGrantsForUser_Call{Call: _e.mock.On("GetGatewayJitaGrantsForUser",
+func (_e *MockAPIServerClient_Expecter) RenewSession(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RenewSession_Call {
+	return &MockAPIServerClient_RenewSession_Call{Call: _e.mock.On("RenewSession",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+func (_c *MockAPIServerClient_RenewSession_Call) Run(run func(ctx context.Context, in *RenewSessionRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RenewSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetGatewayJitaGrantsForUserRequest
+		var arg1 *RenewSessionRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetGatewayJitaGrantsForUserRequest)
+			arg1 = args[1].(*RenewSessionRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -550,18 +2204,18 @@ func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) Return(getGatewayJitaGrantsForUserResponse *GetGatewayJitaGrantsForUserResponse, err error) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
-	_c.Call.Return(getGatewayJitaGrantsForUserResponse, err)
+func (_c *MockAPIServerClient_RenewSession_Call) Return(renewSessionResponse *RenewSessionResponse, err error) *MockAPIServerClient_RenewSession_Call {
+	_c.Call.Return(renewSessionResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call) RunAndReturn(run func(ctx context.Context, in *GetGatewayJitaGrantsForUserRequest, opts ...grpc.CallOption) (*GetGatewayJitaGrantsForUserResponse, error)) *MockAPIServerClient_GetGatewayJitaGrantsForUser_Call {
+func (_c *MockAPIServerClient_RenewSession_Call) RunAndReturn(run func(ctx context.Context, in *RenewSessionRequest, opts ...grpc.CallOption) (*RenewSessionResponse, error)) *MockAPIServerClient_RenewSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetKolideCache provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetKolideCache(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error) {
+// ReviewPrivilegedGatewayAccess provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) ReviewPrivilegedGatewayAccess(ctx context.Context, in *ReviewPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*ReviewPrivilegedGatewayAccessResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -573,22 +2227,22 @@ func (_mock *MockAPIServerClient) GetKolideCache(ctx context.Context, in *GetKol
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetKolideCache")
+		panic("no return value specified for ReviewPrivilegedGatewayAccess")
 	}
 
-	var r0 *GetKolideCacheResponse
+	var r0 *ReviewPrivilegedGatewayAccessResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) (*GetKolideCacheResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReviewPrivilegedGatewayAccessRequest, ...grpc.CallOption) (*ReviewPrivilegedGatewayAccessResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) *GetKolideCacheResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReviewPrivilegedGatewayAccessRequest, ...grpc.CallOption) *ReviewPrivilegedGatewayAccessResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetKolideCacheResponse)
+			r0 = ret.Get(0).(*ReviewPrivilegedGatewayAccessResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetKolideCacheRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ReviewPrivilegedGatewayAccessRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -596,29 +2250,29 @@ func (_mock *MockAPIServerClient) GetKolideCache(ctx context.Context, in *GetKol
 	return r0, r1
 }
 
-// MockAPIServerClient_GetKolideCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKolideCache'
-type MockAPIServerClient_GetKolideCache_Call struct {
+// MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReviewPrivilegedGatewayAccess'
+type MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call struct {
 	*mock.Call
 }
 
-// GetKolideCache is a helper method to define mock.On call
+// ReviewPrivilegedGatewayAccess is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetKolideCacheRequest
+//   - in *ReviewPrivilegedGatewayAccessRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetKolideCache(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetKolideCache_Call {
-	return &MockAPIServerClient_GetKolideCache_Call{Call: _e.mock.On("GetKolideCache",
+func (_e *MockAPIServerClient_Expecter) ReviewPrivilegedGatewayAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call {
+	return &MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call{Call: _e.mock.On("ReviewPrivilegedGatewayAccess",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetKolideCache_Call) Run(run func(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetKolideCache_Call {
+func (_c *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, in *ReviewPrivilegedGatewayAccessRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetKolideCacheRequest
+		var arg1 *ReviewPrivilegedGatewayAccessRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetKolideCacheRequest)
+			arg1 = args[1].(*ReviewPrivilegedGatewayAccessRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -637,18 +2291,18 @@ func (_c *MockAPIServerClient_GetKolideCache_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetKolideCache_Call) Return(getKolideCacheResponse *GetKolideCacheResponse, err error) *MockAPIServerClient_GetKolideCache_Call {
-	_c.Call.Return(getKolideCacheResponse, err)
+func (_c *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call) Return(reviewPrivilegedGatewayAccessResponse *ReviewPrivilegedGatewayAccessResponse, err error) *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call {
+	_c.Call.Return(reviewPrivilegedGatewayAccessResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetKolideCache_Call) RunAndReturn(run func(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error)) *MockAPIServerClient_GetKolideCache_Call {
+func (_c *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, in *ReviewPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*ReviewPrivilegedGatewayAccessResponse, error)) *MockAPIServerClient_ReviewPrivilegedGatewayAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSessions provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error) {
+// RevokeGatewayJitaGrant provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) RevokeGatewayJitaGrant(ctx context.Context, in *RevokeGatewayJitaGrantRequest, opts ...grpc.CallOption) (*RevokeGatewayJitaGrantResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -660,22 +2314,22 @@ func (_mock *MockAPIServerClient) GetSessions(ctx context.Context, in *GetSessio
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSessions")
+		panic("no return value specified for RevokeGatewayJitaGrant")
 	}
 
-	var r0 *GetSessionsResponse
+	var r0 *RevokeGatewayJitaGrantResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) (*GetSessionsResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeGatewayJitaGrantRequest, ...grpc.CallOption) (*RevokeGatewayJitaGrantResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) *GetSessionsResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeGatewayJitaGrantRequest, ...grpc.CallOption) *RevokeGatewayJitaGrantResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetSessionsResponse)
+			r0 = ret.Get(0).(*RevokeGatewayJitaGrantResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetSessionsRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RevokeGatewayJitaGrantRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -683,29 +2337,29 @@ func (_mock *MockAPIServerClient) GetSessions(ctx context.Context, in *GetSessio
 	return r0, r1
 }
 
-// MockAPIServerClient_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
-type MockAPIServerClient_GetSessions_Call struct {
+// MockAPIServerClient_RevokeGatewayJitaGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeGatewayJitaGrant'
+type MockAPIServerClient_RevokeGatewayJitaGrant_Call struct {
 	*mock.Call
 }
 
-// GetSessions is a helper method to define mock.On call
+// RevokeGatewayJitaGrant is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetSessionsRequest
+//   - in *RevokeGatewayJitaGrantRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GetSessions(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GetSessions_Call {
-	return &MockAPIServerClient_GetSessions_Call{Call: _e.mock.On("GetSessions",
+func (_e *MockAPIServerClient_Expecter) RevokeGatewayJitaGrant(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RevokeGatewayJitaGrant_Call {
+	return &MockAPIServerClient_RevokeGatewayJitaGrant_Call{Call: _e.mock.On("RevokeGatewayJitaGrant",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GetSessions_Call) Run(run func(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GetSessions_Call {
+func (_c *MockAPIServerClient_RevokeGatewayJitaGrant_Call) Run(run func(ctx context.Context, in *RevokeGatewayJitaGrantRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RevokeGatewayJitaGrant_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetSessionsRequest
+		var arg1 *RevokeGatewayJitaGrantRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetSessionsRequest)
+			arg1 = args[1].(*RevokeGatewayJitaGrantRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -724,18 +2378,18 @@ func (_c *MockAPIServerClient_GetSessions_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetSessions_Call) Return(getSessionsResponse *GetSessionsResponse, err error) *MockAPIServerClient_GetSessions_Call {
-	_c.Call.Return(getSessionsResponse, err)
+func (_c *MockAPIServerClient_RevokeGatewayJitaGrant_Call) Return(revokeGatewayJitaGrantResponse *RevokeGatewayJitaGrantResponse, err error) *MockAPIServerClient_RevokeGatewayJitaGrant_Call {
+	_c.Call.Return(revokeGatewayJitaGrantResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GetSessions_Call) RunAndReturn(run func(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error)) *MockAPIServerClient_GetSessions_Call {
+func (_c *MockAPIServerClient_RevokeGatewayJitaGrant_Call) RunAndReturn(run func(ctx context.Context, in *RevokeGatewayJitaGrantRequest, opts ...grpc.CallOption) (*RevokeGatewayJitaGrantResponse, error)) *MockAPIServerClient_RevokeGatewayJitaGrant_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GrantPrivilegedGatewayAccess provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) GrantPrivilegedGatewayAccess(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error) {
+// RevokeIssueExemption provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) RevokeIssueExemption(ctx context.Context, in *RevokeIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -747,22 +2401,22 @@ func (_mock *MockAPIServerClient) GrantPrivilegedGatewayAccess(ctx context.Conte
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GrantPrivilegedGatewayAccess")
+		panic("no return value specified for RevokeIssueExemption")
 	}
 
-	var r0 *GrantPrivilegedGatewayAccessResponse
+	var r0 *IssueExemption
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeIssueExemptionRequest, ...grpc.CallOption) (*IssueExemption, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) *GrantPrivilegedGatewayAccessResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeIssueExemptionRequest, ...grpc.CallOption) *IssueExemption); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GrantPrivilegedGatewayAccessResponse)
+			r0 = ret.Get(0).(*IssueExemption)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GrantPrivilegedGatewayAccessRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RevokeIssueExemptionRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -770,29 +2424,29 @@ func (_mock *MockAPIServerClient) GrantPrivilegedGatewayAccess(ctx context.Conte
 	return r0, r1
 }
 
-// MockAPIServerClient_GrantPrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantPrivilegedGatewayAccess'
-type MockAPIServerClient_GrantPrivilegedGatewayAccess_Call struct {
+// MockAPIServerClient_RevokeIssueExemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeIssueExemption'
+type MockAPIServerClient_RevokeIssueExemption_Call struct {
 	*mock.Call
 }
 
-// GrantPrivilegedGatewayAccess is a helper method to define mock.On call
+// RevokeIssueExemption is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GrantPrivilegedGatewayAccessRequest
+//   - in *RevokeIssueExemptionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) GrantPrivilegedGatewayAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
-	return &MockAPIServerClient_GrantPrivilegedGatewayAccess_Call{Call: _e.mock.On("GrantPrivilegedGatewayAccess",
+func (_e *MockAPIServerClient_Expecter) RevokeIssueExemption(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RevokeIssueExemption_Call {
+	return &MockAPIServerClient_RevokeIssueExemption_Call{Call: _e.mock.On("RevokeIssueExemption",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption)) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+func (_c *MockAPIServerClient_RevokeIssueExemption_Call) Run(run func(ctx context.Context, in *RevokeIssueExemptionRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RevokeIssueExemption_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GrantPrivilegedGatewayAccessRequest
+		var arg1 *RevokeIssueExemptionRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GrantPrivilegedGatewayAccessRequest)
+			arg1 = args[1].(*RevokeIssueExemptionRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -811,18 +2465,18 @@ func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) Run(run func(ct
 	return _c
 }
 
-func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) Return(grantPrivilegedGatewayAccessResponse *GrantPrivilegedGatewayAccessResponse, err error) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
-	_c.Call.Return(grantPrivilegedGatewayAccessResponse, err)
+func (_c *MockAPIServerClient_RevokeIssueExemption_Call) Return(issueExemption *IssueExemption, err error) *MockAPIServerClient_RevokeIssueExemption_Call {
+	_c.Call.Return(issueExemption, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error)) *MockAPIServerClient_GrantPrivilegedGatewayAccess_Call {
+func (_c *MockAPIServerClient_RevokeIssueExemption_Call) RunAndReturn(run func(ctx context.Context, in *RevokeIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error)) *MockAPIServerClient_RevokeIssueExemption_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListGateways provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) ListGateways(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error) {
+// RevokePrivilegedGatewayAccess provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) RevokePrivilegedGatewayAccess(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -834,22 +2488,22 @@ func (_mock *MockAPIServerClient) ListGateways(ctx context.Context, in *ListGate
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListGateways")
+		panic("no return value specified for RevokePrivilegedGatewayAccess")
 	}
 
-	var r0 grpc.ServerStreamingClient[Gateway]
+	var r0 *RevokePrivilegedGatewayAccessResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) grpc.ServerStreamingClient[Gateway]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) *RevokePrivilegedGatewayAccessResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(grpc.ServerStreamingClient[Gateway])
+			r0 = ret.Get(0).(*RevokePrivilegedGatewayAccessResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListGatewayRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -857,29 +2511,29 @@ func (_mock *MockAPIServerClient) ListGateways(ctx context.Context, in *ListGate
 	return r0, r1
 }
 
-// MockAPIServerClient_ListGateways_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGateways'
-type MockAPIServerClient_ListGateways_Call struct {
+// MockAPIServerClient_RevokePrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePrivilegedGatewayAccess'
+type MockAPIServerClient_RevokePrivilegedGatewayAccess_Call struct {
 	*mock.Call
 }
 
-// ListGateways is a helper method to define mock.On call
+// RevokePrivilegedGatewayAccess is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *ListGatewayRequest
+//   - in *RevokePrivilegedGatewayAccessRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) ListGateways(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_ListGateways_Call {
-	return &MockAPIServerClient_ListGateways_Call{Call: _e.mock.On("ListGateways",
+func (_e *MockAPIServerClient_Expecter) RevokePrivilegedGatewayAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
+	return &MockAPIServerClient_RevokePrivilegedGatewayAccess_Call{Call: _e.mock.On("RevokePrivilegedGatewayAccess",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_ListGateways_Call) Run(run func(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption)) *MockAPIServerClient_ListGateways_Call {
+func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *ListGatewayRequest
+		var arg1 *RevokePrivilegedGatewayAccessRequest
 		if args[1] != nil {
-			arg1 = args[1].(*ListGatewayRequest)
+			arg1 = args[1].(*RevokePrivilegedGatewayAccessRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -898,18 +2552,18 @@ func (_c *MockAPIServerClient_ListGateways_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockAPIServerClient_ListGateways_Call) Return(serverStreamingClient grpc.ServerStreamingClient[Gateway], err error) *MockAPIServerClient_ListGateways_Call {
-	_c.Call.Return(serverStreamingClient, err)
+func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) Return(revokePrivilegedGatewayAccessResponse *RevokePrivilegedGatewayAccessResponse, err error) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
+	_c.Call.Return(revokePrivilegedGatewayAccessResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_ListGateways_Call) RunAndReturn(run func(ctx context.Context, in *ListGatewayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gateway], error)) *MockAPIServerClient_ListGateways_Call {
+func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error)) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) Login(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption) (*APIServerLoginResponse, error) {
+// RevokeSessions provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -921,22 +2575,22 @@ func (_mock *MockAPIServerClient) Login(ctx context.Context, in *APIServerLoginR
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for RevokeSessions")
 	}
 
-	var r0 *APIServerLoginResponse
+	var r0 *RevokeSessionsResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) (*APIServerLoginResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeSessionsRequest, ...grpc.CallOption) (*RevokeSessionsResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) *APIServerLoginResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokeSessionsRequest, ...grpc.CallOption) *RevokeSessionsResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*APIServerLoginResponse)
+			r0 = ret.Get(0).(*RevokeSessionsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *APIServerLoginRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RevokeSessionsRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -944,29 +2598,29 @@ func (_mock *MockAPIServerClient) Login(ctx context.Context, in *APIServerLoginR
 	return r0, r1
 }
 
-// MockAPIServerClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockAPIServerClient_Login_Call struct {
+// MockAPIServerClient_RevokeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessions'
+type MockAPIServerClient_RevokeSessions_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// RevokeSessions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *APIServerLoginRequest
+//   - in *RevokeSessionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_Login_Call {
-	return &MockAPIServerClient_Login_Call{Call: _e.mock.On("Login",
+func (_e *MockAPIServerClient_Expecter) RevokeSessions(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RevokeSessions_Call {
+	return &MockAPIServerClient_RevokeSessions_Call{Call: _e.mock.On("RevokeSessions",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_Login_Call) Run(run func(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption)) *MockAPIServerClient_Login_Call {
+func (_c *MockAPIServerClient_RevokeSessions_Call) Run(run func(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RevokeSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *APIServerLoginRequest
+		var arg1 *RevokeSessionsRequest
 		if args[1] != nil {
-			arg1 = args[1].(*APIServerLoginRequest)
+			arg1 = args[1].(*RevokeSessionsRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -985,18 +2639,18 @@ func (_c *MockAPIServerClient_Login_Call) Run(run func(ctx context.Context, in *
 	return _c
 }
 
-func (_c *MockAPIServerClient_Login_Call) Return(aPIServerLoginResponse *APIServerLoginResponse, err error) *MockAPIServerClient_Login_Call {
-	_c.Call.Return(aPIServerLoginResponse, err)
+func (_c *MockAPIServerClient_RevokeSessions_Call) Return(revokeSessionsResponse *RevokeSessionsResponse, err error) *MockAPIServerClient_RevokeSessions_Call {
+	_c.Call.Return(revokeSessionsResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_Login_Call) RunAndReturn(run func(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption) (*APIServerLoginResponse, error)) *MockAPIServerClient_Login_Call {
+func (_c *MockAPIServerClient_RevokeSessions_Call) RunAndReturn(run func(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)) *MockAPIServerClient_RevokeSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokePrivilegedGatewayAccess provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) RevokePrivilegedGatewayAccess(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error) {
+// SetAcceptableUseAccepted provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) SetAcceptableUseAccepted(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -1008,22 +2662,22 @@ func (_mock *MockAPIServerClient) RevokePrivilegedGatewayAccess(ctx context.Cont
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokePrivilegedGatewayAccess")
+		panic("no return value specified for SetAcceptableUseAccepted")
 	}
 
-	var r0 *RevokePrivilegedGatewayAccessResponse
+	var r0 *SetAcceptableUseAcceptedResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) *RevokePrivilegedGatewayAccessResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) *SetAcceptableUseAcceptedResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*RevokePrivilegedGatewayAccessResponse)
+			r0 = ret.Get(0).(*SetAcceptableUseAcceptedResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *RevokePrivilegedGatewayAccessRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1031,29 +2685,29 @@ func (_mock *MockAPIServerClient) RevokePrivilegedGatewayAccess(ctx context.Cont
 	return r0, r1
 }
 
-// MockAPIServerClient_RevokePrivilegedGatewayAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokePrivilegedGatewayAccess'
-type MockAPIServerClient_RevokePrivilegedGatewayAccess_Call struct {
+// MockAPIServerClient_SetAcceptableUseAccepted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAcceptableUseAccepted'
+type MockAPIServerClient_SetAcceptableUseAccepted_Call struct {
 	*mock.Call
 }
 
-// RevokePrivilegedGatewayAccess is a helper method to define mock.On call
+// SetAcceptableUseAccepted is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *RevokePrivilegedGatewayAccessRequest
+//   - in *SetAcceptableUseAcceptedRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) RevokePrivilegedGatewayAccess(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
-	return &MockAPIServerClient_RevokePrivilegedGatewayAccess_Call{Call: _e.mock.On("RevokePrivilegedGatewayAccess",
+func (_e *MockAPIServerClient_Expecter) SetAcceptableUseAccepted(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
+	return &MockAPIServerClient_SetAcceptableUseAccepted_Call{Call: _e.mock.On("SetAcceptableUseAccepted",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) Run(run func(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption)) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
+func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) Run(run func(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption)) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *RevokePrivilegedGatewayAccessRequest
+		var arg1 *SetAcceptableUseAcceptedRequest
 		if args[1] != nil {
-			arg1 = args[1].(*RevokePrivilegedGatewayAccessRequest)
+			arg1 = args[1].(*SetAcceptableUseAcceptedRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -1072,18 +2726,18 @@ func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) Run(run func(c
 	return _c
 }
 
-func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) Return(revokePrivilegedGatewayAccessResponse *RevokePrivilegedGatewayAccessResponse, err error) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
-	_c.Call.Return(revokePrivilegedGatewayAccessResponse, err)
+func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) Return(setAcceptableUseAcceptedResponse *SetAcceptableUseAcceptedResponse, err error) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
+	_c.Call.Return(setAcceptableUseAcceptedResponse, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call) RunAndReturn(run func(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error)) *MockAPIServerClient_RevokePrivilegedGatewayAccess_Call {
+func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) RunAndReturn(run func(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error)) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetAcceptableUseAccepted provides a mock function for the type MockAPIServerClient
-func (_mock *MockAPIServerClient) SetAcceptableUseAccepted(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error) {
+// SnapshotDatabase provides a mock function for the type MockAPIServerClient
+func (_mock *MockAPIServerClient) SnapshotDatabase(ctx context.Context, in *SnapshotDatabaseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotDatabaseResponse], error) {
 	// grpc.CallOption
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -1095,22 +2749,22 @@ func (_mock *MockAPIServerClient) SetAcceptableUseAccepted(ctx context.Context,
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetAcceptableUseAccepted")
+		panic("no return value specified for SnapshotDatabase")
 	}
 
-	var r0 *SetAcceptableUseAcceptedResponse
+	var r0 grpc.ServerStreamingClient[SnapshotDatabaseResponse]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SnapshotDatabaseRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotDatabaseResponse], error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) *SetAcceptableUseAcceptedResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SnapshotDatabaseRequest, ...grpc.CallOption) grpc.ServerStreamingClient[SnapshotDatabaseResponse]); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*SetAcceptableUseAcceptedResponse)
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[SnapshotDatabaseResponse])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *SetAcceptableUseAcceptedRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *SnapshotDatabaseRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1118,29 +2772,29 @@ func (_mock *MockAPIServerClient) SetAcceptableUseAccepted(ctx context.Context,
 	return r0, r1
 }
 
-// MockAPIServerClient_SetAcceptableUseAccepted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAcceptableUseAccepted'
-type MockAPIServerClient_SetAcceptableUseAccepted_Call struct {
+// MockAPIServerClient_SnapshotDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotDatabase'
+type MockAPIServerClient_SnapshotDatabase_Call struct {
 	*mock.Call
 }
 
-// SetAcceptableUseAccepted is a helper method to define mock.On call
+// SnapshotDatabase is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *SetAcceptableUseAcceptedRequest
+//   - in *SnapshotDatabaseRequest
 //   - opts ...grpc.CallOption
-func (_e *MockAPIServerClient_Expecter) SetAcceptableUseAccepted(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
-	return &MockAPIServerClient_SetAcceptableUseAccepted_Call{Call: _e.mock.On("SetAcceptableUseAccepted",
+func (_e *MockAPIServerClient_Expecter) SnapshotDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockAPIServerClient_SnapshotDatabase_Call {
+	return &MockAPIServerClient_SnapshotDatabase_Call{Call: _e.mock.On("SnapshotDatabase",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) Run(run func(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption)) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
+func (_c *MockAPIServerClient_SnapshotDatabase_Call) Run(run func(ctx context.Context, in *SnapshotDatabaseRequest, opts ...grpc.CallOption)) *MockAPIServerClient_SnapshotDatabase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *SetAcceptableUseAcceptedRequest
+		var arg1 *SnapshotDatabaseRequest
 		if args[1] != nil {
-			arg1 = args[1].(*SetAcceptableUseAcceptedRequest)
+			arg1 = args[1].(*SnapshotDatabaseRequest)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -1159,12 +2813,12 @@ func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) Return(setAcceptableUseAcceptedResponse *SetAcceptableUseAcceptedResponse, err error) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
-	_c.Call.Return(setAcceptableUseAcceptedResponse, err)
+func (_c *MockAPIServerClient_SnapshotDatabase_Call) Return(serverStreamingClient grpc.ServerStreamingClient[SnapshotDatabaseResponse], err error) *MockAPIServerClient_SnapshotDatabase_Call {
+	_c.Call.Return(serverStreamingClient, err)
 	return _c
 }
 
-func (_c *MockAPIServerClient_SetAcceptableUseAccepted_Call) RunAndReturn(run func(ctx context.Context, in *SetAcceptableUseAcceptedRequest, opts ...grpc.CallOption) (*SetAcceptableUseAcceptedResponse, error)) *MockAPIServerClient_SetAcceptableUseAccepted_Call {
+func (_c *MockAPIServerClient_SnapshotDatabase_Call) RunAndReturn(run func(ctx context.Context, in *SnapshotDatabaseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotDatabaseResponse], error)) *MockAPIServerClient_SnapshotDatabase_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -184,6 +185,55 @@ func (AuthProvider) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{2}
 }
 
+type GatewayConfigurationMode int32
+
+const (
+	// Every message carries the complete device list and routes.
+	GatewayConfigurationMode_GatewayConfigurationModeFull GatewayConfigurationMode = 0
+	// After an initial full snapshot, messages only carry changes since the previous generation.
+	// Full snapshots are still sent periodically for resync.
+	GatewayConfigurationMode_GatewayConfigurationModeDelta GatewayConfigurationMode = 1
+)
+
+// Enum value maps for GatewayConfigurationMode.
+var (
+	GatewayConfigurationMode_name = map[int32]string{
+		0: "GatewayConfigurationModeFull",
+		1: "GatewayConfigurationModeDelta",
+	}
+	GatewayConfigurationMode_value = map[string]int32{
+		"GatewayConfigurationModeFull":  0,
+		"GatewayConfigurationModeDelta": 1,
+	}
+)
+
+func (x GatewayConfigurationMode) Enum() *GatewayConfigurationMode {
+	p := new(GatewayConfigurationMode)
+	*p = x
+	return p
+}
+
+func (x GatewayConfigurationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayConfigurationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_protobuf_api_proto_enumTypes[3].Descriptor()
+}
+
+func (GatewayConfigurationMode) Type() protoreflect.EnumType {
+	return &file_pkg_pb_protobuf_api_proto_enumTypes[3]
+}
+
+func (x GatewayConfigurationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayConfigurationMode.Descriptor instead.
+func (GatewayConfigurationMode) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{3}
+}
+
 type Severity int32
 
 const (
@@ -226,11 +276,11 @@ func (x Severity) String() string {
 }
 
 func (Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_pb_protobuf_api_proto_enumTypes[3].Descriptor()
+	return file_pkg_pb_protobuf_api_proto_enumTypes[4].Descriptor()
 }
 
 func (Severity) Type() protoreflect.EnumType {
-	return &file_pkg_pb_protobuf_api_proto_enumTypes[3]
+	return &file_pkg_pb_protobuf_api_proto_enumTypes[4]
 }
 
 func (x Severity) Number() protoreflect.EnumNumber {
@@ -239,7 +289,108 @@ func (x Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity.Descriptor instead.
 func (Severity) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{3}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{4}
+}
+
+type DeviceHealthFilter int32
+
+const (
+	DeviceHealthFilter_DeviceHealthAny       DeviceHealthFilter = 0
+	DeviceHealthFilter_DeviceHealthHealthy   DeviceHealthFilter = 1
+	DeviceHealthFilter_DeviceHealthUnhealthy DeviceHealthFilter = 2
+)
+
+// Enum value maps for DeviceHealthFilter.
+var (
+	DeviceHealthFilter_name = map[int32]string{
+		0: "DeviceHealthAny",
+		1: "DeviceHealthHealthy",
+		2: "DeviceHealthUnhealthy",
+	}
+	DeviceHealthFilter_value = map[string]int32{
+		"DeviceHealthAny":       0,
+		"DeviceHealthHealthy":   1,
+		"DeviceHealthUnhealthy": 2,
+	}
+)
+
+func (x DeviceHealthFilter) Enum() *DeviceHealthFilter {
+	p := new(DeviceHealthFilter)
+	*p = x
+	return p
+}
+
+func (x DeviceHealthFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceHealthFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_protobuf_api_proto_enumTypes[5].Descriptor()
+}
+
+func (DeviceHealthFilter) Type() protoreflect.EnumType {
+	return &file_pkg_pb_protobuf_api_proto_enumTypes[5]
+}
+
+func (x DeviceHealthFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceHealthFilter.Descriptor instead.
+func (DeviceHealthFilter) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{5}
+}
+
+type JitaApproval int32
+
+const (
+	JitaApproval_JitaApprovalNotRequired JitaApproval = 0
+	JitaApproval_JitaApprovalPending     JitaApproval = 1
+	JitaApproval_JitaApprovalApproved    JitaApproval = 2
+	JitaApproval_JitaApprovalDenied      JitaApproval = 3
+)
+
+// Enum value maps for JitaApproval.
+var (
+	JitaApproval_name = map[int32]string{
+		0: "JitaApprovalNotRequired",
+		1: "JitaApprovalPending",
+		2: "JitaApprovalApproved",
+		3: "JitaApprovalDenied",
+	}
+	JitaApproval_value = map[string]int32{
+		"JitaApprovalNotRequired": 0,
+		"JitaApprovalPending":     1,
+		"JitaApprovalApproved":    2,
+		"JitaApprovalDenied":      3,
+	}
+)
+
+func (x JitaApproval) Enum() *JitaApproval {
+	p := new(JitaApproval)
+	*p = x
+	return p
+}
+
+func (x JitaApproval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JitaApproval) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_pb_protobuf_api_proto_enumTypes[6].Descriptor()
+}
+
+func (JitaApproval) Type() protoreflect.EnumType {
+	return &file_pkg_pb_protobuf_api_proto_enumTypes[6]
+}
+
+func (x JitaApproval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JitaApproval.Descriptor instead.
+func (JitaApproval) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{6}
 }
 
 type TeardownRequest struct {
@@ -1410,6 +1561,139 @@ func (x *ModifyGatewayResponse) GetGateway() *Gateway {
 	return nil
 }
 
+type DeleteGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGatewayResponse) Reset() {
+	*x = DeleteGatewayResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGatewayResponse) ProtoMessage() {}
+
+func (x *DeleteGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGatewayResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{28}
+}
+
+type GetWireGuardPrefixesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWireGuardPrefixesRequest) Reset() {
+	*x = GetWireGuardPrefixesRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWireGuardPrefixesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWireGuardPrefixesRequest) ProtoMessage() {}
+
+func (x *GetWireGuardPrefixesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWireGuardPrefixesRequest.ProtoReflect.Descriptor instead.
+func (*GetWireGuardPrefixesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetWireGuardPrefixesRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetWireGuardPrefixesRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetWireGuardPrefixesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. 10.255.240.0/21
+	Prefixes      []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWireGuardPrefixesResponse) Reset() {
+	*x = GetWireGuardPrefixesResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWireGuardPrefixesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWireGuardPrefixesResponse) ProtoMessage() {}
+
+func (x *GetWireGuardPrefixesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWireGuardPrefixesResponse.ProtoReflect.Descriptor instead.
+func (*GetWireGuardPrefixesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetWireGuardPrefixesResponse) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
 type Gateway struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	Name                     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1423,13 +1707,16 @@ type Gateway struct {
 	AccessGroupIDs           []string               `protobuf:"bytes,8,rep,name=accessGroupIDs,proto3" json:"accessGroupIDs,omitempty"`
 	PasswordHash             string                 `protobuf:"bytes,9,opt,name=passwordHash,proto3" json:"passwordHash,omitempty"`
 	Ipv6                     string                 `protobuf:"bytes,10,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// JITA grants must be approved by someone in an approver group before taking effect
+	RequiresApproval bool        `protobuf:"varint,12,opt,name=requiresApproval,json=requires_approval,proto3" json:"requiresApproval,omitempty"`
+	JitaPolicy       *JitaPolicy `protobuf:"bytes,13,opt,name=jitaPolicy,json=jita_policy,proto3" json:"jitaPolicy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Gateway) Reset() {
 	*x = Gateway{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[28]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1441,7 +1728,7 @@ func (x *Gateway) String() string {
 func (*Gateway) ProtoMessage() {}
 
 func (x *Gateway) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[28]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +1741,7 @@ func (x *Gateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Gateway.ProtoReflect.Descriptor instead.
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{28}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Gateway) GetName() string {
@@ -1534,6 +1821,91 @@ func (x *Gateway) GetIpv6() string {
 	return ""
 }
 
+func (x *Gateway) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Gateway) GetJitaPolicy() *JitaPolicy {
+	if x != nil {
+		return x.JitaPolicy
+	}
+	return nil
+}
+
+// Restrictions on JITA grants for a privileged gateway. Zero values mean no restriction.
+type JitaPolicy struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxDuration     *durationpb.Duration   `protobuf:"bytes,1,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	MinReasonLength int32                  `protobuf:"varint,2,opt,name=minReasonLength,proto3" json:"minReasonLength,omitempty"`
+	// regular expression the reason must match, e.g. a ticket ID
+	ReasonPattern string `protobuf:"bytes,3,opt,name=reasonPattern,proto3" json:"reasonPattern,omitempty"`
+	// maximum number of grants per user during the last 24 hours
+	MaxGrantsPerDay int32 `protobuf:"varint,4,opt,name=maxGrantsPerDay,proto3" json:"maxGrantsPerDay,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JitaPolicy) Reset() {
+	*x = JitaPolicy{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JitaPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JitaPolicy) ProtoMessage() {}
+
+func (x *JitaPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JitaPolicy.ProtoReflect.Descriptor instead.
+func (*JitaPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JitaPolicy) GetMaxDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return nil
+}
+
+func (x *JitaPolicy) GetMinReasonLength() int32 {
+	if x != nil {
+		return x.MinReasonLength
+	}
+	return 0
+}
+
+func (x *JitaPolicy) GetReasonPattern() string {
+	if x != nil {
+		return x.ReasonPattern
+	}
+	return ""
+}
+
+func (x *JitaPolicy) GetMaxGrantsPerDay() int32 {
+	if x != nil {
+		return x.MaxGrantsPerDay
+	}
+	return 0
+}
+
 type Error struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -1543,7 +1915,7 @@ type Error struct {
 
 func (x *Error) Reset() {
 	*x = Error{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[29]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1555,7 +1927,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[29]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1568,7 +1940,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{29}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Error) GetMessage() string {
@@ -1587,7 +1959,7 @@ type SetActiveTenantRequest struct {
 
 func (x *SetActiveTenantRequest) Reset() {
 	*x = SetActiveTenantRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[30]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1599,7 +1971,7 @@ func (x *SetActiveTenantRequest) String() string {
 func (*SetActiveTenantRequest) ProtoMessage() {}
 
 func (x *SetActiveTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[30]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1984,7 @@ func (x *SetActiveTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetActiveTenantRequest.ProtoReflect.Descriptor instead.
 func (*SetActiveTenantRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{30}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SetActiveTenantRequest) GetName() string {
@@ -1630,7 +2002,7 @@ type SetActiveTenantResponse struct {
 
 func (x *SetActiveTenantResponse) Reset() {
 	*x = SetActiveTenantResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[31]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1642,7 +2014,7 @@ func (x *SetActiveTenantResponse) String() string {
 func (*SetActiveTenantResponse) ProtoMessage() {}
 
 func (x *SetActiveTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[31]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,7 +2027,7 @@ func (x *SetActiveTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetActiveTenantResponse.ProtoReflect.Descriptor instead.
 func (*SetActiveTenantResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{31}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{35}
 }
 
 type Tenant struct {
@@ -1671,7 +2043,7 @@ type Tenant struct {
 
 func (x *Tenant) Reset() {
 	*x = Tenant{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[32]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +2055,7 @@ func (x *Tenant) String() string {
 func (*Tenant) ProtoMessage() {}
 
 func (x *Tenant) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[32]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2068,7 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
 func (*Tenant) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{32}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Tenant) GetName() string {
@@ -1744,7 +2116,7 @@ type AgentConfiguration struct {
 
 func (x *AgentConfiguration) Reset() {
 	*x = AgentConfiguration{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[33]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1756,7 +2128,7 @@ func (x *AgentConfiguration) String() string {
 func (*AgentConfiguration) ProtoMessage() {}
 
 func (x *AgentConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[33]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,7 +2141,7 @@ func (x *AgentConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentConfiguration.ProtoReflect.Descriptor instead.
 func (*AgentConfiguration) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{33}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AgentConfiguration) GetAutoConnect() bool {
@@ -1787,16 +2159,17 @@ func (x *AgentConfiguration) GetILoveNinetiesBoybands() bool {
 }
 
 type GetGatewayConfigurationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Gateway       string                   `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Password      string                   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Mode          GatewayConfigurationMode `protobuf:"varint,3,opt,name=mode,proto3,enum=naisdevice.GatewayConfigurationMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGatewayConfigurationRequest) Reset() {
 	*x = GetGatewayConfigurationRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[34]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1808,7 +2181,7 @@ func (x *GetGatewayConfigurationRequest) String() string {
 func (*GetGatewayConfigurationRequest) ProtoMessage() {}
 
 func (x *GetGatewayConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[34]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +2194,7 @@ func (x *GetGatewayConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGatewayConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GetGatewayConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{34}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetGatewayConfigurationRequest) GetGateway() string {
@@ -1838,18 +2211,35 @@ func (x *GetGatewayConfigurationRequest) GetPassword() string {
 	return ""
 }
 
+func (x *GetGatewayConfigurationRequest) GetMode() GatewayConfigurationMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GatewayConfigurationMode_GatewayConfigurationModeFull
+}
+
 type GetGatewayConfigurationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
-	RoutesIPv4    []string               `protobuf:"bytes,2,rep,name=routesIPv4,proto3" json:"routesIPv4,omitempty"`
-	RoutesIPv6    []string               `protobuf:"bytes,3,rep,name=routesIPv6,proto3" json:"routesIPv6,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Complete device list and routes. In delta mode, only set on full snapshots,
+	// or for routes when routesChanged is set.
+	Devices    []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	RoutesIPv4 []string  `protobuf:"bytes,2,rep,name=routesIPv4,proto3" json:"routesIPv4,omitempty"`
+	RoutesIPv6 []string  `protobuf:"bytes,3,rep,name=routesIPv6,proto3" json:"routesIPv6,omitempty"`
+	// The fields below are only used in delta mode.
+	// generation increases by one for every message sent on the stream.
+	Generation   uint64 `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+	FullSnapshot bool   `protobuf:"varint,5,opt,name=fullSnapshot,proto3" json:"fullSnapshot,omitempty"`
+	// Devices that are new or have changed since the previous generation.
+	AddedDevices      []*Device `protobuf:"bytes,6,rep,name=addedDevices,proto3" json:"addedDevices,omitempty"`
+	RemovedPublicKeys []string  `protobuf:"bytes,7,rep,name=removedPublicKeys,proto3" json:"removedPublicKeys,omitempty"`
+	RoutesChanged     bool      `protobuf:"varint,8,opt,name=routesChanged,proto3" json:"routesChanged,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetGatewayConfigurationResponse) Reset() {
 	*x = GetGatewayConfigurationResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[35]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1861,7 +2251,7 @@ func (x *GetGatewayConfigurationResponse) String() string {
 func (*GetGatewayConfigurationResponse) ProtoMessage() {}
 
 func (x *GetGatewayConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[35]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1874,7 +2264,7 @@ func (x *GetGatewayConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGatewayConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GetGatewayConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{35}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetGatewayConfigurationResponse) GetDevices() []*Device {
@@ -1898,16 +2288,52 @@ func (x *GetGatewayConfigurationResponse) GetRoutesIPv6() []string {
 	return nil
 }
 
+func (x *GetGatewayConfigurationResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *GetGatewayConfigurationResponse) GetFullSnapshot() bool {
+	if x != nil {
+		return x.FullSnapshot
+	}
+	return false
+}
+
+func (x *GetGatewayConfigurationResponse) GetAddedDevices() []*Device {
+	if x != nil {
+		return x.AddedDevices
+	}
+	return nil
+}
+
+func (x *GetGatewayConfigurationResponse) GetRemovedPublicKeys() []string {
+	if x != nil {
+		return x.RemovedPublicKeys
+	}
+	return nil
+}
+
+func (x *GetGatewayConfigurationResponse) GetRoutesChanged() bool {
+	if x != nil {
+		return x.RoutesChanged
+	}
+	return false
+}
+
 type GetDeviceConfigurationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDeviceConfigurationRequest) Reset() {
 	*x = GetDeviceConfigurationRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[36]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1919,7 +2345,7 @@ func (x *GetDeviceConfigurationRequest) String() string {
 func (*GetDeviceConfigurationRequest) ProtoMessage() {}
 
 func (x *GetDeviceConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[36]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,7 +2358,7 @@ func (x *GetDeviceConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*GetDeviceConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{36}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetDeviceConfigurationRequest) GetSessionKey() string {
@@ -1942,6 +2368,13 @@ func (x *GetDeviceConfigurationRequest) GetSessionKey() string {
 	return ""
 }
 
+func (x *GetDeviceConfigurationRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 type APIServerLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -1954,7 +2387,7 @@ type APIServerLoginRequest struct {
 
 func (x *APIServerLoginRequest) Reset() {
 	*x = APIServerLoginRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[37]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1966,7 +2399,7 @@ func (x *APIServerLoginRequest) String() string {
 func (*APIServerLoginRequest) ProtoMessage() {}
 
 func (x *APIServerLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[37]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,7 +2412,7 @@ func (x *APIServerLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIServerLoginRequest.ProtoReflect.Descriptor instead.
 func (*APIServerLoginRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{37}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *APIServerLoginRequest) GetToken() string {
@@ -2019,7 +2452,7 @@ type APIServerLoginResponse struct {
 
 func (x *APIServerLoginResponse) Reset() {
 	*x = APIServerLoginResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[38]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2031,7 +2464,7 @@ func (x *APIServerLoginResponse) String() string {
 func (*APIServerLoginResponse) ProtoMessage() {}
 
 func (x *APIServerLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[38]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,7 +2477,7 @@ func (x *APIServerLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIServerLoginResponse.ProtoReflect.Descriptor instead.
 func (*APIServerLoginResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{38}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *APIServerLoginResponse) GetSession() *Session {
@@ -2054,18 +2487,109 @@ func (x *APIServerLoginResponse) GetSession() *Session {
 	return nil
 }
 
+type RenewSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSessionRequest) Reset() {
+	*x = RenewSessionRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSessionRequest) ProtoMessage() {}
+
+func (x *RenewSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSessionRequest.ProtoReflect.Descriptor instead.
+func (*RenewSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RenewSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RenewSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSessionResponse) Reset() {
+	*x = RenewSessionResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSessionResponse) ProtoMessage() {}
+
+func (x *RenewSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSessionResponse.ProtoReflect.Descriptor instead.
+func (*RenewSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RenewSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
 type GetDeviceConfigurationResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Status        DeviceConfigurationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=naisdevice.DeviceConfigurationStatus" json:"status,omitempty"`
-	Gateways      []*Gateway                `protobuf:"bytes,2,rep,name=Gateways,proto3" json:"Gateways,omitempty"`
-	Issues        []*DeviceIssue            `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	state               protoimpl.MessageState    `protogen:"open.v1"`
+	Status              DeviceConfigurationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=naisdevice.DeviceConfigurationStatus" json:"status,omitempty"`
+	Gateways            []*Gateway                `protobuf:"bytes,2,rep,name=Gateways,proto3" json:"Gateways,omitempty"`
+	Issues              []*DeviceIssue            `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	NewVersionAvailable bool                      `protobuf:"varint,4,opt,name=newVersionAvailable,proto3" json:"newVersionAvailable,omitempty"`
+	// renewSession is set when the session expires soon, and should be renewed with RenewSession
+	RenewSession  bool `protobuf:"varint,5,opt,name=renewSession,proto3" json:"renewSession,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDeviceConfigurationResponse) Reset() {
 	*x = GetDeviceConfigurationResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[39]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2077,7 +2601,7 @@ func (x *GetDeviceConfigurationResponse) String() string {
 func (*GetDeviceConfigurationResponse) ProtoMessage() {}
 
 func (x *GetDeviceConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[39]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2614,7 @@ func (x *GetDeviceConfigurationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*GetDeviceConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{39}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetDeviceConfigurationResponse) GetStatus() DeviceConfigurationStatus {
@@ -2114,6 +2638,20 @@ func (x *GetDeviceConfigurationResponse) GetIssues() []*DeviceIssue {
 	return nil
 }
 
+func (x *GetDeviceConfigurationResponse) GetNewVersionAvailable() bool {
+	if x != nil {
+		return x.NewVersionAvailable
+	}
+	return false
+}
+
+func (x *GetDeviceConfigurationResponse) GetRenewSession() bool {
+	if x != nil {
+		return x.RenewSession
+	}
+	return false
+}
+
 type DeviceIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -2122,13 +2660,17 @@ type DeviceIssue struct {
 	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=detectedAt,proto3" json:"detectedAt,omitempty"`
 	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
 	ResolveBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolveBefore,proto3" json:"resolveBefore,omitempty"`
+	// the Kolide check raising the issue, 0 for issues not raised by a Kolide check
+	CheckID int64 `protobuf:"varint,7,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	// set when the issue is suppressed by an exemption, and does not affect the health of the device
+	Exemption     *IssueExemption `protobuf:"bytes,8,opt,name=exemption,proto3" json:"exemption,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeviceIssue) Reset() {
 	*x = DeviceIssue{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[40]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2682,7 @@ func (x *DeviceIssue) String() string {
 func (*DeviceIssue) ProtoMessage() {}
 
 func (x *DeviceIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[40]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2695,7 @@ func (x *DeviceIssue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceIssue.ProtoReflect.Descriptor instead.
 func (*DeviceIssue) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{40}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DeviceIssue) GetTitle() string {
@@ -2198,90 +2740,51 @@ func (x *DeviceIssue) GetResolveBefore() *timestamppb.Timestamp {
 	return nil
 }
 
-type ListGatewayRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListGatewayRequest) Reset() {
-	*x = ListGatewayRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListGatewayRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListGatewayRequest) ProtoMessage() {}
-
-func (x *ListGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[41]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListGatewayRequest.ProtoReflect.Descriptor instead.
-func (*ListGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *ListGatewayRequest) GetPassword() string {
+func (x *DeviceIssue) GetCheckID() int64 {
 	if x != nil {
-		return x.Password
+		return x.CheckID
 	}
-	return ""
+	return 0
 }
 
-func (x *ListGatewayRequest) GetUsername() string {
+func (x *DeviceIssue) GetExemption() *IssueExemption {
 	if x != nil {
-		return x.Username
+		return x.Exemption
 	}
-	return ""
+	return nil
 }
 
-type Device struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Serial        string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
-	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,7,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Ipv4          string                 `protobuf:"bytes,8,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
-	Username      string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
-	Platform      string                 `protobuf:"bytes,10,opt,name=platform,proto3" json:"platform,omitempty"`
-	Ipv6          string                 `protobuf:"bytes,11,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
-	Issues        []*DeviceIssue         `protobuf:"bytes,12,rep,name=issues,proto3" json:"issues,omitempty"`
-	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
-	ExternalID    string                 `protobuf:"bytes,14,opt,name=externalID,proto3" json:"externalID,omitempty"`
+type IssueExemption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the scope of the exemption, unset fields match any device, user or check
+	DeviceID      int64                  `protobuf:"varint,2,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	CheckID       int64                  `protobuf:"varint,4,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty"`
+	Revoked       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked,proto3" json:"revoked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Device) Reset() {
-	*x = Device{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[42]
+func (x *IssueExemption) Reset() {
+	*x = IssueExemption{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Device) String() string {
+func (x *IssueExemption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Device) ProtoMessage() {}
+func (*IssueExemption) ProtoMessage() {}
 
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[42]
+func (x *IssueExemption) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,23 +2795,187 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use IssueExemption.ProtoReflect.Descriptor instead.
+func (*IssueExemption) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Device) GetId() int64 {
+func (x *IssueExemption) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Device) GetSerial() string {
+func (x *IssueExemption) GetDeviceID() int64 {
 	if x != nil {
-		return x.Serial
+		return x.DeviceID
 	}
-	return ""
+	return 0
+}
+
+func (x *IssueExemption) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *IssueExemption) GetCheckID() int64 {
+	if x != nil {
+		return x.CheckID
+	}
+	return 0
+}
+
+func (x *IssueExemption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IssueExemption) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *IssueExemption) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *IssueExemption) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *IssueExemption) GetRevoked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Revoked
+	}
+	return nil
+}
+
+type ListGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGatewayRequest) Reset() {
+	*x = ListGatewayRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGatewayRequest) ProtoMessage() {}
+
+func (x *ListGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGatewayRequest.ProtoReflect.Descriptor instead.
+func (*ListGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListGatewayRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ListGatewayRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type Device struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Serial                    string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	LastUpdated               *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	PublicKey                 string                 `protobuf:"bytes,7,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Ipv4                      string                 `protobuf:"bytes,8,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Username                  string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	Platform                  string                 `protobuf:"bytes,10,opt,name=platform,proto3" json:"platform,omitempty"`
+	Ipv6                      string                 `protobuf:"bytes,11,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	Issues                    []*DeviceIssue         `protobuf:"bytes,12,rep,name=issues,proto3" json:"issues,omitempty"`
+	LastSeen                  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	ExternalID                string                 `protobuf:"bytes,14,opt,name=externalID,proto3" json:"externalID,omitempty"`
+	AgentVersion              string                 `protobuf:"bytes,15,opt,name=agentVersion,proto3" json:"agentVersion,omitempty"`
+	AgentVersionOutdatedSince *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=agentVersionOutdatedSince,proto3" json:"agentVersionOutdatedSince,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Device) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Device) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
 }
 
 func (x *Device) GetLastUpdated() *timestamppb.Timestamp {
@@ -2374,6 +3041,20 @@ func (x *Device) GetExternalID() string {
 	return ""
 }
 
+func (x *Device) GetAgentVersion() string {
+	if x != nil {
+		return x.AgentVersion
+	}
+	return ""
+}
+
+func (x *Device) GetAgentVersionOutdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AgentVersionOutdatedSince
+	}
+	return nil
+}
+
 type Session struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -2387,7 +3068,7 @@ type Session struct {
 
 func (x *Session) Reset() {
 	*x = Session{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[43]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2399,7 +3080,7 @@ func (x *Session) String() string {
 func (*Session) ProtoMessage() {}
 
 func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[43]
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,7 +3093,7 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Session.ProtoReflect.Descriptor instead.
 func (*Session) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{43}
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *Session) GetKey() string {
@@ -2450,29 +3131,32 @@ func (x *Session) GetObjectID() string {
 	return ""
 }
 
-type GetSessionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListDevicesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Password       string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DeviceUsername string                 `protobuf:"bytes,3,opt,name=deviceUsername,proto3" json:"deviceUsername,omitempty"`
+	Platform       string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	Health         DeviceHealthFilter     `protobuf:"varint,5,opt,name=health,proto3,enum=naisdevice.DeviceHealthFilter" json:"health,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSessionsRequest) Reset() {
-	*x = GetSessionsRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[44]
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionsRequest) String() string {
+func (x *ListDevicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionsRequest) ProtoMessage() {}
+func (*ListDevicesRequest) ProtoMessage() {}
 
-func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[44]
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,47 +3167,68 @@ func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetSessionsRequest) GetPassword() string {
+func (x *ListDevicesRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *GetSessionsRequest) GetUsername() string {
+func (x *ListDevicesRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type GetSessionsResponse struct {
+func (x *ListDevicesRequest) GetDeviceUsername() string {
+	if x != nil {
+		return x.DeviceUsername
+	}
+	return ""
+}
+
+func (x *ListDevicesRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ListDevicesRequest) GetHealth() DeviceHealthFilter {
+	if x != nil {
+		return x.Health
+	}
+	return DeviceHealthFilter_DeviceHealthAny
+}
+
+type ListDevicesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionsResponse) Reset() {
-	*x = GetSessionsResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[45]
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionsResponse) String() string {
+func (x *ListDevicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionsResponse) ProtoMessage() {}
+func (*ListDevicesResponse) ProtoMessage() {}
 
-func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[45]
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2534,39 +3239,42 @@ func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetSessionsResponse) GetSessions() []*Session {
+func (x *ListDevicesResponse) GetDevices() []*Device {
 	if x != nil {
-		return x.Sessions
+		return x.Devices
 	}
 	return nil
 }
 
-type PingRequest struct {
+type GetDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DeviceID      int64                  `protobuf:"varint,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[46]
+func (x *GetDeviceRequest) Reset() {
+	*x = GetDeviceRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingRequest) String() string {
+func (x *GetDeviceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*GetDeviceRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[46]
+func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,32 +3285,56 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{53}
 }
 
-type PingResponse struct {
+func (x *GetDeviceRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetDeviceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetDeviceRequest) GetDeviceID() int64 {
+	if x != nil {
+		return x.DeviceID
+	}
+	return 0
+}
+
+type DeleteDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DeviceID      int64                  `protobuf:"varint,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[47]
+func (x *DeleteDeviceRequest) Reset() {
+	*x = DeleteDeviceRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingResponse) String() string {
+func (x *DeleteDeviceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*DeleteDeviceRequest) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[47]
+func (x *DeleteDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,34 +3345,53 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use DeleteDeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{54}
 }
 
-type GetKolideCacheRequest struct {
+func (x *DeleteDeviceRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteDeviceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeleteDeviceRequest) GetDeviceID() int64 {
+	if x != nil {
+		return x.DeviceID
+	}
+	return 0
+}
+
+type DeleteDeviceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKolideCacheRequest) Reset() {
-	*x = GetKolideCacheRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[48]
+func (x *DeleteDeviceResponse) Reset() {
+	*x = DeleteDeviceResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKolideCacheRequest) String() string {
+func (x *DeleteDeviceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKolideCacheRequest) ProtoMessage() {}
+func (*DeleteDeviceResponse) ProtoMessage() {}
 
-func (x *GetKolideCacheRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[48]
+func (x *DeleteDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,47 +3402,101 @@ func (x *GetKolideCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKolideCacheRequest.ProtoReflect.Descriptor instead.
-func (*GetKolideCacheRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DeleteDeviceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetKolideCacheRequest) GetPassword() string {
+type ReassignDeviceRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Password          string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DeviceID          int64                  `protobuf:"varint,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	NewDeviceUsername string                 `protobuf:"bytes,4,opt,name=newDeviceUsername,proto3" json:"newDeviceUsername,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReassignDeviceRequest) Reset() {
+	*x = ReassignDeviceRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignDeviceRequest) ProtoMessage() {}
+
+func (x *ReassignDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignDeviceRequest.ProtoReflect.Descriptor instead.
+func (*ReassignDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReassignDeviceRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *GetKolideCacheRequest) GetUsername() string {
+func (x *ReassignDeviceRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type GetKolideCacheResponse struct {
+func (x *ReassignDeviceRequest) GetDeviceID() int64 {
+	if x != nil {
+		return x.DeviceID
+	}
+	return 0
+}
+
+func (x *ReassignDeviceRequest) GetNewDeviceUsername() string {
+	if x != nil {
+		return x.NewDeviceUsername
+	}
+	return ""
+}
+
+type ReassignDeviceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RawChecks     []byte                 `protobuf:"bytes,99,opt,name=rawChecks,proto3" json:"rawChecks,omitempty"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKolideCacheResponse) Reset() {
-	*x = GetKolideCacheResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[49]
+func (x *ReassignDeviceResponse) Reset() {
+	*x = ReassignDeviceResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKolideCacheResponse) String() string {
+func (x *ReassignDeviceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKolideCacheResponse) ProtoMessage() {}
+func (*ReassignDeviceResponse) ProtoMessage() {}
 
-func (x *GetKolideCacheResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[49]
+func (x *ReassignDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,40 +3507,41 @@ func (x *GetKolideCacheResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKolideCacheResponse.ProtoReflect.Descriptor instead.
-func (*GetKolideCacheResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ReassignDeviceResponse.ProtoReflect.Descriptor instead.
+func (*ReassignDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetKolideCacheResponse) GetRawChecks() []byte {
+func (x *ReassignDeviceResponse) GetDevice() *Device {
 	if x != nil {
-		return x.RawChecks
+		return x.Device
 	}
 	return nil
 }
 
-type GetAcceptableUseAcceptedAtRequest struct {
+type GetSessionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAcceptableUseAcceptedAtRequest) Reset() {
-	*x = GetAcceptableUseAcceptedAtRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[50]
+func (x *GetSessionsRequest) Reset() {
+	*x = GetSessionsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAcceptableUseAcceptedAtRequest) String() string {
+func (x *GetSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAcceptableUseAcceptedAtRequest) ProtoMessage() {}
+func (*GetSessionsRequest) ProtoMessage() {}
 
-func (x *GetAcceptableUseAcceptedAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[50]
+func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,40 +3552,47 @@ func (x *GetAcceptableUseAcceptedAtRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAcceptableUseAcceptedAtRequest.ProtoReflect.Descriptor instead.
-func (*GetAcceptableUseAcceptedAtRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetAcceptableUseAcceptedAtRequest) GetSessionKey() string {
+func (x *GetSessionsRequest) GetPassword() string {
 	if x != nil {
-		return x.SessionKey
+		return x.Password
 	}
 	return ""
 }
 
-type GetAcceptableUseAcceptedAtResponse struct {
+func (x *GetSessionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AcceptedAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=acceptedAt,proto3" json:"acceptedAt,omitempty"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAcceptableUseAcceptedAtResponse) Reset() {
-	*x = GetAcceptableUseAcceptedAtResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[51]
+func (x *GetSessionsResponse) Reset() {
+	*x = GetSessionsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAcceptableUseAcceptedAtResponse) String() string {
+func (x *GetSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAcceptableUseAcceptedAtResponse) ProtoMessage() {}
+func (*GetSessionsResponse) ProtoMessage() {}
 
-func (x *GetAcceptableUseAcceptedAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[51]
+func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,41 +3603,47 @@ func (x *GetAcceptableUseAcceptedAtResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAcceptableUseAcceptedAtResponse.ProtoReflect.Descriptor instead.
-func (*GetAcceptableUseAcceptedAtResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetAcceptableUseAcceptedAtResponse) GetAcceptedAt() *timestamppb.Timestamp {
+func (x *GetSessionsResponse) GetSessions() []*Session {
 	if x != nil {
-		return x.AcceptedAt
+		return x.Sessions
 	}
 	return nil
 }
 
-type SetAcceptableUseAcceptedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
-	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+type RevokeSessionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RevokeSessionsRequest_SessionKey
+	//	*RevokeSessionsRequest_DeviceID
+	//	*RevokeSessionsRequest_ObjectID
+	Target        isRevokeSessionsRequest_Target `protobuf_oneof:"target"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAcceptableUseAcceptedRequest) Reset() {
-	*x = SetAcceptableUseAcceptedRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[52]
+func (x *RevokeSessionsRequest) Reset() {
+	*x = RevokeSessionsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAcceptableUseAcceptedRequest) String() string {
+func (x *RevokeSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAcceptableUseAcceptedRequest) ProtoMessage() {}
+func (*RevokeSessionsRequest) ProtoMessage() {}
 
-func (x *SetAcceptableUseAcceptedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[52]
+func (x *RevokeSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,46 +3654,103 @@ func (x *SetAcceptableUseAcceptedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAcceptableUseAcceptedRequest.ProtoReflect.Descriptor instead.
-func (*SetAcceptableUseAcceptedRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use RevokeSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SetAcceptableUseAcceptedRequest) GetSessionKey() string {
+func (x *RevokeSessionsRequest) GetPassword() string {
 	if x != nil {
-		return x.SessionKey
+		return x.Password
 	}
 	return ""
 }
 
-func (x *SetAcceptableUseAcceptedRequest) GetAccepted() bool {
+func (x *RevokeSessionsRequest) GetUsername() string {
 	if x != nil {
-		return x.Accepted
+		return x.Username
 	}
-	return false
+	return ""
 }
 
-type SetAcceptableUseAcceptedResponse struct {
+func (x *RevokeSessionsRequest) GetTarget() isRevokeSessionsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RevokeSessionsRequest) GetSessionKey() string {
+	if x != nil {
+		if x, ok := x.Target.(*RevokeSessionsRequest_SessionKey); ok {
+			return x.SessionKey
+		}
+	}
+	return ""
+}
+
+func (x *RevokeSessionsRequest) GetDeviceID() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*RevokeSessionsRequest_DeviceID); ok {
+			return x.DeviceID
+		}
+	}
+	return 0
+}
+
+func (x *RevokeSessionsRequest) GetObjectID() string {
+	if x != nil {
+		if x, ok := x.Target.(*RevokeSessionsRequest_ObjectID); ok {
+			return x.ObjectID
+		}
+	}
+	return ""
+}
+
+type isRevokeSessionsRequest_Target interface {
+	isRevokeSessionsRequest_Target()
+}
+
+type RevokeSessionsRequest_SessionKey struct {
+	SessionKey string `protobuf:"bytes,3,opt,name=sessionKey,proto3,oneof"`
+}
+
+type RevokeSessionsRequest_DeviceID struct {
+	DeviceID int64 `protobuf:"varint,4,opt,name=deviceID,proto3,oneof"`
+}
+
+type RevokeSessionsRequest_ObjectID struct {
+	ObjectID string `protobuf:"bytes,5,opt,name=objectID,proto3,oneof"`
+}
+
+func (*RevokeSessionsRequest_SessionKey) isRevokeSessionsRequest_Target() {}
+
+func (*RevokeSessionsRequest_DeviceID) isRevokeSessionsRequest_Target() {}
+
+func (*RevokeSessionsRequest_ObjectID) isRevokeSessionsRequest_Target() {}
+
+type RevokeSessionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAcceptableUseAcceptedResponse) Reset() {
-	*x = SetAcceptableUseAcceptedResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[53]
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAcceptableUseAcceptedResponse) String() string {
+func (x *RevokeSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAcceptableUseAcceptedResponse) ProtoMessage() {}
+func (*RevokeSessionsResponse) ProtoMessage() {}
 
-func (x *SetAcceptableUseAcceptedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[53]
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2885,38 +3761,45 @@ func (x *SetAcceptableUseAcceptedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAcceptableUseAcceptedResponse.ProtoReflect.Descriptor instead.
-func (*SetAcceptableUseAcceptedResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{61}
 }
 
-type GatewayJitaGrant struct {
+func (x *RevokeSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Created       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
-	Expires       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
-	Revoked       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
 	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GatewayJitaGrant) Reset() {
-	*x = GatewayJitaGrant{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[54]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GatewayJitaGrant) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayJitaGrant) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *GatewayJitaGrant) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[54]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,75 +3810,2000 @@ func (x *GatewayJitaGrant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayJitaGrant.ProtoReflect.Descriptor instead.
-func (*GatewayJitaGrant) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GatewayJitaGrant) GetId() int64 {
+func (x *AuditEvent) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GatewayJitaGrant) GetGateway() string {
+func (x *AuditEvent) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Gateway
+		return x.Created
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Actor    string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action   string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Target   string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Since    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	PageSize int32                  `protobuf:"varint,8,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// id of the last event on the previous page, 0 for the first page
+	PageToken     int64 `protobuf:"varint,9,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAuditEventsRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() int64 {
+	if x != nil {
+		return x.PageToken
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// pass as pageToken to fetch the next page, 0 when there are no more events
+	NextPageToken int64 `protobuf:"varint,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() int64 {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return 0
+}
+
+type SnapshotDatabaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotDatabaseRequest) Reset() {
+	*x = SnapshotDatabaseRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDatabaseRequest) ProtoMessage() {}
+
+func (x *SnapshotDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SnapshotDatabaseRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SnapshotDatabaseRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SnapshotDatabaseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the next chunk of the SQLite database file
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotDatabaseResponse) Reset() {
+	*x = SnapshotDatabaseResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDatabaseResponse) ProtoMessage() {}
+
+func (x *SnapshotDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SnapshotDatabaseResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{67}
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{68}
+}
+
+type GetPosturePolicyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// rate the Kolide check with this id, using its tags from the database unless tags are set
+	CheckID       int64    `protobuf:"varint,3,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPosturePolicyRequest) Reset() {
+	*x = GetPosturePolicyRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPosturePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPosturePolicyRequest) ProtoMessage() {}
+
+func (x *GetPosturePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPosturePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPosturePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetPosturePolicyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetPosturePolicyRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPosturePolicyRequest) GetCheckID() int64 {
+	if x != nil {
+		return x.CheckID
+	}
+	return 0
+}
+
+func (x *GetPosturePolicyRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PostureGracePeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Severity      Severity               `protobuf:"varint,1,opt,name=severity,proto3,enum=naisdevice.Severity" json:"severity,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,2,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostureGracePeriod) Reset() {
+	*x = PostureGracePeriod{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostureGracePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostureGracePeriod) ProtoMessage() {}
+
+func (x *PostureGracePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostureGracePeriod.ProtoReflect.Descriptor instead.
+func (*PostureGracePeriod) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PostureGracePeriod) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_Info
+}
+
+func (x *PostureGracePeriod) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type PostureTagSeverity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Severity      Severity               `protobuf:"varint,2,opt,name=severity,proto3,enum=naisdevice.Severity" json:"severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostureTagSeverity) Reset() {
+	*x = PostureTagSeverity{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostureTagSeverity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostureTagSeverity) ProtoMessage() {}
+
+func (x *PostureTagSeverity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostureTagSeverity.ProtoReflect.Descriptor instead.
+func (*PostureTagSeverity) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PostureTagSeverity) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PostureTagSeverity) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_Info
+}
+
+type PostureCheckOverride struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CheckID  int64                  `protobuf:"varint,1,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	Severity *Severity              `protobuf:"varint,2,opt,name=severity,proto3,enum=naisdevice.Severity,oneof" json:"severity,omitempty"`
+	// not set if the grace period is not overridden
+	GracePeriod   *durationpb.Duration `protobuf:"bytes,3,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostureCheckOverride) Reset() {
+	*x = PostureCheckOverride{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostureCheckOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostureCheckOverride) ProtoMessage() {}
+
+func (x *PostureCheckOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostureCheckOverride.ProtoReflect.Descriptor instead.
+func (*PostureCheckOverride) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PostureCheckOverride) GetCheckID() int64 {
+	if x != nil {
+		return x.CheckID
+	}
+	return 0
+}
+
+func (x *PostureCheckOverride) GetSeverity() Severity {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return Severity_Info
+}
+
+func (x *PostureCheckOverride) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type PostureRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckID       int64                  `protobuf:"varint,1,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Severity      Severity               `protobuf:"varint,3,opt,name=severity,proto3,enum=naisdevice.Severity" json:"severity,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,4,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostureRating) Reset() {
+	*x = PostureRating{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostureRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostureRating) ProtoMessage() {}
+
+func (x *PostureRating) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostureRating.ProtoReflect.Descriptor instead.
+func (*PostureRating) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PostureRating) GetCheckID() int64 {
+	if x != nil {
+		return x.CheckID
+	}
+	return 0
+}
+
+func (x *PostureRating) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostureRating) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_Info
+}
+
+func (x *PostureRating) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *PostureRating) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetPosturePolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path of the policy file, empty when the built-in policy is used
+	Source          string                  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	GracePeriods    []*PostureGracePeriod   `protobuf:"bytes,2,rep,name=gracePeriods,proto3" json:"gracePeriods,omitempty"`
+	Tags            []*PostureTagSeverity   `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	DefaultSeverity Severity                `protobuf:"varint,4,opt,name=defaultSeverity,proto3,enum=naisdevice.Severity" json:"defaultSeverity,omitempty"`
+	Checks          []*PostureCheckOverride `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+	// set when a check or tags are given in the request
+	Rating        *PostureRating `protobuf:"bytes,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPosturePolicyResponse) Reset() {
+	*x = GetPosturePolicyResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPosturePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPosturePolicyResponse) ProtoMessage() {}
+
+func (x *GetPosturePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPosturePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPosturePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPosturePolicyResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetPosturePolicyResponse) GetGracePeriods() []*PostureGracePeriod {
+	if x != nil {
+		return x.GracePeriods
+	}
+	return nil
+}
+
+func (x *GetPosturePolicyResponse) GetTags() []*PostureTagSeverity {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetPosturePolicyResponse) GetDefaultSeverity() Severity {
+	if x != nil {
+		return x.DefaultSeverity
+	}
+	return Severity_Info
+}
+
+func (x *GetPosturePolicyResponse) GetChecks() []*PostureCheckOverride {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GetPosturePolicyResponse) GetRating() *PostureRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+type CreateIssueExemptionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// at least one of deviceID, user and checkID must be set
+	DeviceID      int64                  `protobuf:"varint,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	User          string                 `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	CheckID       int64                  `protobuf:"varint,5,opt,name=checkID,proto3" json:"checkID,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueExemptionRequest) Reset() {
+	*x = CreateIssueExemptionRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueExemptionRequest) ProtoMessage() {}
+
+func (x *CreateIssueExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueExemptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateIssueExemptionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateIssueExemptionRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateIssueExemptionRequest) GetDeviceID() int64 {
+	if x != nil {
+		return x.DeviceID
+	}
+	return 0
+}
+
+func (x *CreateIssueExemptionRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CreateIssueExemptionRequest) GetCheckID() int64 {
+	if x != nil {
+		return x.CheckID
+	}
+	return 0
+}
+
+func (x *CreateIssueExemptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateIssueExemptionRequest) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type ListIssueExemptionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// only list exemptions that are neither expired nor revoked
+	ActiveOnly    bool `protobuf:"varint,3,opt,name=activeOnly,proto3" json:"activeOnly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueExemptionsRequest) Reset() {
+	*x = ListIssueExemptionsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueExemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueExemptionsRequest) ProtoMessage() {}
+
+func (x *ListIssueExemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueExemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueExemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListIssueExemptionsRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ListIssueExemptionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListIssueExemptionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListIssueExemptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exemptions    []*IssueExemption      `protobuf:"bytes,1,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueExemptionsResponse) Reset() {
+	*x = ListIssueExemptionsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueExemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueExemptionsResponse) ProtoMessage() {}
+
+func (x *ListIssueExemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueExemptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueExemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListIssueExemptionsResponse) GetExemptions() []*IssueExemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
+type RevokeIssueExemptionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Id       int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// recorded in the audit log
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeIssueExemptionRequest) Reset() {
+	*x = RevokeIssueExemptionRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeIssueExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIssueExemptionRequest) ProtoMessage() {}
+
+func (x *RevokeIssueExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIssueExemptionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeIssueExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevokeIssueExemptionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RevokeIssueExemptionRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RevokeIssueExemptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeIssueExemptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetKolideCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKolideCacheRequest) Reset() {
+	*x = GetKolideCacheRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKolideCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKolideCacheRequest) ProtoMessage() {}
+
+func (x *GetKolideCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKolideCacheRequest.ProtoReflect.Descriptor instead.
+func (*GetKolideCacheRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetKolideCacheRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetKolideCacheRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetKolideCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RawChecks     []byte                 `protobuf:"bytes,99,opt,name=rawChecks,proto3" json:"rawChecks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKolideCacheResponse) Reset() {
+	*x = GetKolideCacheResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKolideCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKolideCacheResponse) ProtoMessage() {}
+
+func (x *GetKolideCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKolideCacheResponse.ProtoReflect.Descriptor instead.
+func (*GetKolideCacheResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetKolideCacheResponse) GetRawChecks() []byte {
+	if x != nil {
+		return x.RawChecks
+	}
+	return nil
+}
+
+type GetAcceptableUseAcceptedAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcceptableUseAcceptedAtRequest) Reset() {
+	*x = GetAcceptableUseAcceptedAtRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcceptableUseAcceptedAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcceptableUseAcceptedAtRequest) ProtoMessage() {}
+
+func (x *GetAcceptableUseAcceptedAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcceptableUseAcceptedAtRequest.ProtoReflect.Descriptor instead.
+func (*GetAcceptableUseAcceptedAtRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetAcceptableUseAcceptedAtRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+type GetAcceptableUseAcceptedAtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcceptedAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=acceptedAt,proto3" json:"acceptedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcceptableUseAcceptedAtResponse) Reset() {
+	*x = GetAcceptableUseAcceptedAtResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcceptableUseAcceptedAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcceptableUseAcceptedAtResponse) ProtoMessage() {}
+
+func (x *GetAcceptableUseAcceptedAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcceptableUseAcceptedAtResponse.ProtoReflect.Descriptor instead.
+func (*GetAcceptableUseAcceptedAtResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetAcceptableUseAcceptedAtResponse) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+type SetAcceptableUseAcceptedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAcceptableUseAcceptedRequest) Reset() {
+	*x = SetAcceptableUseAcceptedRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAcceptableUseAcceptedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAcceptableUseAcceptedRequest) ProtoMessage() {}
+
+func (x *SetAcceptableUseAcceptedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAcceptableUseAcceptedRequest.ProtoReflect.Descriptor instead.
+func (*SetAcceptableUseAcceptedRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetAcceptableUseAcceptedRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *SetAcceptableUseAcceptedRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type SetAcceptableUseAcceptedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAcceptableUseAcceptedResponse) Reset() {
+	*x = SetAcceptableUseAcceptedResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAcceptableUseAcceptedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAcceptableUseAcceptedResponse) ProtoMessage() {}
+
+func (x *SetAcceptableUseAcceptedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAcceptableUseAcceptedResponse.ProtoReflect.Descriptor instead.
+func (*SetAcceptableUseAcceptedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{84}
+}
+
+type GatewayJitaGrant struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Gateway string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Expires *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	Revoked *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	Reason  string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserID  string                 `protobuf:"bytes,7,opt,name=userID,proto3" json:"userID,omitempty"`
+	// only populated for admin listings and approvers, when the user has a known session
+	Username string       `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
+	Approval JitaApproval `protobuf:"varint,9,opt,name=approval,proto3,enum=naisdevice.JitaApproval" json:"approval,omitempty"`
+	// username of the user who approved or denied the grant
+	Approver      string                 `protobuf:"bytes,10,opt,name=approver,proto3" json:"approver,omitempty"`
+	Decided       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=decided,proto3" json:"decided,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayJitaGrant) Reset() {
+	*x = GatewayJitaGrant{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayJitaGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayJitaGrant) ProtoMessage() {}
+
+func (x *GatewayJitaGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayJitaGrant.ProtoReflect.Descriptor instead.
+func (*GatewayJitaGrant) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GatewayJitaGrant) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GatewayJitaGrant) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *GatewayJitaGrant) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *GatewayJitaGrant) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *GatewayJitaGrant) GetRevoked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Revoked
+	}
+	return nil
+}
+
+func (x *GatewayJitaGrant) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GatewayJitaGrant) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GatewayJitaGrant) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GatewayJitaGrant) GetApproval() JitaApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return JitaApproval_JitaApprovalNotRequired
+}
+
+func (x *GatewayJitaGrant) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *GatewayJitaGrant) GetDecided() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Decided
+	}
+	return nil
+}
+
+type GetGatewayJitaGrantsForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGatewayJitaGrantsForUserRequest) Reset() {
+	*x = GetGatewayJitaGrantsForUserRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGatewayJitaGrantsForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayJitaGrantsForUserRequest) ProtoMessage() {}
+
+func (x *GetGatewayJitaGrantsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayJitaGrantsForUserRequest.ProtoReflect.Descriptor instead.
+func (*GetGatewayJitaGrantsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetGatewayJitaGrantsForUserRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+type GetGatewayJitaGrantsForUserResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GatewayJitaGrants []*GatewayJitaGrant    `protobuf:"bytes,1,rep,name=gatewayJitaGrants,proto3" json:"gatewayJitaGrants,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetGatewayJitaGrantsForUserResponse) Reset() {
+	*x = GetGatewayJitaGrantsForUserResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGatewayJitaGrantsForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayJitaGrantsForUserResponse) ProtoMessage() {}
+
+func (x *GetGatewayJitaGrantsForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayJitaGrantsForUserResponse.ProtoReflect.Descriptor instead.
+func (*GetGatewayJitaGrantsForUserResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGatewayJitaGrantsForUserResponse) GetGatewayJitaGrants() []*GatewayJitaGrant {
+	if x != nil {
+		return x.GatewayJitaGrants
+	}
+	return nil
+}
+
+type UserHasAccessToPrivilegedGatewayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHasAccessToPrivilegedGatewayRequest) Reset() {
+	*x = UserHasAccessToPrivilegedGatewayRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserHasAccessToPrivilegedGatewayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHasAccessToPrivilegedGatewayRequest) ProtoMessage() {}
+
+func (x *UserHasAccessToPrivilegedGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHasAccessToPrivilegedGatewayRequest.ProtoReflect.Descriptor instead.
+func (*UserHasAccessToPrivilegedGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UserHasAccessToPrivilegedGatewayRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *UserHasAccessToPrivilegedGatewayRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+type UserHasAccessToPrivilegedGatewayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasAccess     bool                   `protobuf:"varint,1,opt,name=hasAccess,proto3" json:"hasAccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserHasAccessToPrivilegedGatewayResponse) Reset() {
+	*x = UserHasAccessToPrivilegedGatewayResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserHasAccessToPrivilegedGatewayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHasAccessToPrivilegedGatewayResponse) ProtoMessage() {}
+
+func (x *UserHasAccessToPrivilegedGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHasAccessToPrivilegedGatewayResponse.ProtoReflect.Descriptor instead.
+func (*UserHasAccessToPrivilegedGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UserHasAccessToPrivilegedGatewayResponse) GetHasAccess() bool {
+	if x != nil {
+		return x.HasAccess
+	}
+	return false
+}
+
+type NewPrivilegedGatewayAccess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires,proto3" json:"expires,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewPrivilegedGatewayAccess) Reset() {
+	*x = NewPrivilegedGatewayAccess{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPrivilegedGatewayAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPrivilegedGatewayAccess) ProtoMessage() {}
+
+func (x *NewPrivilegedGatewayAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPrivilegedGatewayAccess.ProtoReflect.Descriptor instead.
+func (*NewPrivilegedGatewayAccess) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *NewPrivilegedGatewayAccess) GetGateway() string {
+	if x != nil {
+		return x.Gateway
 	}
 	return ""
 }
 
-func (x *GatewayJitaGrant) GetCreated() *timestamppb.Timestamp {
+func (x *NewPrivilegedGatewayAccess) GetExpires() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Created
+		return x.Expires
 	}
 	return nil
 }
 
-func (x *GatewayJitaGrant) GetExpires() *timestamppb.Timestamp {
+func (x *NewPrivilegedGatewayAccess) GetReason() string {
 	if x != nil {
-		return x.Expires
+		return x.Reason
+	}
+	return ""
+}
+
+type GrantPrivilegedGatewayAccessRequest struct {
+	state                      protoimpl.MessageState      `protogen:"open.v1"`
+	SessionKey                 string                      `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	NewPrivilegedGatewayAccess *NewPrivilegedGatewayAccess `protobuf:"bytes,2,opt,name=newPrivilegedGatewayAccess,proto3" json:"newPrivilegedGatewayAccess,omitempty"`
+	Token                      string                      `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *GrantPrivilegedGatewayAccessRequest) Reset() {
+	*x = GrantPrivilegedGatewayAccessRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPrivilegedGatewayAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPrivilegedGatewayAccessRequest) ProtoMessage() {}
+
+func (x *GrantPrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPrivilegedGatewayAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantPrivilegedGatewayAccessRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GrantPrivilegedGatewayAccessRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *GrantPrivilegedGatewayAccessRequest) GetNewPrivilegedGatewayAccess() *NewPrivilegedGatewayAccess {
+	if x != nil {
+		return x.NewPrivilegedGatewayAccess
 	}
 	return nil
 }
 
-func (x *GatewayJitaGrant) GetRevoked() *timestamppb.Timestamp {
+func (x *GrantPrivilegedGatewayAccessRequest) GetToken() string {
 	if x != nil {
-		return x.Revoked
+		return x.Token
+	}
+	return ""
+}
+
+type GrantPrivilegedGatewayAccessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the gateway requires approval, and the grant will not take effect until approved
+	PendingApproval bool `protobuf:"varint,1,opt,name=pendingApproval,proto3" json:"pendingApproval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GrantPrivilegedGatewayAccessResponse) Reset() {
+	*x = GrantPrivilegedGatewayAccessResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPrivilegedGatewayAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPrivilegedGatewayAccessResponse) ProtoMessage() {}
+
+func (x *GrantPrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPrivilegedGatewayAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantPrivilegedGatewayAccessResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GrantPrivilegedGatewayAccessResponse) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
+type RevokePrivilegedGatewayAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePrivilegedGatewayAccessRequest) Reset() {
+	*x = RevokePrivilegedGatewayAccessRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePrivilegedGatewayAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePrivilegedGatewayAccessRequest) ProtoMessage() {}
+
+func (x *RevokePrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePrivilegedGatewayAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokePrivilegedGatewayAccessRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RevokePrivilegedGatewayAccessRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *RevokePrivilegedGatewayAccessRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+type RevokePrivilegedGatewayAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePrivilegedGatewayAccessResponse) Reset() {
+	*x = RevokePrivilegedGatewayAccessResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePrivilegedGatewayAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePrivilegedGatewayAccessResponse) ProtoMessage() {}
+
+func (x *RevokePrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePrivilegedGatewayAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokePrivilegedGatewayAccessResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{94}
+}
+
+type GetPrivilegedGatewayAccessPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
+	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyRequest) Reset() {
+	*x = GetPrivilegedGatewayAccessPolicyRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivilegedGatewayAccessPolicyRequest) ProtoMessage() {}
+
+func (x *GetPrivilegedGatewayAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivilegedGatewayAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivilegedGatewayAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyRequest) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+type GetPrivilegedGatewayAccessPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *JitaPolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyResponse) Reset() {
+	*x = GetPrivilegedGatewayAccessPolicyResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivilegedGatewayAccessPolicyResponse) ProtoMessage() {}
+
+func (x *GetPrivilegedGatewayAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GatewayJitaGrant) GetReason() string {
+// Deprecated: Use GetPrivilegedGatewayAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivilegedGatewayAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetPrivilegedGatewayAccessPolicyResponse) GetPolicy() *JitaPolicy {
 	if x != nil {
-		return x.Reason
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-type GetGatewayJitaGrantsForUserRequest struct {
+type GetPendingPrivilegedGatewayAccessRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGatewayJitaGrantsForUserRequest) Reset() {
-	*x = GetGatewayJitaGrantsForUserRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[55]
+func (x *GetPendingPrivilegedGatewayAccessRequestsRequest) Reset() {
+	*x = GetPendingPrivilegedGatewayAccessRequestsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGatewayJitaGrantsForUserRequest) String() string {
+func (x *GetPendingPrivilegedGatewayAccessRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGatewayJitaGrantsForUserRequest) ProtoMessage() {}
+func (*GetPendingPrivilegedGatewayAccessRequestsRequest) ProtoMessage() {}
 
-func (x *GetGatewayJitaGrantsForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[55]
+func (x *GetPendingPrivilegedGatewayAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,40 +5814,40 @@ func (x *GetGatewayJitaGrantsForUserRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGatewayJitaGrantsForUserRequest.ProtoReflect.Descriptor instead.
-func (*GetGatewayJitaGrantsForUserRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GetPendingPrivilegedGatewayAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingPrivilegedGatewayAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetGatewayJitaGrantsForUserRequest) GetSessionKey() string {
+func (x *GetPendingPrivilegedGatewayAccessRequestsRequest) GetSessionKey() string {
 	if x != nil {
 		return x.SessionKey
 	}
 	return ""
 }
 
-type GetGatewayJitaGrantsForUserResponse struct {
+type GetPendingPrivilegedGatewayAccessRequestsResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	GatewayJitaGrants []*GatewayJitaGrant    `protobuf:"bytes,1,rep,name=gatewayJitaGrants,proto3" json:"gatewayJitaGrants,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetGatewayJitaGrantsForUserResponse) Reset() {
-	*x = GetGatewayJitaGrantsForUserResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[56]
+func (x *GetPendingPrivilegedGatewayAccessRequestsResponse) Reset() {
+	*x = GetPendingPrivilegedGatewayAccessRequestsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGatewayJitaGrantsForUserResponse) String() string {
+func (x *GetPendingPrivilegedGatewayAccessRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGatewayJitaGrantsForUserResponse) ProtoMessage() {}
+func (*GetPendingPrivilegedGatewayAccessRequestsResponse) ProtoMessage() {}
 
-func (x *GetGatewayJitaGrantsForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[56]
+func (x *GetPendingPrivilegedGatewayAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3050,41 +5858,43 @@ func (x *GetGatewayJitaGrantsForUserResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGatewayJitaGrantsForUserResponse.ProtoReflect.Descriptor instead.
-func (*GetGatewayJitaGrantsForUserResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetPendingPrivilegedGatewayAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingPrivilegedGatewayAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetGatewayJitaGrantsForUserResponse) GetGatewayJitaGrants() []*GatewayJitaGrant {
+func (x *GetPendingPrivilegedGatewayAccessRequestsResponse) GetGatewayJitaGrants() []*GatewayJitaGrant {
 	if x != nil {
 		return x.GatewayJitaGrants
 	}
 	return nil
 }
 
-type UserHasAccessToPrivilegedGatewayRequest struct {
+type ReviewPrivilegedGatewayAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
-	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	GrantID       int64                  `protobuf:"varint,3,opt,name=grantID,proto3" json:"grantID,omitempty"`
+	Approved      bool                   `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserHasAccessToPrivilegedGatewayRequest) Reset() {
-	*x = UserHasAccessToPrivilegedGatewayRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[57]
+func (x *ReviewPrivilegedGatewayAccessRequest) Reset() {
+	*x = ReviewPrivilegedGatewayAccessRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserHasAccessToPrivilegedGatewayRequest) String() string {
+func (x *ReviewPrivilegedGatewayAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserHasAccessToPrivilegedGatewayRequest) ProtoMessage() {}
+func (*ReviewPrivilegedGatewayAccessRequest) ProtoMessage() {}
 
-func (x *UserHasAccessToPrivilegedGatewayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[57]
+func (x *ReviewPrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3095,47 +5905,61 @@ func (x *UserHasAccessToPrivilegedGatewayRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserHasAccessToPrivilegedGatewayRequest.ProtoReflect.Descriptor instead.
-func (*UserHasAccessToPrivilegedGatewayRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ReviewPrivilegedGatewayAccessRequest.ProtoReflect.Descriptor instead.
+func (*ReviewPrivilegedGatewayAccessRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *UserHasAccessToPrivilegedGatewayRequest) GetSessionKey() string {
+func (x *ReviewPrivilegedGatewayAccessRequest) GetSessionKey() string {
 	if x != nil {
 		return x.SessionKey
 	}
 	return ""
 }
 
-func (x *UserHasAccessToPrivilegedGatewayRequest) GetGateway() string {
+func (x *ReviewPrivilegedGatewayAccessRequest) GetToken() string {
 	if x != nil {
-		return x.Gateway
+		return x.Token
 	}
 	return ""
 }
 
-type UserHasAccessToPrivilegedGatewayResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasAccess     bool                   `protobuf:"varint,1,opt,name=hasAccess,proto3" json:"hasAccess,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReviewPrivilegedGatewayAccessRequest) GetGrantID() int64 {
+	if x != nil {
+		return x.GrantID
+	}
+	return 0
 }
 
-func (x *UserHasAccessToPrivilegedGatewayResponse) Reset() {
-	*x = UserHasAccessToPrivilegedGatewayResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[58]
+func (x *ReviewPrivilegedGatewayAccessRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+type ReviewPrivilegedGatewayAccessResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GatewayJitaGrant *GatewayJitaGrant      `protobuf:"bytes,1,opt,name=gatewayJitaGrant,proto3" json:"gatewayJitaGrant,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewPrivilegedGatewayAccessResponse) Reset() {
+	*x = ReviewPrivilegedGatewayAccessResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserHasAccessToPrivilegedGatewayResponse) String() string {
+func (x *ReviewPrivilegedGatewayAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserHasAccessToPrivilegedGatewayResponse) ProtoMessage() {}
+func (*ReviewPrivilegedGatewayAccessResponse) ProtoMessage() {}
 
-func (x *UserHasAccessToPrivilegedGatewayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[58]
+func (x *ReviewPrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3146,42 +5970,48 @@ func (x *UserHasAccessToPrivilegedGatewayResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserHasAccessToPrivilegedGatewayResponse.ProtoReflect.Descriptor instead.
-func (*UserHasAccessToPrivilegedGatewayResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ReviewPrivilegedGatewayAccessResponse.ProtoReflect.Descriptor instead.
+func (*ReviewPrivilegedGatewayAccessResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UserHasAccessToPrivilegedGatewayResponse) GetHasAccess() bool {
+func (x *ReviewPrivilegedGatewayAccessResponse) GetGatewayJitaGrant() *GatewayJitaGrant {
 	if x != nil {
-		return x.HasAccess
+		return x.GatewayJitaGrant
 	}
-	return false
+	return nil
 }
 
-type NewPrivilegedGatewayAccess struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gateway       string                 `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	Expires       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires,proto3" json:"expires,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type ListGatewayJitaGrantsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Gateway  string                 `protobuf:"bytes,3,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	UserID   string                 `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	// only grants created within this time range
+	Since *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// only grants that are neither expired nor revoked
+	ActiveOnly    bool `protobuf:"varint,7,opt,name=activeOnly,proto3" json:"activeOnly,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NewPrivilegedGatewayAccess) Reset() {
-	*x = NewPrivilegedGatewayAccess{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[59]
+func (x *ListGatewayJitaGrantsRequest) Reset() {
+	*x = ListGatewayJitaGrantsRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewPrivilegedGatewayAccess) String() string {
+func (x *ListGatewayJitaGrantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPrivilegedGatewayAccess) ProtoMessage() {}
+func (*ListGatewayJitaGrantsRequest) ProtoMessage() {}
 
-func (x *NewPrivilegedGatewayAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[59]
+func (x *ListGatewayJitaGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3192,113 +6022,82 @@ func (x *NewPrivilegedGatewayAccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPrivilegedGatewayAccess.ProtoReflect.Descriptor instead.
-func (*NewPrivilegedGatewayAccess) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ListGatewayJitaGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListGatewayJitaGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *NewPrivilegedGatewayAccess) GetGateway() string {
+func (x *ListGatewayJitaGrantsRequest) GetPassword() string {
 	if x != nil {
-		return x.Gateway
+		return x.Password
 	}
 	return ""
 }
 
-func (x *NewPrivilegedGatewayAccess) GetExpires() *timestamppb.Timestamp {
+func (x *ListGatewayJitaGrantsRequest) GetUsername() string {
 	if x != nil {
-		return x.Expires
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *NewPrivilegedGatewayAccess) GetReason() string {
+func (x *ListGatewayJitaGrantsRequest) GetGateway() string {
 	if x != nil {
-		return x.Reason
+		return x.Gateway
 	}
 	return ""
 }
 
-type GrantPrivilegedGatewayAccessRequest struct {
-	state                      protoimpl.MessageState      `protogen:"open.v1"`
-	SessionKey                 string                      `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
-	NewPrivilegedGatewayAccess *NewPrivilegedGatewayAccess `protobuf:"bytes,2,opt,name=newPrivilegedGatewayAccess,proto3" json:"newPrivilegedGatewayAccess,omitempty"`
-	Token                      string                      `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
-}
-
-func (x *GrantPrivilegedGatewayAccessRequest) Reset() {
-	*x = GrantPrivilegedGatewayAccessRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GrantPrivilegedGatewayAccessRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GrantPrivilegedGatewayAccessRequest) ProtoMessage() {}
-
-func (x *GrantPrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[60]
+func (x *ListGatewayJitaGrantsRequest) GetUserID() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GrantPrivilegedGatewayAccessRequest.ProtoReflect.Descriptor instead.
-func (*GrantPrivilegedGatewayAccessRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{60}
+	return ""
 }
 
-func (x *GrantPrivilegedGatewayAccessRequest) GetSessionKey() string {
+func (x *ListGatewayJitaGrantsRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SessionKey
+		return x.Since
 	}
-	return ""
+	return nil
 }
 
-func (x *GrantPrivilegedGatewayAccessRequest) GetNewPrivilegedGatewayAccess() *NewPrivilegedGatewayAccess {
+func (x *ListGatewayJitaGrantsRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NewPrivilegedGatewayAccess
+		return x.Until
 	}
 	return nil
 }
 
-func (x *GrantPrivilegedGatewayAccessRequest) GetToken() string {
+func (x *ListGatewayJitaGrantsRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.Token
+		return x.ActiveOnly
 	}
-	return ""
+	return false
 }
 
-type GrantPrivilegedGatewayAccessResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListGatewayJitaGrantsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GatewayJitaGrants []*GatewayJitaGrant    `protobuf:"bytes,1,rep,name=gatewayJitaGrants,proto3" json:"gatewayJitaGrants,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GrantPrivilegedGatewayAccessResponse) Reset() {
-	*x = GrantPrivilegedGatewayAccessResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[61]
+func (x *ListGatewayJitaGrantsResponse) Reset() {
+	*x = ListGatewayJitaGrantsResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantPrivilegedGatewayAccessResponse) String() string {
+func (x *ListGatewayJitaGrantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantPrivilegedGatewayAccessResponse) ProtoMessage() {}
+func (*ListGatewayJitaGrantsResponse) ProtoMessage() {}
 
-func (x *GrantPrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[61]
+func (x *ListGatewayJitaGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,34 +6108,43 @@ func (x *GrantPrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantPrivilegedGatewayAccessResponse.ProtoReflect.Descriptor instead.
-func (*GrantPrivilegedGatewayAccessResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ListGatewayJitaGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListGatewayJitaGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{102}
 }
 
-type RevokePrivilegedGatewayAccessRequest struct {
+func (x *ListGatewayJitaGrantsResponse) GetGatewayJitaGrants() []*GatewayJitaGrant {
+	if x != nil {
+		return x.GatewayJitaGrants
+	}
+	return nil
+}
+
+type RevokeGatewayJitaGrantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionKey    string                 `protobuf:"bytes,1,opt,name=sessionKey,proto3" json:"sessionKey,omitempty"`
-	Gateway       string                 `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Id            int64                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokePrivilegedGatewayAccessRequest) Reset() {
-	*x = RevokePrivilegedGatewayAccessRequest{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[62]
+func (x *RevokeGatewayJitaGrantRequest) Reset() {
+	*x = RevokeGatewayJitaGrantRequest{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokePrivilegedGatewayAccessRequest) String() string {
+func (x *RevokeGatewayJitaGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokePrivilegedGatewayAccessRequest) ProtoMessage() {}
+func (*RevokeGatewayJitaGrantRequest) ProtoMessage() {}
 
-func (x *RevokePrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[62]
+func (x *RevokeGatewayJitaGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,46 +6155,61 @@ func (x *RevokePrivilegedGatewayAccessRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokePrivilegedGatewayAccessRequest.ProtoReflect.Descriptor instead.
-func (*RevokePrivilegedGatewayAccessRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use RevokeGatewayJitaGrantRequest.ProtoReflect.Descriptor instead.
+func (*RevokeGatewayJitaGrantRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *RevokePrivilegedGatewayAccessRequest) GetSessionKey() string {
+func (x *RevokeGatewayJitaGrantRequest) GetPassword() string {
 	if x != nil {
-		return x.SessionKey
+		return x.Password
 	}
 	return ""
 }
 
-func (x *RevokePrivilegedGatewayAccessRequest) GetGateway() string {
+func (x *RevokeGatewayJitaGrantRequest) GetUsername() string {
 	if x != nil {
-		return x.Gateway
+		return x.Username
 	}
 	return ""
 }
 
-type RevokePrivilegedGatewayAccessResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RevokeGatewayJitaGrantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (x *RevokePrivilegedGatewayAccessResponse) Reset() {
-	*x = RevokePrivilegedGatewayAccessResponse{}
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[63]
+func (x *RevokeGatewayJitaGrantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeGatewayJitaGrantResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GatewayJitaGrant *GatewayJitaGrant      `protobuf:"bytes,1,opt,name=gatewayJitaGrant,proto3" json:"gatewayJitaGrant,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevokeGatewayJitaGrantResponse) Reset() {
+	*x = RevokeGatewayJitaGrantResponse{}
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokePrivilegedGatewayAccessResponse) String() string {
+func (x *RevokeGatewayJitaGrantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokePrivilegedGatewayAccessResponse) ProtoMessage() {}
+func (*RevokeGatewayJitaGrantResponse) ProtoMessage() {}
 
-func (x *RevokePrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[63]
+func (x *RevokeGatewayJitaGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protobuf_api_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3397,9 +6220,16 @@ func (x *RevokePrivilegedGatewayAccessResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokePrivilegedGatewayAccessResponse.ProtoReflect.Descriptor instead.
-func (*RevokePrivilegedGatewayAccessResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use RevokeGatewayJitaGrantResponse.ProtoReflect.Descriptor instead.
+func (*RevokeGatewayJitaGrantResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protobuf_api_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RevokeGatewayJitaGrantResponse) GetGatewayJitaGrant() *GatewayJitaGrant {
+	if x != nil {
+		return x.GatewayJitaGrant
+	}
+	return nil
 }
 
 var File_pkg_pb_protobuf_api_proto protoreflect.FileDescriptor
@@ -3407,7 +6237,7 @@ var File_pkg_pb_protobuf_api_proto protoreflect.FileDescriptor
 const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\n" +
 	"\x19pkg/pb/protobuf-api.proto\x12\n" +
-	"naisdevice\x1a\x1fgoogle/protobuf/timestamp.proto\"\x11\n" +
+	"naisdevice\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x11\n" +
 	"\x0fTeardownRequest\"\x12\n" +
 	"\x10TeardownResponse\"\x13\n" +
 	"\x11ConfigureResponse\"\x17\n" +
@@ -3461,7 +6291,13 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\agateway\x18\x02 \x01(\v2\x13.naisdevice.GatewayR\agateway\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\"F\n" +
 	"\x15ModifyGatewayResponse\x12-\n" +
-	"\agateway\x18\x01 \x01(\v2\x13.naisdevice.GatewayR\agateway\"\xe3\x02\n" +
+	"\agateway\x18\x01 \x01(\v2\x13.naisdevice.GatewayR\agateway\"\x17\n" +
+	"\x15DeleteGatewayResponse\"U\n" +
+	"\x1bGetWireGuardPrefixesRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\":\n" +
+	"\x1cGetWireGuardPrefixesResponse\x12\x1a\n" +
+	"\bprefixes\x18\x01 \x03(\tR\bprefixes\"\xc9\x03\n" +
 	"\aGateway\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x1c\n" +
@@ -3478,7 +6314,16 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\x0eaccessGroupIDs\x18\b \x03(\tR\x0eaccessGroupIDs\x12\"\n" +
 	"\fpasswordHash\x18\t \x01(\tR\fpasswordHash\x12\x12\n" +
 	"\x04ipv6\x18\n" +
-	" \x01(\tR\x04ipv6\"!\n" +
+	" \x01(\tR\x04ipv6\x12+\n" +
+	"\x10requiresApproval\x18\f \x01(\bR\x11requires_approval\x127\n" +
+	"\n" +
+	"jitaPolicy\x18\r \x01(\v2\x16.naisdevice.JitaPolicyR\vjita_policy\"\xc3\x01\n" +
+	"\n" +
+	"JitaPolicy\x12;\n" +
+	"\vmaxDuration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vmaxDuration\x12(\n" +
+	"\x0fminReasonLength\x18\x02 \x01(\x05R\x0fminReasonLength\x12$\n" +
+	"\rreasonPattern\x18\x03 \x01(\tR\rreasonPattern\x12(\n" +
+	"\x0fmaxGrantsPerDay\x18\x04 \x01(\x05R\x0fmaxGrantsPerDay\"!\n" +
 	"\x05Error\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\",\n" +
 	"\x16SetActiveTenantRequest\x12\x12\n" +
@@ -3492,10 +6337,11 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\asession\x18\x06 \x01(\v2\x13.naisdevice.SessionR\asessionJ\x04\b\x03\x10\x04R\x0eouttuneEnabled\"\x7f\n" +
 	"\x12AgentConfiguration\x12 \n" +
 	"\vAutoConnect\x18\x02 \x01(\bR\vAutoConnect\x124\n" +
-	"\x15ILoveNinetiesBoybands\x18\x03 \x01(\bR\x15ILoveNinetiesBoybandsJ\x04\b\x01\x10\x02R\vCertRenewal\"V\n" +
+	"\x15ILoveNinetiesBoybands\x18\x03 \x01(\bR\x15ILoveNinetiesBoybandsJ\x04\b\x01\x10\x02R\vCertRenewal\"\x90\x01\n" +
 	"\x1eGetGatewayConfigurationRequest\x12\x18\n" +
 	"\agateway\x18\x01 \x01(\tR\agateway\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x8f\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x128\n" +
+	"\x04mode\x18\x03 \x01(\x0e2$.naisdevice.GatewayConfigurationModeR\x04mode\"\xdf\x02\n" +
 	"\x1fGetGatewayConfigurationResponse\x12,\n" +
 	"\adevices\x18\x01 \x03(\v2\x12.naisdevice.DeviceR\adevices\x12\x1e\n" +
 	"\n" +
@@ -3503,22 +6349,36 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"routesIPv4\x12\x1e\n" +
 	"\n" +
 	"routesIPv6\x18\x03 \x03(\tR\n" +
-	"routesIPv6\"?\n" +
+	"routesIPv6\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x04 \x01(\x04R\n" +
+	"generation\x12\"\n" +
+	"\ffullSnapshot\x18\x05 \x01(\bR\ffullSnapshot\x126\n" +
+	"\faddedDevices\x18\x06 \x03(\v2\x12.naisdevice.DeviceR\faddedDevices\x12,\n" +
+	"\x11removedPublicKeys\x18\a \x03(\tR\x11removedPublicKeys\x12$\n" +
+	"\rroutesChanged\x18\b \x01(\bR\rroutesChanged\"Y\n" +
 	"\x1dGetDeviceConfigurationRequest\x12\x1e\n" +
 	"\n" +
 	"sessionKey\x18\x01 \x01(\tR\n" +
-	"sessionKey\"{\n" +
+	"sessionKey\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"{\n" +
 	"\x15APIServerLoginRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x16\n" +
 	"\x06serial\x18\x03 \x01(\tR\x06serial\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\tR\aversion\"G\n" +
 	"\x16APIServerLoginResponse\x12-\n" +
-	"\asession\x18\x01 \x01(\v2\x13.naisdevice.SessionR\asession\"\xc1\x01\n" +
+	"\asession\x18\x01 \x01(\v2\x13.naisdevice.SessionR\asession\"+\n" +
+	"\x13RenewSessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"E\n" +
+	"\x14RenewSessionResponse\x12-\n" +
+	"\asession\x18\x01 \x01(\v2\x13.naisdevice.SessionR\asession\"\x97\x02\n" +
 	"\x1eGetDeviceConfigurationResponse\x12=\n" +
 	"\x06status\x18\x01 \x01(\x0e2%.naisdevice.DeviceConfigurationStatusR\x06status\x12/\n" +
 	"\bGateways\x18\x02 \x03(\v2\x13.naisdevice.GatewayR\bGateways\x12/\n" +
-	"\x06issues\x18\x03 \x03(\v2\x17.naisdevice.DeviceIssueR\x06issues\"\xab\x02\n" +
+	"\x06issues\x18\x03 \x03(\v2\x17.naisdevice.DeviceIssueR\x06issues\x120\n" +
+	"\x13newVersionAvailable\x18\x04 \x01(\bR\x13newVersionAvailable\x12\"\n" +
+	"\frenewSession\x18\x05 \x01(\bR\frenewSession\"\xff\x02\n" +
 	"\vDeviceIssue\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
@@ -3527,10 +6387,22 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"detectedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"detectedAt\x12<\n" +
 	"\vlastUpdated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12@\n" +
-	"\rresolveBefore\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rresolveBefore\"L\n" +
+	"\rresolveBefore\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rresolveBefore\x12\x18\n" +
+	"\acheckID\x18\a \x01(\x03R\acheckID\x128\n" +
+	"\texemption\x18\b \x01(\v2\x1a.naisdevice.IssueExemptionR\texemption\"\xca\x02\n" +
+	"\x0eIssueExemption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bdeviceID\x18\x02 \x01(\x03R\bdeviceID\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x18\n" +
+	"\acheckID\x18\x04 \x01(\x03R\acheckID\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tcreatedBy\x18\x06 \x01(\tR\tcreatedBy\x124\n" +
+	"\acreated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
+	"\aexpires\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x124\n" +
+	"\arevoked\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\arevoked\"L\n" +
 	"\x12ListGatewayRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\"\xa5\x03\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xa3\x04\n" +
 	"\x06Device\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06serial\x18\x02 \x01(\tR\x06serial\x12<\n" +
@@ -3545,20 +6417,135 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\blastSeen\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x1e\n" +
 	"\n" +
 	"externalID\x18\x0e \x01(\tR\n" +
-	"externalIDJ\x04\b\x03\x10\x04J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x03pskR\x0ekolideLastSeenR\ahealthy\"\xaf\x01\n" +
+	"externalID\x12\"\n" +
+	"\fagentVersion\x18\x0f \x01(\tR\fagentVersion\x12X\n" +
+	"\x19agentVersionOutdatedSince\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x19agentVersionOutdatedSinceJ\x04\b\x03\x10\x04J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x03pskR\x0ekolideLastSeenR\ahealthy\"\xaf\x01\n" +
 	"\aSession\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
 	"\x06expiry\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06expiry\x12*\n" +
 	"\x06device\x18\x03 \x01(\v2\x12.naisdevice.DeviceR\x06device\x12\x16\n" +
 	"\x06groups\x18\x04 \x03(\tR\x06groups\x12\x1a\n" +
-	"\bobjectID\x18\x05 \x01(\tR\bobjectID\"L\n" +
+	"\bobjectID\x18\x05 \x01(\tR\bobjectID\"\xc8\x01\n" +
+	"\x12ListDevicesRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12&\n" +
+	"\x0edeviceUsername\x18\x03 \x01(\tR\x0edeviceUsername\x12\x1a\n" +
+	"\bplatform\x18\x04 \x01(\tR\bplatform\x126\n" +
+	"\x06health\x18\x05 \x01(\x0e2\x1e.naisdevice.DeviceHealthFilterR\x06health\"C\n" +
+	"\x13ListDevicesResponse\x12,\n" +
+	"\adevices\x18\x01 \x03(\v2\x12.naisdevice.DeviceR\adevices\"f\n" +
+	"\x10GetDeviceRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\x03R\bdeviceID\"i\n" +
+	"\x13DeleteDeviceRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\x03R\bdeviceID\"\x16\n" +
+	"\x14DeleteDeviceResponse\"\x99\x01\n" +
+	"\x15ReassignDeviceRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\x03R\bdeviceID\x12,\n" +
+	"\x11newDeviceUsername\x18\x04 \x01(\tR\x11newDeviceUsername\"D\n" +
+	"\x16ReassignDeviceResponse\x12*\n" +
+	"\x06device\x18\x01 \x01(\v2\x12.naisdevice.DeviceR\x06device\"L\n" +
 	"\x12GetSessionsRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\"F\n" +
 	"\x13GetSessionsResponse\x12/\n" +
-	"\bsessions\x18\x01 \x03(\v2\x13.naisdevice.SessionR\bsessions\"\r\n" +
+	"\bsessions\x18\x01 \x03(\v2\x13.naisdevice.SessionR\bsessions\"\xb7\x01\n" +
+	"\x15RevokeSessionsRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\n" +
+	"sessionKey\x18\x03 \x01(\tH\x00R\n" +
+	"sessionKey\x12\x1c\n" +
+	"\bdeviceID\x18\x04 \x01(\x03H\x00R\bdeviceID\x12\x1c\n" +
+	"\bobjectID\x18\x05 \x01(\tH\x00R\bobjectIDB\b\n" +
+	"\x06target\"I\n" +
+	"\x16RevokeSessionsResponse\x12/\n" +
+	"\bsessions\x18\x01 \x03(\v2\x13.naisdevice.SessionR\bsessions\"\xb0\x01\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x124\n" +
+	"\acreated\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xb4\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1a\n" +
+	"\bpageSize\x18\b \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\t \x01(\x03R\tpageToken\"o\n" +
+	"\x17ListAuditEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.naisdevice.AuditEventR\x06events\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\x03R\rnextPageToken\"Q\n" +
+	"\x17SnapshotDatabaseRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\".\n" +
+	"\x18SnapshotDatabaseResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\r\n" +
 	"\vPingRequest\"\x0e\n" +
-	"\fPingResponse\"O\n" +
+	"\fPingResponse\"\x7f\n" +
+	"\x17GetPosturePolicyRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
+	"\acheckID\x18\x03 \x01(\x03R\acheckID\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\"\x83\x01\n" +
+	"\x12PostureGracePeriod\x120\n" +
+	"\bseverity\x18\x01 \x01(\x0e2\x14.naisdevice.SeverityR\bseverity\x12;\n" +
+	"\vgracePeriod\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\"X\n" +
+	"\x12PostureTagSeverity\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x120\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x14.naisdevice.SeverityR\bseverity\"\xb1\x01\n" +
+	"\x14PostureCheckOverride\x12\x18\n" +
+	"\acheckID\x18\x01 \x01(\x03R\acheckID\x125\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x14.naisdevice.SeverityH\x00R\bseverity\x88\x01\x01\x12;\n" +
+	"\vgracePeriod\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriodB\v\n" +
+	"\t_severity\"\xc4\x01\n" +
+	"\rPostureRating\x12\x18\n" +
+	"\acheckID\x18\x01 \x01(\x03R\acheckID\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x120\n" +
+	"\bseverity\x18\x03 \x01(\x0e2\x14.naisdevice.SeverityR\bseverity\x12;\n" +
+	"\vgracePeriod\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd7\x02\n" +
+	"\x18GetPosturePolicyResponse\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12B\n" +
+	"\fgracePeriods\x18\x02 \x03(\v2\x1e.naisdevice.PostureGracePeriodR\fgracePeriods\x122\n" +
+	"\x04tags\x18\x03 \x03(\v2\x1e.naisdevice.PostureTagSeverityR\x04tags\x12>\n" +
+	"\x0fdefaultSeverity\x18\x04 \x01(\x0e2\x14.naisdevice.SeverityR\x0fdefaultSeverity\x128\n" +
+	"\x06checks\x18\x05 \x03(\v2 .naisdevice.PostureCheckOverrideR\x06checks\x121\n" +
+	"\x06rating\x18\x06 \x01(\v2\x19.naisdevice.PostureRatingR\x06rating\"\xed\x01\n" +
+	"\x1bCreateIssueExemptionRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\x03R\bdeviceID\x12\x12\n" +
+	"\x04user\x18\x04 \x01(\tR\x04user\x12\x18\n" +
+	"\acheckID\x18\x05 \x01(\x03R\acheckID\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x124\n" +
+	"\aexpires\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\"t\n" +
+	"\x1aListIssueExemptionsRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1e\n" +
+	"\n" +
+	"activeOnly\x18\x03 \x01(\bR\n" +
+	"activeOnly\"Y\n" +
+	"\x1bListIssueExemptionsResponse\x12:\n" +
+	"\n" +
+	"exemptions\x18\x01 \x03(\v2\x1a.naisdevice.IssueExemptionR\n" +
+	"exemptions\"}\n" +
+	"\x1bRevokeIssueExemptionRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"O\n" +
 	"\x15GetKolideCacheRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\"6\n" +
@@ -3577,14 +6564,20 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"sessionKey\x18\x01 \x01(\tR\n" +
 	"sessionKey\x12\x1a\n" +
 	"\baccepted\x18\x02 \x01(\bR\baccepted\"\"\n" +
-	" SetAcceptableUseAcceptedResponse\"\xf6\x01\n" +
+	" SetAcceptableUseAcceptedResponse\"\xb2\x03\n" +
 	"\x10GatewayJitaGrant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\agateway\x18\x02 \x01(\tR\agateway\x124\n" +
 	"\acreated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x124\n" +
 	"\aexpires\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x124\n" +
 	"\arevoked\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\arevoked\x12\x16\n" +
-	"\x06reason\x18\x06 \x01(\tR\x06reason\"D\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06userID\x18\a \x01(\tR\x06userID\x12\x1a\n" +
+	"\busername\x18\b \x01(\tR\busername\x124\n" +
+	"\bapproval\x18\t \x01(\x0e2\x18.naisdevice.JitaApprovalR\bapproval\x12\x1a\n" +
+	"\bapprover\x18\n" +
+	" \x01(\tR\bapprover\x124\n" +
+	"\adecided\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\adecided\"D\n" +
 	"\"GetGatewayJitaGrantsForUserRequest\x12\x1e\n" +
 	"\n" +
 	"sessionKey\x18\x01 \x01(\tR\n" +
@@ -3607,14 +6600,56 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"sessionKey\x18\x01 \x01(\tR\n" +
 	"sessionKey\x12f\n" +
 	"\x1anewPrivilegedGatewayAccess\x18\x02 \x01(\v2&.naisdevice.NewPrivilegedGatewayAccessR\x1anewPrivilegedGatewayAccess\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token\"&\n" +
-	"$GrantPrivilegedGatewayAccessResponse\"`\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"P\n" +
+	"$GrantPrivilegedGatewayAccessResponse\x12(\n" +
+	"\x0fpendingApproval\x18\x01 \x01(\bR\x0fpendingApproval\"`\n" +
 	"$RevokePrivilegedGatewayAccessRequest\x12\x1e\n" +
 	"\n" +
 	"sessionKey\x18\x01 \x01(\tR\n" +
 	"sessionKey\x12\x18\n" +
 	"\agateway\x18\x02 \x01(\tR\agateway\"'\n" +
-	"%RevokePrivilegedGatewayAccessResponse*\xf2\x01\n" +
+	"%RevokePrivilegedGatewayAccessResponse\"c\n" +
+	"'GetPrivilegedGatewayAccessPolicyRequest\x12\x1e\n" +
+	"\n" +
+	"sessionKey\x18\x01 \x01(\tR\n" +
+	"sessionKey\x12\x18\n" +
+	"\agateway\x18\x02 \x01(\tR\agateway\"Z\n" +
+	"(GetPrivilegedGatewayAccessPolicyResponse\x12.\n" +
+	"\x06policy\x18\x01 \x01(\v2\x16.naisdevice.JitaPolicyR\x06policy\"R\n" +
+	"0GetPendingPrivilegedGatewayAccessRequestsRequest\x12\x1e\n" +
+	"\n" +
+	"sessionKey\x18\x01 \x01(\tR\n" +
+	"sessionKey\"\x7f\n" +
+	"1GetPendingPrivilegedGatewayAccessRequestsResponse\x12J\n" +
+	"\x11gatewayJitaGrants\x18\x01 \x03(\v2\x1c.naisdevice.GatewayJitaGrantR\x11gatewayJitaGrants\"\x92\x01\n" +
+	"$ReviewPrivilegedGatewayAccessRequest\x12\x1e\n" +
+	"\n" +
+	"sessionKey\x18\x01 \x01(\tR\n" +
+	"sessionKey\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x18\n" +
+	"\agrantID\x18\x03 \x01(\x03R\agrantID\x12\x1a\n" +
+	"\bapproved\x18\x04 \x01(\bR\bapproved\"q\n" +
+	"%ReviewPrivilegedGatewayAccessResponse\x12H\n" +
+	"\x10gatewayJitaGrant\x18\x01 \x01(\v2\x1c.naisdevice.GatewayJitaGrantR\x10gatewayJitaGrant\"\x8c\x02\n" +
+	"\x1cListGatewayJitaGrantsRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
+	"\agateway\x18\x03 \x01(\tR\agateway\x12\x16\n" +
+	"\x06userID\x18\x04 \x01(\tR\x06userID\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1e\n" +
+	"\n" +
+	"activeOnly\x18\a \x01(\bR\n" +
+	"activeOnly\"k\n" +
+	"\x1dListGatewayJitaGrantsResponse\x12J\n" +
+	"\x11gatewayJitaGrants\x18\x01 \x03(\v2\x1c.naisdevice.GatewayJitaGrantR\x11gatewayJitaGrants\"\x7f\n" +
+	"\x1dRevokeGatewayJitaGrantRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"j\n" +
+	"\x1eRevokeGatewayJitaGrantResponse\x12H\n" +
+	"\x10gatewayJitaGrant\x18\x01 \x01(\v2\x1c.naisdevice.GatewayJitaGrantR\x10gatewayJitaGrant*\xf2\x01\n" +
 	"\n" +
 	"AgentState\x12\x10\n" +
 	"\fDisconnected\x10\x00\x12\x11\n" +
@@ -3635,7 +6670,10 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\fAuthProvider\x12\t\n" +
 	"\x05Azure\x10\x00\x12\n" +
 	"\n" +
-	"\x06Google\x10\x01*V\n" +
+	"\x06Google\x10\x01*_\n" +
+	"\x18GatewayConfigurationMode\x12 \n" +
+	"\x1cGatewayConfigurationModeFull\x10\x00\x12!\n" +
+	"\x1dGatewayConfigurationModeDelta\x10\x01*V\n" +
 	"\bSeverity\x12\b\n" +
 	"\x04Info\x10\x00\x12\n" +
 	"\n" +
@@ -3644,7 +6682,16 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\n" +
 	"\x06Danger\x10\x03\x12\f\n" +
 	"\bCritical\x10\x04\x12\r\n" +
-	"\tAttention\x10\x052\xef\x02\n" +
+	"\tAttention\x10\x05*]\n" +
+	"\x12DeviceHealthFilter\x12\x13\n" +
+	"\x0fDeviceHealthAny\x10\x00\x12\x17\n" +
+	"\x13DeviceHealthHealthy\x10\x01\x12\x19\n" +
+	"\x15DeviceHealthUnhealthy\x10\x02*v\n" +
+	"\fJitaApproval\x12\x1b\n" +
+	"\x17JitaApprovalNotRequired\x10\x00\x12\x17\n" +
+	"\x13JitaApprovalPending\x10\x01\x12\x18\n" +
+	"\x14JitaApprovalApproved\x10\x02\x12\x16\n" +
+	"\x12JitaApprovalDenied\x10\x032\xef\x02\n" +
 	"\fDeviceHelper\x12G\n" +
 	"\tConfigure\x12\x19.naisdevice.Configuration\x1a\x1d.naisdevice.ConfigureResponse\"\x00\x12G\n" +
 	"\bTeardown\x12\x1b.naisdevice.TeardownRequest\x1a\x1c.naisdevice.TeardownResponse\"\x00\x12D\n" +
@@ -3661,24 +6708,43 @@ const file_pkg_pb_protobuf_api_proto_rawDesc = "" +
 	"\x15GetAgentConfiguration\x12(.naisdevice.GetAgentConfigurationRequest\x1a).naisdevice.GetAgentConfigurationResponse\"\x00\x12b\n" +
 	"\x11ShowAcceptableUse\x12$.naisdevice.ShowAcceptableUseRequest\x1a%.naisdevice.ShowAcceptableUseResponse\"\x00\x12G\n" +
 	"\bShowJita\x12\x1b.naisdevice.ShowJitaRequest\x1a\x1c.naisdevice.ShowJitaResponse\"\x00\x12G\n" +
-	"\bShutdown\x12\x1b.naisdevice.ShutdownRequest\x1a\x1c.naisdevice.ShutdownResponse\"\x002\xd3\f\n" +
+	"\bShutdown\x12\x1b.naisdevice.ShutdownRequest\x1a\x1c.naisdevice.ShutdownResponse\"\x002\x80\x1c\n" +
 	"\tAPIServer\x12P\n" +
-	"\x05Login\x12!.naisdevice.APIServerLoginRequest\x1a\".naisdevice.APIServerLoginResponse\"\x00\x12s\n" +
+	"\x05Login\x12!.naisdevice.APIServerLoginRequest\x1a\".naisdevice.APIServerLoginResponse\"\x00\x12S\n" +
+	"\fRenewSession\x12\x1f.naisdevice.RenewSessionRequest\x1a .naisdevice.RenewSessionResponse\"\x00\x12s\n" +
 	"\x16GetDeviceConfiguration\x12).naisdevice.GetDeviceConfigurationRequest\x1a*.naisdevice.GetDeviceConfigurationResponse\"\x000\x01\x12v\n" +
 	"\x17GetGatewayConfiguration\x12*.naisdevice.GetGatewayConfigurationRequest\x1a+.naisdevice.GetGatewayConfigurationResponse\"\x000\x01\x12E\n" +
 	"\n" +
 	"GetGateway\x12 .naisdevice.ModifyGatewayRequest\x1a\x13.naisdevice.Gateway\"\x00\x12G\n" +
 	"\fListGateways\x12\x1e.naisdevice.ListGatewayRequest\x1a\x13.naisdevice.Gateway\"\x000\x01\x12V\n" +
 	"\rEnrollGateway\x12 .naisdevice.ModifyGatewayRequest\x1a!.naisdevice.ModifyGatewayResponse\"\x00\x12V\n" +
-	"\rUpdateGateway\x12 .naisdevice.ModifyGatewayRequest\x1a!.naisdevice.ModifyGatewayResponse\"\x00\x12P\n" +
+	"\rUpdateGateway\x12 .naisdevice.ModifyGatewayRequest\x1a!.naisdevice.ModifyGatewayResponse\"\x00\x12V\n" +
+	"\rDeleteGateway\x12 .naisdevice.ModifyGatewayRequest\x1a!.naisdevice.DeleteGatewayResponse\"\x00\x12k\n" +
+	"\x14GetWireGuardPrefixes\x12'.naisdevice.GetWireGuardPrefixesRequest\x1a(.naisdevice.GetWireGuardPrefixesResponse\"\x00\x12P\n" +
+	"\vListDevices\x12\x1e.naisdevice.ListDevicesRequest\x1a\x1f.naisdevice.ListDevicesResponse\"\x00\x12?\n" +
+	"\tGetDevice\x12\x1c.naisdevice.GetDeviceRequest\x1a\x12.naisdevice.Device\"\x00\x12S\n" +
+	"\fDeleteDevice\x12\x1f.naisdevice.DeleteDeviceRequest\x1a .naisdevice.DeleteDeviceResponse\"\x00\x12Y\n" +
+	"\x0eReassignDevice\x12!.naisdevice.ReassignDeviceRequest\x1a\".naisdevice.ReassignDeviceResponse\"\x00\x12P\n" +
 	"\vGetSessions\x12\x1e.naisdevice.GetSessionsRequest\x1a\x1f.naisdevice.GetSessionsResponse\"\x00\x12Y\n" +
+	"\x0eRevokeSessions\x12!.naisdevice.RevokeSessionsRequest\x1a\".naisdevice.RevokeSessionsResponse\"\x00\x12\\\n" +
+	"\x0fListAuditEvents\x12\".naisdevice.ListAuditEventsRequest\x1a#.naisdevice.ListAuditEventsResponse\"\x00\x12a\n" +
+	"\x10SnapshotDatabase\x12#.naisdevice.SnapshotDatabaseRequest\x1a$.naisdevice.SnapshotDatabaseResponse\"\x000\x01\x12_\n" +
+	"\x10GetPosturePolicy\x12#.naisdevice.GetPosturePolicyRequest\x1a$.naisdevice.GetPosturePolicyResponse\"\x00\x12]\n" +
+	"\x14CreateIssueExemption\x12'.naisdevice.CreateIssueExemptionRequest\x1a\x1a.naisdevice.IssueExemption\"\x00\x12h\n" +
+	"\x13ListIssueExemptions\x12&.naisdevice.ListIssueExemptionsRequest\x1a'.naisdevice.ListIssueExemptionsResponse\"\x00\x12]\n" +
+	"\x14RevokeIssueExemption\x12'.naisdevice.RevokeIssueExemptionRequest\x1a\x1a.naisdevice.IssueExemption\"\x00\x12Y\n" +
 	"\x0eGetKolideCache\x12!.naisdevice.GetKolideCacheRequest\x1a\".naisdevice.GetKolideCacheResponse\"\x00\x12}\n" +
 	"\x1aGetAcceptableUseAcceptedAt\x12-.naisdevice.GetAcceptableUseAcceptedAtRequest\x1a..naisdevice.GetAcceptableUseAcceptedAtResponse\"\x00\x12w\n" +
 	"\x18SetAcceptableUseAccepted\x12+.naisdevice.SetAcceptableUseAcceptedRequest\x1a,.naisdevice.SetAcceptableUseAcceptedResponse\"\x00\x12\x80\x01\n" +
 	"\x1bGetGatewayJitaGrantsForUser\x12..naisdevice.GetGatewayJitaGrantsForUserRequest\x1a/.naisdevice.GetGatewayJitaGrantsForUserResponse\"\x00\x12\x8f\x01\n" +
 	" UserHasAccessToPrivilegedGateway\x123.naisdevice.UserHasAccessToPrivilegedGatewayRequest\x1a4.naisdevice.UserHasAccessToPrivilegedGatewayResponse\"\x00\x12\x83\x01\n" +
 	"\x1cGrantPrivilegedGatewayAccess\x12/.naisdevice.GrantPrivilegedGatewayAccessRequest\x1a0.naisdevice.GrantPrivilegedGatewayAccessResponse\"\x00\x12\x86\x01\n" +
-	"\x1dRevokePrivilegedGatewayAccess\x120.naisdevice.RevokePrivilegedGatewayAccessRequest\x1a1.naisdevice.RevokePrivilegedGatewayAccessResponse\"\x00B\x1fZ\x1dgithub.com/nais/device/pkg/pbb\x06proto3"
+	"\x1dRevokePrivilegedGatewayAccess\x120.naisdevice.RevokePrivilegedGatewayAccessRequest\x1a1.naisdevice.RevokePrivilegedGatewayAccessResponse\"\x00\x12\x8f\x01\n" +
+	" GetPrivilegedGatewayAccessPolicy\x123.naisdevice.GetPrivilegedGatewayAccessPolicyRequest\x1a4.naisdevice.GetPrivilegedGatewayAccessPolicyResponse\"\x00\x12\xaa\x01\n" +
+	")GetPendingPrivilegedGatewayAccessRequests\x12<.naisdevice.GetPendingPrivilegedGatewayAccessRequestsRequest\x1a=.naisdevice.GetPendingPrivilegedGatewayAccessRequestsResponse\"\x00\x12\x86\x01\n" +
+	"\x1dReviewPrivilegedGatewayAccess\x120.naisdevice.ReviewPrivilegedGatewayAccessRequest\x1a1.naisdevice.ReviewPrivilegedGatewayAccessResponse\"\x00\x12n\n" +
+	"\x15ListGatewayJitaGrants\x12(.naisdevice.ListGatewayJitaGrantsRequest\x1a).naisdevice.ListGatewayJitaGrantsResponse\"\x00\x12q\n" +
+	"\x16RevokeGatewayJitaGrant\x12).naisdevice.RevokeGatewayJitaGrantRequest\x1a*.naisdevice.RevokeGatewayJitaGrantResponse\"\x00B\x1fZ\x1dgithub.com/nais/device/pkg/pbb\x06proto3"
 
 var (
 	file_pkg_pb_protobuf_api_proto_rawDescOnce sync.Once
@@ -3692,180 +6758,304 @@ func file_pkg_pb_protobuf_api_proto_rawDescGZIP() []byte {
 	return file_pkg_pb_protobuf_api_proto_rawDescData
 }
 
-var file_pkg_pb_protobuf_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pkg_pb_protobuf_api_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_pkg_pb_protobuf_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_pkg_pb_protobuf_api_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
 var file_pkg_pb_protobuf_api_proto_goTypes = []any{
-	(AgentState)(0),                                  // 0: naisdevice.AgentState
-	(DeviceConfigurationStatus)(0),                   // 1: naisdevice.DeviceConfigurationStatus
-	(AuthProvider)(0),                                // 2: naisdevice.AuthProvider
-	(Severity)(0),                                    // 3: naisdevice.Severity
-	(*TeardownRequest)(nil),                          // 4: naisdevice.TeardownRequest
-	(*TeardownResponse)(nil),                         // 5: naisdevice.TeardownResponse
-	(*ConfigureResponse)(nil),                        // 6: naisdevice.ConfigureResponse
-	(*ConfigureJITAResponse)(nil),                    // 7: naisdevice.ConfigureJITAResponse
-	(*LoginResponse)(nil),                            // 8: naisdevice.LoginResponse
-	(*LogoutResponse)(nil),                           // 9: naisdevice.LogoutResponse
-	(*UpgradeRequest)(nil),                           // 10: naisdevice.UpgradeRequest
-	(*UpgradeResponse)(nil),                          // 11: naisdevice.UpgradeResponse
-	(*GetSerialRequest)(nil),                         // 12: naisdevice.GetSerialRequest
-	(*GetSerialResponse)(nil),                        // 13: naisdevice.GetSerialResponse
-	(*ConfigureJITARequest)(nil),                     // 14: naisdevice.ConfigureJITARequest
-	(*LoginRequest)(nil),                             // 15: naisdevice.LoginRequest
-	(*LogoutRequest)(nil),                            // 16: naisdevice.LogoutRequest
-	(*SetAgentConfigurationRequest)(nil),             // 17: naisdevice.SetAgentConfigurationRequest
-	(*SetAgentConfigurationResponse)(nil),            // 18: naisdevice.SetAgentConfigurationResponse
-	(*GetAgentConfigurationRequest)(nil),             // 19: naisdevice.GetAgentConfigurationRequest
-	(*ShowAcceptableUseRequest)(nil),                 // 20: naisdevice.ShowAcceptableUseRequest
-	(*ShowAcceptableUseResponse)(nil),                // 21: naisdevice.ShowAcceptableUseResponse
-	(*ShowJitaRequest)(nil),                          // 22: naisdevice.ShowJitaRequest
-	(*ShowJitaResponse)(nil),                         // 23: naisdevice.ShowJitaResponse
-	(*ShutdownRequest)(nil),                          // 24: naisdevice.ShutdownRequest
-	(*ShutdownResponse)(nil),                         // 25: naisdevice.ShutdownResponse
-	(*GetAgentConfigurationResponse)(nil),            // 26: naisdevice.GetAgentConfigurationResponse
-	(*AgentStatusRequest)(nil),                       // 27: naisdevice.AgentStatusRequest
-	(*AgentStatus)(nil),                              // 28: naisdevice.AgentStatus
-	(*Configuration)(nil),                            // 29: naisdevice.Configuration
-	(*ModifyGatewayRequest)(nil),                     // 30: naisdevice.ModifyGatewayRequest
-	(*ModifyGatewayResponse)(nil),                    // 31: naisdevice.ModifyGatewayResponse
-	(*Gateway)(nil),                                  // 32: naisdevice.Gateway
-	(*Error)(nil),                                    // 33: naisdevice.Error
-	(*SetActiveTenantRequest)(nil),                   // 34: naisdevice.SetActiveTenantRequest
-	(*SetActiveTenantResponse)(nil),                  // 35: naisdevice.SetActiveTenantResponse
-	(*Tenant)(nil),                                   // 36: naisdevice.Tenant
-	(*AgentConfiguration)(nil),                       // 37: naisdevice.AgentConfiguration
-	(*GetGatewayConfigurationRequest)(nil),           // 38: naisdevice.GetGatewayConfigurationRequest
-	(*GetGatewayConfigurationResponse)(nil),          // 39: naisdevice.GetGatewayConfigurationResponse
-	(*GetDeviceConfigurationRequest)(nil),            // 40: naisdevice.GetDeviceConfigurationRequest
-	(*APIServerLoginRequest)(nil),                    // 41: naisdevice.APIServerLoginRequest
-	(*APIServerLoginResponse)(nil),                   // 42: naisdevice.APIServerLoginResponse
-	(*GetDeviceConfigurationResponse)(nil),           // 43: naisdevice.GetDeviceConfigurationResponse
-	(*DeviceIssue)(nil),                              // 44: naisdevice.DeviceIssue
-	(*ListGatewayRequest)(nil),                       // 45: naisdevice.ListGatewayRequest
-	(*Device)(nil),                                   // 46: naisdevice.Device
-	(*Session)(nil),                                  // 47: naisdevice.Session
-	(*GetSessionsRequest)(nil),                       // 48: naisdevice.GetSessionsRequest
-	(*GetSessionsResponse)(nil),                      // 49: naisdevice.GetSessionsResponse
-	(*PingRequest)(nil),                              // 50: naisdevice.PingRequest
-	(*PingResponse)(nil),                             // 51: naisdevice.PingResponse
-	(*GetKolideCacheRequest)(nil),                    // 52: naisdevice.GetKolideCacheRequest
-	(*GetKolideCacheResponse)(nil),                   // 53: naisdevice.GetKolideCacheResponse
-	(*GetAcceptableUseAcceptedAtRequest)(nil),        // 54: naisdevice.GetAcceptableUseAcceptedAtRequest
-	(*GetAcceptableUseAcceptedAtResponse)(nil),       // 55: naisdevice.GetAcceptableUseAcceptedAtResponse
-	(*SetAcceptableUseAcceptedRequest)(nil),          // 56: naisdevice.SetAcceptableUseAcceptedRequest
-	(*SetAcceptableUseAcceptedResponse)(nil),         // 57: naisdevice.SetAcceptableUseAcceptedResponse
-	(*GatewayJitaGrant)(nil),                         // 58: naisdevice.GatewayJitaGrant
-	(*GetGatewayJitaGrantsForUserRequest)(nil),       // 59: naisdevice.GetGatewayJitaGrantsForUserRequest
-	(*GetGatewayJitaGrantsForUserResponse)(nil),      // 60: naisdevice.GetGatewayJitaGrantsForUserResponse
-	(*UserHasAccessToPrivilegedGatewayRequest)(nil),  // 61: naisdevice.UserHasAccessToPrivilegedGatewayRequest
-	(*UserHasAccessToPrivilegedGatewayResponse)(nil), // 62: naisdevice.UserHasAccessToPrivilegedGatewayResponse
-	(*NewPrivilegedGatewayAccess)(nil),               // 63: naisdevice.NewPrivilegedGatewayAccess
-	(*GrantPrivilegedGatewayAccessRequest)(nil),      // 64: naisdevice.GrantPrivilegedGatewayAccessRequest
-	(*GrantPrivilegedGatewayAccessResponse)(nil),     // 65: naisdevice.GrantPrivilegedGatewayAccessResponse
-	(*RevokePrivilegedGatewayAccessRequest)(nil),     // 66: naisdevice.RevokePrivilegedGatewayAccessRequest
-	(*RevokePrivilegedGatewayAccessResponse)(nil),    // 67: naisdevice.RevokePrivilegedGatewayAccessResponse
-	(*timestamppb.Timestamp)(nil),                    // 68: google.protobuf.Timestamp
+	(AgentState)(0),                                           // 0: naisdevice.AgentState
+	(DeviceConfigurationStatus)(0),                            // 1: naisdevice.DeviceConfigurationStatus
+	(AuthProvider)(0),                                         // 2: naisdevice.AuthProvider
+	(GatewayConfigurationMode)(0),                             // 3: naisdevice.GatewayConfigurationMode
+	(Severity)(0),                                             // 4: naisdevice.Severity
+	(DeviceHealthFilter)(0),                                   // 5: naisdevice.DeviceHealthFilter
+	(JitaApproval)(0),                                         // 6: naisdevice.JitaApproval
+	(*TeardownRequest)(nil),                                   // 7: naisdevice.TeardownRequest
+	(*TeardownResponse)(nil),                                  // 8: naisdevice.TeardownResponse
+	(*ConfigureResponse)(nil),                                 // 9: naisdevice.ConfigureResponse
+	(*ConfigureJITAResponse)(nil),                             // 10: naisdevice.ConfigureJITAResponse
+	(*LoginResponse)(nil),                                     // 11: naisdevice.LoginResponse
+	(*LogoutResponse)(nil),                                    // 12: naisdevice.LogoutResponse
+	(*UpgradeRequest)(nil),                                    // 13: naisdevice.UpgradeRequest
+	(*UpgradeResponse)(nil),                                   // 14: naisdevice.UpgradeResponse
+	(*GetSerialRequest)(nil),                                  // 15: naisdevice.GetSerialRequest
+	(*GetSerialResponse)(nil),                                 // 16: naisdevice.GetSerialResponse
+	(*ConfigureJITARequest)(nil),                              // 17: naisdevice.ConfigureJITARequest
+	(*LoginRequest)(nil),                                      // 18: naisdevice.LoginRequest
+	(*LogoutRequest)(nil),                                     // 19: naisdevice.LogoutRequest
+	(*SetAgentConfigurationRequest)(nil),                      // 20: naisdevice.SetAgentConfigurationRequest
+	(*SetAgentConfigurationResponse)(nil),                     // 21: naisdevice.SetAgentConfigurationResponse
+	(*GetAgentConfigurationRequest)(nil),                      // 22: naisdevice.GetAgentConfigurationRequest
+	(*ShowAcceptableUseRequest)(nil),                          // 23: naisdevice.ShowAcceptableUseRequest
+	(*ShowAcceptableUseResponse)(nil),                         // 24: naisdevice.ShowAcceptableUseResponse
+	(*ShowJitaRequest)(nil),                                   // 25: naisdevice.ShowJitaRequest
+	(*ShowJitaResponse)(nil),                                  // 26: naisdevice.ShowJitaResponse
+	(*ShutdownRequest)(nil),                                   // 27: naisdevice.ShutdownRequest
+	(*ShutdownResponse)(nil),                                  // 28: naisdevice.ShutdownResponse
+	(*GetAgentConfigurationResponse)(nil),                     // 29: naisdevice.GetAgentConfigurationResponse
+	(*AgentStatusRequest)(nil),                                // 30: naisdevice.AgentStatusRequest
+	(*AgentStatus)(nil),                                       // 31: naisdevice.AgentStatus
+	(*Configuration)(nil),                                     // 32: naisdevice.Configuration
+	(*ModifyGatewayRequest)(nil),                              // 33: naisdevice.ModifyGatewayRequest
+	(*ModifyGatewayResponse)(nil),                             // 34: naisdevice.ModifyGatewayResponse
+	(*DeleteGatewayResponse)(nil),                             // 35: naisdevice.DeleteGatewayResponse
+	(*GetWireGuardPrefixesRequest)(nil),                       // 36: naisdevice.GetWireGuardPrefixesRequest
+	(*GetWireGuardPrefixesResponse)(nil),                      // 37: naisdevice.GetWireGuardPrefixesResponse
+	(*Gateway)(nil),                                           // 38: naisdevice.Gateway
+	(*JitaPolicy)(nil),                                        // 39: naisdevice.JitaPolicy
+	(*Error)(nil),                                             // 40: naisdevice.Error
+	(*SetActiveTenantRequest)(nil),                            // 41: naisdevice.SetActiveTenantRequest
+	(*SetActiveTenantResponse)(nil),                           // 42: naisdevice.SetActiveTenantResponse
+	(*Tenant)(nil),                                            // 43: naisdevice.Tenant
+	(*AgentConfiguration)(nil),                                // 44: naisdevice.AgentConfiguration
+	(*GetGatewayConfigurationRequest)(nil),                    // 45: naisdevice.GetGatewayConfigurationRequest
+	(*GetGatewayConfigurationResponse)(nil),                   // 46: naisdevice.GetGatewayConfigurationResponse
+	(*GetDeviceConfigurationRequest)(nil),                     // 47: naisdevice.GetDeviceConfigurationRequest
+	(*APIServerLoginRequest)(nil),                             // 48: naisdevice.APIServerLoginRequest
+	(*APIServerLoginResponse)(nil),                            // 49: naisdevice.APIServerLoginResponse
+	(*RenewSessionRequest)(nil),                               // 50: naisdevice.RenewSessionRequest
+	(*RenewSessionResponse)(nil),                              // 51: naisdevice.RenewSessionResponse
+	(*GetDeviceConfigurationResponse)(nil),                    // 52: naisdevice.GetDeviceConfigurationResponse
+	(*DeviceIssue)(nil),                                       // 53: naisdevice.DeviceIssue
+	(*IssueExemption)(nil),                                    // 54: naisdevice.IssueExemption
+	(*ListGatewayRequest)(nil),                                // 55: naisdevice.ListGatewayRequest
+	(*Device)(nil),                                            // 56: naisdevice.Device
+	(*Session)(nil),                                           // 57: naisdevice.Session
+	(*ListDevicesRequest)(nil),                                // 58: naisdevice.ListDevicesRequest
+	(*ListDevicesResponse)(nil),                               // 59: naisdevice.ListDevicesResponse
+	(*GetDeviceRequest)(nil),                                  // 60: naisdevice.GetDeviceRequest
+	(*DeleteDeviceRequest)(nil),                               // 61: naisdevice.DeleteDeviceRequest
+	(*DeleteDeviceResponse)(nil),                              // 62: naisdevice.DeleteDeviceResponse
+	(*ReassignDeviceRequest)(nil),                             // 63: naisdevice.ReassignDeviceRequest
+	(*ReassignDeviceResponse)(nil),                            // 64: naisdevice.ReassignDeviceResponse
+	(*GetSessionsRequest)(nil),                                // 65: naisdevice.GetSessionsRequest
+	(*GetSessionsResponse)(nil),                               // 66: naisdevice.GetSessionsResponse
+	(*RevokeSessionsRequest)(nil),                             // 67: naisdevice.RevokeSessionsRequest
+	(*RevokeSessionsResponse)(nil),                            // 68: naisdevice.RevokeSessionsResponse
+	(*AuditEvent)(nil),                                        // 69: naisdevice.AuditEvent
+	(*ListAuditEventsRequest)(nil),                            // 70: naisdevice.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                           // 71: naisdevice.ListAuditEventsResponse
+	(*SnapshotDatabaseRequest)(nil),                           // 72: naisdevice.SnapshotDatabaseRequest
+	(*SnapshotDatabaseResponse)(nil),                          // 73: naisdevice.SnapshotDatabaseResponse
+	(*PingRequest)(nil),                                       // 74: naisdevice.PingRequest
+	(*PingResponse)(nil),                                      // 75: naisdevice.PingResponse
+	(*GetPosturePolicyRequest)(nil),                           // 76: naisdevice.GetPosturePolicyRequest
+	(*PostureGracePeriod)(nil),                                // 77: naisdevice.PostureGracePeriod
+	(*PostureTagSeverity)(nil),                                // 78: naisdevice.PostureTagSeverity
+	(*PostureCheckOverride)(nil),                              // 79: naisdevice.PostureCheckOverride
+	(*PostureRating)(nil),                                     // 80: naisdevice.PostureRating
+	(*GetPosturePolicyResponse)(nil),                          // 81: naisdevice.GetPosturePolicyResponse
+	(*CreateIssueExemptionRequest)(nil),                       // 82: naisdevice.CreateIssueExemptionRequest
+	(*ListIssueExemptionsRequest)(nil),                        // 83: naisdevice.ListIssueExemptionsRequest
+	(*ListIssueExemptionsResponse)(nil),                       // 84: naisdevice.ListIssueExemptionsResponse
+	(*RevokeIssueExemptionRequest)(nil),                       // 85: naisdevice.RevokeIssueExemptionRequest
+	(*GetKolideCacheRequest)(nil),                             // 86: naisdevice.GetKolideCacheRequest
+	(*GetKolideCacheResponse)(nil),                            // 87: naisdevice.GetKolideCacheResponse
+	(*GetAcceptableUseAcceptedAtRequest)(nil),                 // 88: naisdevice.GetAcceptableUseAcceptedAtRequest
+	(*GetAcceptableUseAcceptedAtResponse)(nil),                // 89: naisdevice.GetAcceptableUseAcceptedAtResponse
+	(*SetAcceptableUseAcceptedRequest)(nil),                   // 90: naisdevice.SetAcceptableUseAcceptedRequest
+	(*SetAcceptableUseAcceptedResponse)(nil),                  // 91: naisdevice.SetAcceptableUseAcceptedResponse
+	(*GatewayJitaGrant)(nil),                                  // 92: naisdevice.GatewayJitaGrant
+	(*GetGatewayJitaGrantsForUserRequest)(nil),                // 93: naisdevice.GetGatewayJitaGrantsForUserRequest
+	(*GetGatewayJitaGrantsForUserResponse)(nil),               // 94: naisdevice.GetGatewayJitaGrantsForUserResponse
+	(*UserHasAccessToPrivilegedGatewayRequest)(nil),           // 95: naisdevice.UserHasAccessToPrivilegedGatewayRequest
+	(*UserHasAccessToPrivilegedGatewayResponse)(nil),          // 96: naisdevice.UserHasAccessToPrivilegedGatewayResponse
+	(*NewPrivilegedGatewayAccess)(nil),                        // 97: naisdevice.NewPrivilegedGatewayAccess
+	(*GrantPrivilegedGatewayAccessRequest)(nil),               // 98: naisdevice.GrantPrivilegedGatewayAccessRequest
+	(*GrantPrivilegedGatewayAccessResponse)(nil),              // 99: naisdevice.GrantPrivilegedGatewayAccessResponse
+	(*RevokePrivilegedGatewayAccessRequest)(nil),              // 100: naisdevice.RevokePrivilegedGatewayAccessRequest
+	(*RevokePrivilegedGatewayAccessResponse)(nil),             // 101: naisdevice.RevokePrivilegedGatewayAccessResponse
+	(*GetPrivilegedGatewayAccessPolicyRequest)(nil),           // 102: naisdevice.GetPrivilegedGatewayAccessPolicyRequest
+	(*GetPrivilegedGatewayAccessPolicyResponse)(nil),          // 103: naisdevice.GetPrivilegedGatewayAccessPolicyResponse
+	(*GetPendingPrivilegedGatewayAccessRequestsRequest)(nil),  // 104: naisdevice.GetPendingPrivilegedGatewayAccessRequestsRequest
+	(*GetPendingPrivilegedGatewayAccessRequestsResponse)(nil), // 105: naisdevice.GetPendingPrivilegedGatewayAccessRequestsResponse
+	(*ReviewPrivilegedGatewayAccessRequest)(nil),              // 106: naisdevice.ReviewPrivilegedGatewayAccessRequest
+	(*ReviewPrivilegedGatewayAccessResponse)(nil),             // 107: naisdevice.ReviewPrivilegedGatewayAccessResponse
+	(*ListGatewayJitaGrantsRequest)(nil),                      // 108: naisdevice.ListGatewayJitaGrantsRequest
+	(*ListGatewayJitaGrantsResponse)(nil),                     // 109: naisdevice.ListGatewayJitaGrantsResponse
+	(*RevokeGatewayJitaGrantRequest)(nil),                     // 110: naisdevice.RevokeGatewayJitaGrantRequest
+	(*RevokeGatewayJitaGrantResponse)(nil),                    // 111: naisdevice.RevokeGatewayJitaGrantResponse
+	(*timestamppb.Timestamp)(nil),                             // 112: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                               // 113: google.protobuf.Duration
 }
 var file_pkg_pb_protobuf_api_proto_depIdxs = []int32{
-	32, // 0: naisdevice.ConfigureJITARequest.gateway:type_name -> naisdevice.Gateway
-	37, // 1: naisdevice.SetAgentConfigurationRequest.config:type_name -> naisdevice.AgentConfiguration
-	37, // 2: naisdevice.GetAgentConfigurationResponse.config:type_name -> naisdevice.AgentConfiguration
-	0,  // 3: naisdevice.AgentStatus.connectionState:type_name -> naisdevice.AgentState
-	68, // 4: naisdevice.AgentStatus.connectedSince:type_name -> google.protobuf.Timestamp
-	32, // 5: naisdevice.AgentStatus.Gateways:type_name -> naisdevice.Gateway
-	36, // 6: naisdevice.AgentStatus.Tenants:type_name -> naisdevice.Tenant
-	44, // 7: naisdevice.AgentStatus.Issues:type_name -> naisdevice.DeviceIssue
-	32, // 8: naisdevice.Configuration.Gateways:type_name -> naisdevice.Gateway
-	32, // 9: naisdevice.ModifyGatewayRequest.gateway:type_name -> naisdevice.Gateway
-	32, // 10: naisdevice.ModifyGatewayResponse.gateway:type_name -> naisdevice.Gateway
-	2,  // 11: naisdevice.Tenant.authProvider:type_name -> naisdevice.AuthProvider
-	47, // 12: naisdevice.Tenant.session:type_name -> naisdevice.Session
-	46, // 13: naisdevice.GetGatewayConfigurationResponse.devices:type_name -> naisdevice.Device
-	47, // 14: naisdevice.APIServerLoginResponse.session:type_name -> naisdevice.Session
-	1,  // 15: naisdevice.GetDeviceConfigurationResponse.status:type_name -> naisdevice.DeviceConfigurationStatus
-	32, // 16: naisdevice.GetDeviceConfigurationResponse.Gateways:type_name -> naisdevice.Gateway
-	44, // 17: naisdevice.GetDeviceConfigurationResponse.issues:type_name -> naisdevice.DeviceIssue
-	3,  // 18: naisdevice.DeviceIssue.severity:type_name -> naisdevice.Severity
-	68, // 19: naisdevice.DeviceIssue.detectedAt:type_name -> google.protobuf.Timestamp
-	68, // 20: naisdevice.DeviceIssue.lastUpdated:type_name -> google.protobuf.Timestamp
-	68, // 21: naisdevice.DeviceIssue.resolveBefore:type_name -> google.protobuf.Timestamp
-	68, // 22: naisdevice.Device.lastUpdated:type_name -> google.protobuf.Timestamp
-	44, // 23: naisdevice.Device.issues:type_name -> naisdevice.DeviceIssue
-	68, // 24: naisdevice.Device.lastSeen:type_name -> google.protobuf.Timestamp
-	68, // 25: naisdevice.Session.expiry:type_name -> google.protobuf.Timestamp
-	46, // 26: naisdevice.Session.device:type_name -> naisdevice.Device
-	47, // 27: naisdevice.GetSessionsResponse.sessions:type_name -> naisdevice.Session
-	68, // 28: naisdevice.GetAcceptableUseAcceptedAtResponse.acceptedAt:type_name -> google.protobuf.Timestamp
-	68, // 29: naisdevice.GatewayJitaGrant.created:type_name -> google.protobuf.Timestamp
-	68, // 30: naisdevice.GatewayJitaGrant.expires:type_name -> google.protobuf.Timestamp
-	68, // 31: naisdevice.GatewayJitaGrant.revoked:type_name -> google.protobuf.Timestamp
-	58, // 32: naisdevice.GetGatewayJitaGrantsForUserResponse.gatewayJitaGrants:type_name -> naisdevice.GatewayJitaGrant
-	68, // 33: naisdevice.NewPrivilegedGatewayAccess.expires:type_name -> google.protobuf.Timestamp
-	63, // 34: naisdevice.GrantPrivilegedGatewayAccessRequest.newPrivilegedGatewayAccess:type_name -> naisdevice.NewPrivilegedGatewayAccess
-	29, // 35: naisdevice.DeviceHelper.Configure:input_type -> naisdevice.Configuration
-	4,  // 36: naisdevice.DeviceHelper.Teardown:input_type -> naisdevice.TeardownRequest
-	10, // 37: naisdevice.DeviceHelper.Upgrade:input_type -> naisdevice.UpgradeRequest
-	12, // 38: naisdevice.DeviceHelper.GetSerial:input_type -> naisdevice.GetSerialRequest
-	50, // 39: naisdevice.DeviceHelper.Ping:input_type -> naisdevice.PingRequest
-	27, // 40: naisdevice.DeviceAgent.Status:input_type -> naisdevice.AgentStatusRequest
-	14, // 41: naisdevice.DeviceAgent.ConfigureJITA:input_type -> naisdevice.ConfigureJITARequest
-	15, // 42: naisdevice.DeviceAgent.Login:input_type -> naisdevice.LoginRequest
-	16, // 43: naisdevice.DeviceAgent.Logout:input_type -> naisdevice.LogoutRequest
-	34, // 44: naisdevice.DeviceAgent.SetActiveTenant:input_type -> naisdevice.SetActiveTenantRequest
-	17, // 45: naisdevice.DeviceAgent.SetAgentConfiguration:input_type -> naisdevice.SetAgentConfigurationRequest
-	19, // 46: naisdevice.DeviceAgent.GetAgentConfiguration:input_type -> naisdevice.GetAgentConfigurationRequest
-	20, // 47: naisdevice.DeviceAgent.ShowAcceptableUse:input_type -> naisdevice.ShowAcceptableUseRequest
-	22, // 48: naisdevice.DeviceAgent.ShowJita:input_type -> naisdevice.ShowJitaRequest
-	24, // 49: naisdevice.DeviceAgent.Shutdown:input_type -> naisdevice.ShutdownRequest
-	41, // 50: naisdevice.APIServer.Login:input_type -> naisdevice.APIServerLoginRequest
-	40, // 51: naisdevice.APIServer.GetDeviceConfiguration:input_type -> naisdevice.GetDeviceConfigurationRequest
-	38, // 52: naisdevice.APIServer.GetGatewayConfiguration:input_type -> naisdevice.GetGatewayConfigurationRequest
-	30, // 53: naisdevice.APIServer.GetGateway:input_type -> naisdevice.ModifyGatewayRequest
-	45, // 54: naisdevice.APIServer.ListGateways:input_type -> naisdevice.ListGatewayRequest
-	30, // 55: naisdevice.APIServer.EnrollGateway:input_type -> naisdevice.ModifyGatewayRequest
-	30, // 56: naisdevice.APIServer.UpdateGateway:input_type -> naisdevice.ModifyGatewayRequest
-	48, // 57: naisdevice.APIServer.GetSessions:input_type -> naisdevice.GetSessionsRequest
-	52, // 58: naisdevice.APIServer.GetKolideCache:input_type -> naisdevice.GetKolideCacheRequest
-	54, // 59: naisdevice.APIServer.GetAcceptableUseAcceptedAt:input_type -> naisdevice.GetAcceptableUseAcceptedAtRequest
-	56, // 60: naisdevice.APIServer.SetAcceptableUseAccepted:input_type -> naisdevice.SetAcceptableUseAcceptedRequest
-	59, // 61: naisdevice.APIServer.GetGatewayJitaGrantsForUser:input_type -> naisdevice.GetGatewayJitaGrantsForUserRequest
-	61, // 62: naisdevice.APIServer.UserHasAccessToPrivilegedGateway:input_type -> naisdevice.UserHasAccessToPrivilegedGatewayRequest
-	64, // 63: naisdevice.APIServer.GrantPrivilegedGatewayAccess:input_type -> naisdevice.GrantPrivilegedGatewayAccessRequest
-	66, // 64: naisdevice.APIServer.RevokePrivilegedGatewayAccess:input_type -> naisdevice.RevokePrivilegedGatewayAccessRequest
-	6,  // 65: naisdevice.DeviceHelper.Configure:output_type -> naisdevice.ConfigureResponse
-	5,  // 66: naisdevice.DeviceHelper.Teardown:output_type -> naisdevice.TeardownResponse
-	11, // 67: naisdevice.DeviceHelper.Upgrade:output_type -> naisdevice.UpgradeResponse
-	13, // 68: naisdevice.DeviceHelper.GetSerial:output_type -> naisdevice.GetSerialResponse
-	51, // 69: naisdevice.DeviceHelper.Ping:output_type -> naisdevice.PingResponse
-	28, // 70: naisdevice.DeviceAgent.Status:output_type -> naisdevice.AgentStatus
-	7,  // 71: naisdevice.DeviceAgent.ConfigureJITA:output_type -> naisdevice.ConfigureJITAResponse
-	8,  // 72: naisdevice.DeviceAgent.Login:output_type -> naisdevice.LoginResponse
-	9,  // 73: naisdevice.DeviceAgent.Logout:output_type -> naisdevice.LogoutResponse
-	35, // 74: naisdevice.DeviceAgent.SetActiveTenant:output_type -> naisdevice.SetActiveTenantResponse
-	18, // 75: naisdevice.DeviceAgent.SetAgentConfiguration:output_type -> naisdevice.SetAgentConfigurationResponse
-	26, // 76: naisdevice.DeviceAgent.GetAgentConfiguration:output_type -> naisdevice.GetAgentConfigurationResponse
-	21, // 77: naisdevice.DeviceAgent.ShowAcceptableUse:output_type -> naisdevice.ShowAcceptableUseResponse
-	23, // 78: naisdevice.DeviceAgent.ShowJita:output_type -> naisdevice.ShowJitaResponse
-	25, // 79: naisdevice.DeviceAgent.Shutdown:output_type -> naisdevice.ShutdownResponse
-	42, // 80: naisdevice.APIServer.Login:output_type -> naisdevice.APIServerLoginResponse
-	43, // 81: naisdevice.APIServer.GetDeviceConfiguration:output_type -> naisdevice.GetDeviceConfigurationResponse
-	39, // 82: naisdevice.APIServer.GetGatewayConfiguration:output_type -> naisdevice.GetGatewayConfigurationResponse
-	32, // 83: naisdevice.APIServer.GetGateway:output_type -> naisdevice.Gateway
-	32, // 84: naisdevice.APIServer.ListGateways:output_type -> naisdevice.Gateway
-	31, // 85: naisdevice.APIServer.EnrollGateway:output_type -> naisdevice.ModifyGatewayResponse
-	31, // 86: naisdevice.APIServer.UpdateGateway:output_type -> naisdevice.ModifyGatewayResponse
-	49, // 87: naisdevice.APIServer.GetSessions:output_type -> naisdevice.GetSessionsResponse
-	53, // 88: naisdevice.APIServer.GetKolideCache:output_type -> naisdevice.GetKolideCacheResponse
-	55, // 89: naisdevice.APIServer.GetAcceptableUseAcceptedAt:output_type -> naisdevice.GetAcceptableUseAcceptedAtResponse
-	57, // 90: naisdevice.APIServer.SetAcceptableUseAccepted:output_type -> naisdevice.SetAcceptableUseAcceptedResponse
-	60, // 91: naisdevice.APIServer.GetGatewayJitaGrantsForUser:output_type -> naisdevice.GetGatewayJitaGrantsForUserResponse
-	62, // 92: naisdevice.APIServer.UserHasAccessToPrivilegedGateway:output_type -> naisdevice.UserHasAccessToPrivilegedGatewayResponse
-	65, // 93: naisdevice.APIServer.GrantPrivilegedGatewayAccess:output_type -> naisdevice.GrantPrivilegedGatewayAccessResponse
-	67, // 94: naisdevice.APIServer.RevokePrivilegedGatewayAccess:output_type -> naisdevice.RevokePrivilegedGatewayAccessResponse
-	65, // [65:95] is the sub-list for method output_type
-	35, // [35:65] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	38,  // 0: naisdevice.ConfigureJITARequest.gateway:type_name -> naisdevice.Gateway
+	44,  // 1: naisdevice.SetAgentConfigurationRequest.config:type_name -> naisdevice.AgentConfiguration
+	44,  // 2: naisdevice.GetAgentConfigurationResponse.config:type_name -> naisdevice.AgentConfiguration
+	0,   // 3: naisdevice.AgentStatus.connectionState:type_name -> naisdevice.AgentState
+	112, // 4: naisdevice.AgentStatus.connectedSince:type_name -> google.protobuf.Timestamp
+	38,  // 5: naisdevice.AgentStatus.Gateways:type_name -> naisdevice.Gateway
+	43,  // 6: naisdevice.AgentStatus.Tenants:type_name -> naisdevice.Tenant
+	53,  // 7: naisdevice.AgentStatus.Issues:type_name -> naisdevice.DeviceIssue
+	38,  // 8: naisdevice.Configuration.Gateways:type_name -> naisdevice.Gateway
+	38,  // 9: naisdevice.ModifyGatewayRequest.gateway:type_name -> naisdevice.Gateway
+	38,  // 10: naisdevice.ModifyGatewayResponse.gateway:type_name -> naisdevice.Gateway
+	39,  // 11: naisdevice.Gateway.jitaPolicy:type_name -> naisdevice.JitaPolicy
+	113, // 12: naisdevice.JitaPolicy.maxDuration:type_name -> google.protobuf.Duration
+	2,   // 13: naisdevice.Tenant.authProvider:type_name -> naisdevice.AuthProvider
+	57,  // 14: naisdevice.Tenant.session:type_name -> naisdevice.Session
+	3,   // 15: naisdevice.GetGatewayConfigurationRequest.mode:type_name -> naisdevice.GatewayConfigurationMode
+	56,  // 16: naisdevice.GetGatewayConfigurationResponse.devices:type_name -> naisdevice.Device
+	56,  // 17: naisdevice.GetGatewayConfigurationResponse.addedDevices:type_name -> naisdevice.Device
+	57,  // 18: naisdevice.APIServerLoginResponse.session:type_name -> naisdevice.Session
+	57,  // 19: naisdevice.RenewSessionResponse.session:type_name -> naisdevice.Session
+	1,   // 20: naisdevice.GetDeviceConfigurationResponse.status:type_name -> naisdevice.DeviceConfigurationStatus
+	38,  // 21: naisdevice.GetDeviceConfigurationResponse.Gateways:type_name -> naisdevice.Gateway
+	53,  // 22: naisdevice.GetDeviceConfigurationResponse.issues:type_name -> naisdevice.DeviceIssue
+	4,   // 23: naisdevice.DeviceIssue.severity:type_name -> naisdevice.Severity
+	112, // 24: naisdevice.DeviceIssue.detectedAt:type_name -> google.protobuf.Timestamp
+	112, // 25: naisdevice.DeviceIssue.lastUpdated:type_name -> google.protobuf.Timestamp
+	112, // 26: naisdevice.DeviceIssue.resolveBefore:type_name -> google.protobuf.Timestamp
+	54,  // 27: naisdevice.DeviceIssue.exemption:type_name -> naisdevice.IssueExemption
+	112, // 28: naisdevice.IssueExemption.created:type_name -> google.protobuf.Timestamp
+	112, // 29: naisdevice.IssueExemption.expires:type_name -> google.protobuf.Timestamp
+	112, // 30: naisdevice.IssueExemption.revoked:type_name -> google.protobuf.Timestamp
+	112, // 31: naisdevice.Device.lastUpdated:type_name -> google.protobuf.Timestamp
+	53,  // 32: naisdevice.Device.issues:type_name -> naisdevice.DeviceIssue
+	112, // 33: naisdevice.Device.lastSeen:type_name -> google.protobuf.Timestamp
+	112, // 34: naisdevice.Device.agentVersionOutdatedSince:type_name -> google.protobuf.Timestamp
+	112, // 35: naisdevice.Session.expiry:type_name -> google.protobuf.Timestamp
+	56,  // 36: naisdevice.Session.device:type_name -> naisdevice.Device
+	5,   // 37: naisdevice.ListDevicesRequest.health:type_name -> naisdevice.DeviceHealthFilter
+	56,  // 38: naisdevice.ListDevicesResponse.devices:type_name -> naisdevice.Device
+	56,  // 39: naisdevice.ReassignDeviceResponse.device:type_name -> naisdevice.Device
+	57,  // 40: naisdevice.GetSessionsResponse.sessions:type_name -> naisdevice.Session
+	57,  // 41: naisdevice.RevokeSessionsResponse.sessions:type_name -> naisdevice.Session
+	112, // 42: naisdevice.AuditEvent.created:type_name -> google.protobuf.Timestamp
+	112, // 43: naisdevice.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	112, // 44: naisdevice.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	69,  // 45: naisdevice.ListAuditEventsResponse.events:type_name -> naisdevice.AuditEvent
+	4,   // 46: naisdevice.PostureGracePeriod.severity:type_name -> naisdevice.Severity
+	113, // 47: naisdevice.PostureGracePeriod.gracePeriod:type_name -> google.protobuf.Duration
+	4,   // 48: naisdevice.PostureTagSeverity.severity:type_name -> naisdevice.Severity
+	4,   // 49: naisdevice.PostureCheckOverride.severity:type_name -> naisdevice.Severity
+	113, // 50: naisdevice.PostureCheckOverride.gracePeriod:type_name -> google.protobuf.Duration
+	4,   // 51: naisdevice.PostureRating.severity:type_name -> naisdevice.Severity
+	113, // 52: naisdevice.PostureRating.gracePeriod:type_name -> google.protobuf.Duration
+	77,  // 53: naisdevice.GetPosturePolicyResponse.gracePeriods:type_name -> naisdevice.PostureGracePeriod
+	78,  // 54: naisdevice.GetPosturePolicyResponse.tags:type_name -> naisdevice.PostureTagSeverity
+	4,   // 55: naisdevice.GetPosturePolicyResponse.defaultSeverity:type_name -> naisdevice.Severity
+	79,  // 56: naisdevice.GetPosturePolicyResponse.checks:type_name -> naisdevice.PostureCheckOverride
+	80,  // 57: naisdevice.GetPosturePolicyResponse.rating:type_name -> naisdevice.PostureRating
+	112, // 58: naisdevice.CreateIssueExemptionRequest.expires:type_name -> google.protobuf.Timestamp
+	54,  // 59: naisdevice.ListIssueExemptionsResponse.exemptions:type_name -> naisdevice.IssueExemption
+	112, // 60: naisdevice.GetAcceptableUseAcceptedAtResponse.acceptedAt:type_name -> google.protobuf.Timestamp
+	112, // 61: naisdevice.GatewayJitaGrant.created:type_name -> google.protobuf.Timestamp
+	112, // 62: naisdevice.GatewayJitaGrant.expires:type_name -> google.protobuf.Timestamp
+	112, // 63: naisdevice.GatewayJitaGrant.revoked:type_name -> google.protobuf.Timestamp
+	6,   // 64: naisdevice.GatewayJitaGrant.approval:type_name -> naisdevice.JitaApproval
+	112, // 65: naisdevice.GatewayJitaGrant.decided:type_name -> google.protobuf.Timestamp
+	92,  // 66: naisdevice.GetGatewayJitaGrantsForUserResponse.gatewayJitaGrants:type_name -> naisdevice.GatewayJitaGrant
+	112, // 67: naisdevice.NewPrivilegedGatewayAccess.expires:type_name -> google.protobuf.Timestamp
+	97,  // 68: naisdevice.GrantPrivilegedGatewayAccessRequest.newPrivilegedGatewayAccess:type_name -> naisdevice.NewPrivilegedGatewayAccess
+	39,  // 69: naisdevice.GetPrivilegedGatewayAccessPolicyResponse.policy:type_name -> naisdevice.JitaPolicy
+	92,  // 70: naisdevice.GetPendingPrivilegedGatewayAccessRequestsResponse.gatewayJitaGrants:type_name -> naisdevice.GatewayJitaGrant
+	92,  // 71: naisdevice.ReviewPrivilegedGatewayAccessResponse.gatewayJitaGrant:type_name -> naisdevice.GatewayJitaGrant
+	112, // 72: naisdevice.ListGatewayJitaGrantsRequest.since:type_name -> google.protobuf.Timestamp
+	112, // 73: naisdevice.ListGatewayJitaGrantsRequest.until:type_name -> google.protobuf.Timestamp
+	92,  // 74: naisdevice.ListGatewayJitaGrantsResponse.gatewayJitaGrants:type_name -> naisdevice.GatewayJitaGrant
+	92,  // 75: naisdevice.RevokeGatewayJitaGrantResponse.gatewayJitaGrant:type_name -> naisdevice.GatewayJitaGrant
+	32,  // 76: naisdevice.DeviceHelper.Configure:input_type -> naisdevice.Configuration
+	7,   // 77: naisdevice.DeviceHelper.Teardown:input_type -> naisdevice.TeardownRequest
+	13,  // 78: naisdevice.DeviceHelper.Upgrade:input_type -> naisdevice.UpgradeRequest
+	15,  // 79: naisdevice.DeviceHelper.GetSerial:input_type -> naisdevice.GetSerialRequest
+	74,  // 80: naisdevice.DeviceHelper.Ping:input_type -> naisdevice.PingRequest
+	30,  // 81: naisdevice.DeviceAgent.Status:input_type -> naisdevice.AgentStatusRequest
+	17,  // 82: naisdevice.DeviceAgent.ConfigureJITA:input_type -> naisdevice.ConfigureJITARequest
+	18,  // 83: naisdevice.DeviceAgent.Login:input_type -> naisdevice.LoginRequest
+	19,  // 84: naisdevice.DeviceAgent.Logout:input_type -> naisdevice.LogoutRequest
+	41,  // 85: naisdevice.DeviceAgent.SetActiveTenant:input_type -> naisdevice.SetActiveTenantRequest
+	20,  // 86: naisdevice.DeviceAgent.SetAgentConfiguration:input_type -> naisdevice.SetAgentConfigurationRequest
+	22,  // 87: naisdevice.DeviceAgent.GetAgentConfiguration:input_type -> naisdevice.GetAgentConfigurationRequest
+	23,  // 88: naisdevice.DeviceAgent.ShowAcceptableUse:input_type -> naisdevice.ShowAcceptableUseRequest
+	25,  // 89: naisdevice.DeviceAgent.ShowJita:input_type -> naisdevice.ShowJitaRequest
+	27,  // 90: naisdevice.DeviceAgent.Shutdown:input_type -> naisdevice.ShutdownRequest
+	48,  // 91: naisdevice.APIServer.Login:input_type -> naisdevice.APIServerLoginRequest
+	50,  // 92: naisdevice.APIServer.RenewSession:input_type -> naisdevice.RenewSessionRequest
+	47,  // 93: naisdevice.APIServer.GetDeviceConfiguration:input_type -> naisdevice.GetDeviceConfigurationRequest
+	45,  // 94: naisdevice.APIServer.GetGatewayConfiguration:input_type -> naisdevice.GetGatewayConfigurationRequest
+	33,  // 95: naisdevice.APIServer.GetGateway:input_type -> naisdevice.ModifyGatewayRequest
+	55,  // 96: naisdevice.APIServer.ListGateways:input_type -> naisdevice.ListGatewayRequest
+	33,  // 97: naisdevice.APIServer.EnrollGateway:input_type -> naisdevice.ModifyGatewayRequest
+	33,  // 98: naisdevice.APIServer.UpdateGateway:input_type -> naisdevice.ModifyGatewayRequest
+	33,  // 99: naisdevice.APIServer.DeleteGateway:input_type -> naisdevice.ModifyGatewayRequest
+	36,  // 100: naisdevice.APIServer.GetWireGuardPrefixes:input_type -> naisdevice.GetWireGuardPrefixesRequest
+	58,  // 101: naisdevice.APIServer.ListDevices:input_type -> naisdevice.ListDevicesRequest
+	60,  // 102: naisdevice.APIServer.GetDevice:input_type -> naisdevice.GetDeviceRequest
+	61,  // 103: naisdevice.APIServer.DeleteDevice:input_type -> naisdevice.DeleteDeviceRequest
+	63,  // 104: naisdevice.APIServer.ReassignDevice:input_type -> naisdevice.ReassignDeviceRequest
+	65,  // 105: naisdevice.APIServer.GetSessions:input_type -> naisdevice.GetSessionsRequest
+	67,  // 106: naisdevice.APIServer.RevokeSessions:input_type -> naisdevice.RevokeSessionsRequest
+	70,  // 107: naisdevice.APIServer.ListAuditEvents:input_type -> naisdevice.ListAuditEventsRequest
+	72,  // 108: naisdevice.APIServer.SnapshotDatabase:input_type -> naisdevice.SnapshotDatabaseRequest
+	76,  // 109: naisdevice.APIServer.GetPosturePolicy:input_type -> naisdevice.GetPosturePolicyRequest
+	82,  // 110: naisdevice.APIServer.CreateIssueExemption:input_type -> naisdevice.CreateIssueExemptionRequest
+	83,  // 111: naisdevice.APIServer.ListIssueExemptions:input_type -> naisdevice.ListIssueExemptionsRequest
+	85,  // 112: naisdevice.APIServer.RevokeIssueExemption:input_type -> naisdevice.RevokeIssueExemptionRequest
+	86,  // 113: naisdevice.APIServer.GetKolideCache:input_type -> naisdevice.GetKolideCacheRequest
+	88,  // 114: naisdevice.APIServer.GetAcceptableUseAcceptedAt:input_type -> naisdevice.GetAcceptableUseAcceptedAtRequest
+	90,  // 115: naisdevice.APIServer.SetAcceptableUseAccepted:input_type -> naisdevice.SetAcceptableUseAcceptedRequest
+	93,  // 116: naisdevice.APIServer.GetGatewayJitaGrantsForUser:input_type -> naisdevice.GetGatewayJitaGrantsForUserRequest
+	95,  // 117: naisdevice.APIServer.UserHasAccessToPrivilegedGateway:input_type -> naisdevice.UserHasAccessToPrivilegedGatewayRequest
+	98,  // 118: naisdevice.APIServer.GrantPrivilegedGatewayAccess:input_type -> naisdevice.GrantPrivilegedGatewayAccessRequest
+	100, // 119: naisdevice.APIServer.RevokePrivilegedGatewayAccess:input_type -> naisdevice.RevokePrivilegedGatewayAccessRequest
+	102, // 120: naisdevice.APIServer.GetPrivilegedGatewayAccessPolicy:input_type -> naisdevice.GetPrivilegedGatewayAccessPolicyRequest
+	104, // 121: naisdevice.APIServer.GetPendingPrivilegedGatewayAccessRequests:input_type -> naisdevice.GetPendingPrivilegedGatewayAccessRequestsRequest
+	106, // 122: naisdevice.APIServer.ReviewPrivilegedGatewayAccess:input_type -> naisdevice.ReviewPrivilegedGatewayAccessRequest
+	108, // 123: naisdevice.APIServer.ListGatewayJitaGrants:input_type -> naisdevice.ListGatewayJitaGrantsRequest
+	110, // 124: naisdevice.APIServer.RevokeGatewayJitaGrant:input_type -> naisdevice.RevokeGatewayJitaGrantRequest
+	9,   // 125: naisdevice.DeviceHelper.Configure:output_type -> naisdevice.ConfigureResponse
+	8,   // 126: naisdevice.DeviceHelper.Teardown:output_type -> naisdevice.TeardownResponse
+	14,  // 127: naisdevice.DeviceHelper.Upgrade:output_type -> naisdevice.UpgradeResponse
+	16,  // 128: naisdevice.DeviceHelper.GetSerial:output_type -> naisdevice.GetSerialResponse
+	75,  // 129: naisdevice.DeviceHelper.Ping:output_type -> naisdevice.PingResponse
+	31,  // 130: naisdevice.DeviceAgent.Status:output_type -> naisdevice.AgentStatus
+	10,  // 131: naisdevice.DeviceAgent.ConfigureJITA:output_type -> naisdevice.ConfigureJITAResponse
+	11,  // 132: naisdevice.DeviceAgent.Login:output_type -> naisdevice.LoginResponse
+	12,  // 133: naisdevice.DeviceAgent.Logout:output_type -> naisdevice.LogoutResponse
+	42,  // 134: naisdevice.DeviceAgent.SetActiveTenant:output_type -> naisdevice.SetActiveTenantResponse
+	21,  // 135: naisdevice.DeviceAgent.SetAgentConfiguration:output_type -> naisdevice.SetAgentConfigurationResponse
+	29,  // 136: naisdevice.DeviceAgent.GetAgentConfiguration:output_type -> naisdevice.GetAgentConfigurationResponse
+	24,  // 137: naisdevice.DeviceAgent.ShowAcceptableUse:output_type -> naisdevice.ShowAcceptableUseResponse
+	26,  // 138: naisdevice.DeviceAgent.ShowJita:output_type -> naisdevice.ShowJitaResponse
+	28,  // 139: naisdevice.DeviceAgent.Shutdown:output_type -> naisdevice.ShutdownResponse
+	49,  // 140: naisdevice.APIServer.Login:output_type -> naisdevice.APIServerLoginResponse
+	51,  // 141: naisdevice.APIServer.RenewSession:output_type -> naisdevice.RenewSessionResponse
+	52,  // 142: naisdevice.APIServer.GetDeviceConfiguration:output_type -> naisdevice.GetDeviceConfigurationResponse
+	46,  // 143: naisdevice.APIServer.GetGatewayConfiguration:output_type -> naisdevice.GetGatewayConfigurationResponse
+	38,  // 144: naisdevice.APIServer.GetGateway:output_type -> naisdevice.Gateway
+	38,  // 145: naisdevice.APIServer.ListGateways:output_type -> naisdevice.Gateway
+	34,  // 146: naisdevice.APIServer.EnrollGateway:output_type -> naisdevice.ModifyGatewayResponse
+	34,  // 147: naisdevice.APIServer.UpdateGateway:output_type -> naisdevice.ModifyGatewayResponse
+	35,  // 148: naisdevice.APIServer.DeleteGateway:output_type -> naisdevice.DeleteGatewayResponse
+	37,  // 149: naisdevice.APIServer.GetWireGuardPrefixes:output_type -> naisdevice.GetWireGuardPrefixesResponse
+	59,  // 150: naisdevice.APIServer.ListDevices:output_type -> naisdevice.ListDevicesResponse
+	56,  // 151: naisdevice.APIServer.GetDevice:output_type -> naisdevice.Device
+	62,  // 152: naisdevice.APIServer.DeleteDevice:output_type -> naisdevice.DeleteDeviceResponse
+	64,  // 153: naisdevice.APIServer.ReassignDevice:output_type -> naisdevice.ReassignDeviceResponse
+	66,  // 154: naisdevice.APIServer.GetSessions:output_type -> naisdevice.GetSessionsResponse
+	68,  // 155: naisdevice.APIServer.RevokeSessions:output_type -> naisdevice.RevokeSessionsResponse
+	71,  // 156: naisdevice.APIServer.ListAuditEvents:output_type -> naisdevice.ListAuditEventsResponse
+	73,  // 157: naisdevice.APIServer.SnapshotDatabase:output_type -> naisdevice.SnapshotDatabaseResponse
+	81,  // 158: naisdevice.APIServer.GetPosturePolicy:output_type -> naisdevice.GetPosturePolicyResponse
+	54,  // 159: naisdevice.APIServer.CreateIssueExemption:output_type -> naisdevice.IssueExemption
+	84,  // 160: naisdevice.APIServer.ListIssueExemptions:output_type -> naisdevice.ListIssueExemptionsResponse
+	54,  // 161: naisdevice.APIServer.RevokeIssueExemption:output_type -> naisdevice.IssueExemption
+	87,  // 162: naisdevice.APIServer.GetKolideCache:output_type -> naisdevice.GetKolideCacheResponse
+	89,  // 163: naisdevice.APIServer.GetAcceptableUseAcceptedAt:output_type -> naisdevice.GetAcceptableUseAcceptedAtResponse
+	91,  // 164: naisdevice.APIServer.SetAcceptableUseAccepted:output_type -> naisdevice.SetAcceptableUseAcceptedResponse
+	94,  // 165: naisdevice.APIServer.GetGatewayJitaGrantsForUser:output_type -> naisdevice.GetGatewayJitaGrantsForUserResponse
+	96,  // 166: naisdevice.APIServer.UserHasAccessToPrivilegedGateway:output_type -> naisdevice.UserHasAccessToPrivilegedGatewayResponse
+	99,  // 167: naisdevice.APIServer.GrantPrivilegedGatewayAccess:output_type -> naisdevice.GrantPrivilegedGatewayAccessResponse
+	101, // 168: naisdevice.APIServer.RevokePrivilegedGatewayAccess:output_type -> naisdevice.RevokePrivilegedGatewayAccessResponse
+	103, // 169: naisdevice.APIServer.GetPrivilegedGatewayAccessPolicy:output_type -> naisdevice.GetPrivilegedGatewayAccessPolicyResponse
+	105, // 170: naisdevice.APIServer.GetPendingPrivilegedGatewayAccessRequests:output_type -> naisdevice.GetPendingPrivilegedGatewayAccessRequestsResponse
+	107, // 171: naisdevice.APIServer.ReviewPrivilegedGatewayAccess:output_type -> naisdevice.ReviewPrivilegedGatewayAccessResponse
+	109, // 172: naisdevice.APIServer.ListGatewayJitaGrants:output_type -> naisdevice.ListGatewayJitaGrantsResponse
+	111, // 173: naisdevice.APIServer.RevokeGatewayJitaGrant:output_type -> naisdevice.RevokeGatewayJitaGrantResponse
+	125, // [125:174] is the sub-list for method output_type
+	76,  // [76:125] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_pkg_pb_protobuf_api_proto_init() }
@@ -3873,13 +7063,19 @@ func file_pkg_pb_protobuf_api_proto_init() {
 	if File_pkg_pb_protobuf_api_proto != nil {
 		return
 	}
+	file_pkg_pb_protobuf_api_proto_msgTypes[60].OneofWrappers = []any{
+		(*RevokeSessionsRequest_SessionKey)(nil),
+		(*RevokeSessionsRequest_DeviceID)(nil),
+		(*RevokeSessionsRequest_ObjectID)(nil),
+	}
+	file_pkg_pb_protobuf_api_proto_msgTypes[72].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_pb_protobuf_api_proto_rawDesc), len(file_pkg_pb_protobuf_api_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   64,
+			NumEnums:      7,
+			NumMessages:   105,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
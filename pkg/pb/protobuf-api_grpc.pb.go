@@ -749,21 +749,40 @@ var DeviceAgent_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	APIServer_Login_FullMethodName                            = "/naisdevice.APIServer/Login"
-	APIServer_GetDeviceConfiguration_FullMethodName           = "/naisdevice.APIServer/GetDeviceConfiguration"
-	APIServer_GetGatewayConfiguration_FullMethodName          = "/naisdevice.APIServer/GetGatewayConfiguration"
-	APIServer_GetGateway_FullMethodName                       = "/naisdevice.APIServer/GetGateway"
-	APIServer_ListGateways_FullMethodName                     = "/naisdevice.APIServer/ListGateways"
-	APIServer_EnrollGateway_FullMethodName                    = "/naisdevice.APIServer/EnrollGateway"
-	APIServer_UpdateGateway_FullMethodName                    = "/naisdevice.APIServer/UpdateGateway"
-	APIServer_GetSessions_FullMethodName                      = "/naisdevice.APIServer/GetSessions"
-	APIServer_GetKolideCache_FullMethodName                   = "/naisdevice.APIServer/GetKolideCache"
-	APIServer_GetAcceptableUseAcceptedAt_FullMethodName       = "/naisdevice.APIServer/GetAcceptableUseAcceptedAt"
-	APIServer_SetAcceptableUseAccepted_FullMethodName         = "/naisdevice.APIServer/SetAcceptableUseAccepted"
-	APIServer_GetGatewayJitaGrantsForUser_FullMethodName      = "/naisdevice.APIServer/GetGatewayJitaGrantsForUser"
-	APIServer_UserHasAccessToPrivilegedGateway_FullMethodName = "/naisdevice.APIServer/UserHasAccessToPrivilegedGateway"
-	APIServer_GrantPrivilegedGatewayAccess_FullMethodName     = "/naisdevice.APIServer/GrantPrivilegedGatewayAccess"
-	APIServer_RevokePrivilegedGatewayAccess_FullMethodName    = "/naisdevice.APIServer/RevokePrivilegedGatewayAccess"
+	APIServer_Login_FullMethodName                                     = "/naisdevice.APIServer/Login"
+	APIServer_RenewSession_FullMethodName                              = "/naisdevice.APIServer/RenewSession"
+	APIServer_GetDeviceConfiguration_FullMethodName                    = "/naisdevice.APIServer/GetDeviceConfiguration"
+	APIServer_GetGatewayConfiguration_FullMethodName                   = "/naisdevice.APIServer/GetGatewayConfiguration"
+	APIServer_GetGateway_FullMethodName                                = "/naisdevice.APIServer/GetGateway"
+	APIServer_ListGateways_FullMethodName                              = "/naisdevice.APIServer/ListGateways"
+	APIServer_EnrollGateway_FullMethodName                             = "/naisdevice.APIServer/EnrollGateway"
+	APIServer_UpdateGateway_FullMethodName                             = "/naisdevice.APIServer/UpdateGateway"
+	APIServer_DeleteGateway_FullMethodName                             = "/naisdevice.APIServer/DeleteGateway"
+	APIServer_GetWireGuardPrefixes_FullMethodName                      = "/naisdevice.APIServer/GetWireGuardPrefixes"
+	APIServer_ListDevices_FullMethodName                               = "/naisdevice.APIServer/ListDevices"
+	APIServer_GetDevice_FullMethodName                                 = "/naisdevice.APIServer/GetDevice"
+	APIServer_DeleteDevice_FullMethodName                              = "/naisdevice.APIServer/DeleteDevice"
+	APIServer_ReassignDevice_FullMethodName                            = "/naisdevice.APIServer/ReassignDevice"
+	APIServer_GetSessions_FullMethodName                               = "/naisdevice.APIServer/GetSessions"
+	APIServer_RevokeSessions_FullMethodName                            = "/naisdevice.APIServer/RevokeSessions"
+	APIServer_ListAuditEvents_FullMethodName                           = "/naisdevice.APIServer/ListAuditEvents"
+	APIServer_SnapshotDatabase_FullMethodName                          = "/naisdevice.APIServer/SnapshotDatabase"
+	APIServer_GetPosturePolicy_FullMethodName                          = "/naisdevice.APIServer/GetPosturePolicy"
+	APIServer_CreateIssueExemption_FullMethodName                      = "/naisdevice.APIServer/CreateIssueExemption"
+	APIServer_ListIssueExemptions_FullMethodName                       = "/naisdevice.APIServer/ListIssueExemptions"
+	APIServer_RevokeIssueExemption_FullMethodName                      = "/naisdevice.APIServer/RevokeIssueExemption"
+	APIServer_GetKolideCache_FullMethodName                            = "/naisdevice.APIServer/GetKolideCache"
+	APIServer_GetAcceptableUseAcceptedAt_FullMethodName                = "/naisdevice.APIServer/GetAcceptableUseAcceptedAt"
+	APIServer_SetAcceptableUseAccepted_FullMethodName                  = "/naisdevice.APIServer/SetAcceptableUseAccepted"
+	APIServer_GetGatewayJitaGrantsForUser_FullMethodName               = "/naisdevice.APIServer/GetGatewayJitaGrantsForUser"
+	APIServer_UserHasAccessToPrivilegedGateway_FullMethodName          = "/naisdevice.APIServer/UserHasAccessToPrivilegedGateway"
+	APIServer_GrantPrivilegedGatewayAccess_FullMethodName              = "/naisdevice.APIServer/GrantPrivilegedGatewayAccess"
+	APIServer_RevokePrivilegedGatewayAccess_FullMethodName             = "/naisdevice.APIServer/RevokePrivilegedGatewayAccess"
+	APIServer_GetPrivilegedGatewayAccessPolicy_FullMethodName          = "/naisdevice.APIServer/GetPrivilegedGatewayAccessPolicy"
+	APIServer_GetPendingPrivilegedGatewayAccessRequests_FullMethodName = "/naisdevice.APIServer/GetPendingPrivilegedGatewayAccessRequests"
+	APIServer_ReviewPrivilegedGatewayAccess_FullMethodName             = "/naisdevice.APIServer/ReviewPrivilegedGatewayAccess"
+	APIServer_ListGatewayJitaGrants_FullMethodName                     = "/naisdevice.APIServer/ListGatewayJitaGrants"
+	APIServer_RevokeGatewayJitaGrant_FullMethodName                    = "/naisdevice.APIServer/RevokeGatewayJitaGrant"
 )
 
 // APIServerClient is the client API for APIServer service.
@@ -772,6 +791,8 @@ const (
 type APIServerClient interface {
 	// Exchange an access token for a session
 	Login(ctx context.Context, in *APIServerLoginRequest, opts ...grpc.CallOption) (*APIServerLoginResponse, error)
+	// Exchange a fresh access token for a new session replacing the current one, before it expires
+	RenewSession(ctx context.Context, in *RenewSessionRequest, opts ...grpc.CallOption) (*RenewSessionResponse, error)
 	// Set up a client->server request for continuous streaming of new configuration
 	GetDeviceConfiguration(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error)
 	// Set up continuous streaming of new gateway configuration
@@ -784,8 +805,34 @@ type APIServerClient interface {
 	EnrollGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*ModifyGatewayResponse, error)
 	// Admin endpoint for adding gateway credentials to the database
 	UpdateGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*ModifyGatewayResponse, error)
+	// Admin endpoint for removing a gateway, its routes, access groups and JITA grants from the database
+	DeleteGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error)
+	// Admin endpoint for reading the WireGuard networks that gateway routes must not overlap
+	GetWireGuardPrefixes(ctx context.Context, in *GetWireGuardPrefixesRequest, opts ...grpc.CallOption) (*GetWireGuardPrefixesResponse, error)
+	// Admin endpoint for listing devices, optionally filtered by username, platform and health
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// Admin endpoint for retrieving a single device
+	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	// Admin endpoint for removing a device and its sessions from the database
+	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error)
+	// Admin endpoint for transferring a device to another user
+	ReassignDevice(ctx context.Context, in *ReassignDeviceRequest, opts ...grpc.CallOption) (*ReassignDeviceResponse, error)
 	// Admin endpoint for reading sessions from the cache
 	GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error)
+	// Admin endpoint for revoking sessions by session key, device or user, ending their configuration streams
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	// Admin endpoint for reading the audit log, newest events first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Admin endpoint for streaming a consistent point-in-time copy of the database
+	SnapshotDatabase(ctx context.Context, in *SnapshotDatabaseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotDatabaseResponse], error)
+	// Admin endpoint for reading the effective device posture policy, and how it rates a Kolide check
+	GetPosturePolicy(ctx context.Context, in *GetPosturePolicyRequest, opts ...grpc.CallOption) (*GetPosturePolicyResponse, error)
+	// Admin endpoint for exempting a device, a user or a check from device issues until the exemption expires
+	CreateIssueExemption(ctx context.Context, in *CreateIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error)
+	// Admin endpoint for listing issue exemptions, newest first
+	ListIssueExemptions(ctx context.Context, in *ListIssueExemptionsRequest, opts ...grpc.CallOption) (*ListIssueExemptionsResponse, error)
+	// Admin endpoint for revoking an issue exemption before it expires
+	RevokeIssueExemption(ctx context.Context, in *RevokeIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error)
 	// Admin endpoint for reading kolide cache
 	GetKolideCache(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error)
 	GetAcceptableUseAcceptedAt(ctx context.Context, in *GetAcceptableUseAcceptedAtRequest, opts ...grpc.CallOption) (*GetAcceptableUseAcceptedAtResponse, error)
@@ -794,6 +841,16 @@ type APIServerClient interface {
 	UserHasAccessToPrivilegedGateway(ctx context.Context, in *UserHasAccessToPrivilegedGatewayRequest, opts ...grpc.CallOption) (*UserHasAccessToPrivilegedGatewayResponse, error)
 	GrantPrivilegedGatewayAccess(ctx context.Context, in *GrantPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*GrantPrivilegedGatewayAccessResponse, error)
 	RevokePrivilegedGatewayAccess(ctx context.Context, in *RevokePrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*RevokePrivilegedGatewayAccessResponse, error)
+	// Get the JITA policy for a privileged gateway, so that clients can offer valid choices
+	GetPrivilegedGatewayAccessPolicy(ctx context.Context, in *GetPrivilegedGatewayAccessPolicyRequest, opts ...grpc.CallOption) (*GetPrivilegedGatewayAccessPolicyResponse, error)
+	// List JITA grants from other users awaiting approval, only available to approvers
+	GetPendingPrivilegedGatewayAccessRequests(ctx context.Context, in *GetPendingPrivilegedGatewayAccessRequestsRequest, opts ...grpc.CallOption) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error)
+	// Approve or deny a pending JITA grant, only available to approvers
+	ReviewPrivilegedGatewayAccess(ctx context.Context, in *ReviewPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*ReviewPrivilegedGatewayAccessResponse, error)
+	// Admin endpoint for listing active and historical JITA grants across all users
+	ListGatewayJitaGrants(ctx context.Context, in *ListGatewayJitaGrantsRequest, opts ...grpc.CallOption) (*ListGatewayJitaGrantsResponse, error)
+	// Admin endpoint for revoking any JITA grant by id
+	RevokeGatewayJitaGrant(ctx context.Context, in *RevokeGatewayJitaGrantRequest, opts ...grpc.CallOption) (*RevokeGatewayJitaGrantResponse, error)
 }
 
 type aPIServerClient struct {
@@ -814,6 +871,16 @@ func (c *aPIServerClient) Login(ctx context.Context, in *APIServerLoginRequest,
 	return out, nil
 }
 
+func (c *aPIServerClient) RenewSession(ctx context.Context, in *RenewSessionRequest, opts ...grpc.CallOption) (*RenewSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewSessionResponse)
+	err := c.cc.Invoke(ctx, APIServer_RenewSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServerClient) GetDeviceConfiguration(ctx context.Context, in *GetDeviceConfigurationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDeviceConfigurationResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &APIServer_ServiceDesc.Streams[0], APIServer_GetDeviceConfiguration_FullMethodName, cOpts...)
@@ -901,6 +968,66 @@ func (c *aPIServerClient) UpdateGateway(ctx context.Context, in *ModifyGatewayRe
 	return out, nil
 }
 
+func (c *aPIServerClient) DeleteGateway(ctx context.Context, in *ModifyGatewayRequest, opts ...grpc.CallOption) (*DeleteGatewayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGatewayResponse)
+	err := c.cc.Invoke(ctx, APIServer_DeleteGateway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) GetWireGuardPrefixes(ctx context.Context, in *GetWireGuardPrefixesRequest, opts ...grpc.CallOption) (*GetWireGuardPrefixesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWireGuardPrefixesResponse)
+	err := c.cc.Invoke(ctx, APIServer_GetWireGuardPrefixes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, APIServer_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Device)
+	err := c.cc.Invoke(ctx, APIServer_GetDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*DeleteDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDeviceResponse)
+	err := c.cc.Invoke(ctx, APIServer_DeleteDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ReassignDevice(ctx context.Context, in *ReassignDeviceRequest, opts ...grpc.CallOption) (*ReassignDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignDeviceResponse)
+	err := c.cc.Invoke(ctx, APIServer_ReassignDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServerClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSessionsResponse)
@@ -911,6 +1038,85 @@ func (c *aPIServerClient) GetSessions(ctx context.Context, in *GetSessionsReques
 	return out, nil
 }
 
+func (c *aPIServerClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, APIServer_RevokeSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, APIServer_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) SnapshotDatabase(ctx context.Context, in *SnapshotDatabaseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SnapshotDatabaseResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &APIServer_ServiceDesc.Streams[3], APIServer_SnapshotDatabase_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SnapshotDatabaseRequest, SnapshotDatabaseResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type APIServer_SnapshotDatabaseClient = grpc.ServerStreamingClient[SnapshotDatabaseResponse]
+
+func (c *aPIServerClient) GetPosturePolicy(ctx context.Context, in *GetPosturePolicyRequest, opts ...grpc.CallOption) (*GetPosturePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPosturePolicyResponse)
+	err := c.cc.Invoke(ctx, APIServer_GetPosturePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) CreateIssueExemption(ctx context.Context, in *CreateIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueExemption)
+	err := c.cc.Invoke(ctx, APIServer_CreateIssueExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ListIssueExemptions(ctx context.Context, in *ListIssueExemptionsRequest, opts ...grpc.CallOption) (*ListIssueExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueExemptionsResponse)
+	err := c.cc.Invoke(ctx, APIServer_ListIssueExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) RevokeIssueExemption(ctx context.Context, in *RevokeIssueExemptionRequest, opts ...grpc.CallOption) (*IssueExemption, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueExemption)
+	err := c.cc.Invoke(ctx, APIServer_RevokeIssueExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServerClient) GetKolideCache(ctx context.Context, in *GetKolideCacheRequest, opts ...grpc.CallOption) (*GetKolideCacheResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetKolideCacheResponse)
@@ -981,12 +1187,64 @@ func (c *aPIServerClient) RevokePrivilegedGatewayAccess(ctx context.Context, in
 	return out, nil
 }
 
+func (c *aPIServerClient) GetPrivilegedGatewayAccessPolicy(ctx context.Context, in *GetPrivilegedGatewayAccessPolicyRequest, opts ...grpc.CallOption) (*GetPrivilegedGatewayAccessPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrivilegedGatewayAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, APIServer_GetPrivilegedGatewayAccessPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) GetPendingPrivilegedGatewayAccessRequests(ctx context.Context, in *GetPendingPrivilegedGatewayAccessRequestsRequest, opts ...grpc.CallOption) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingPrivilegedGatewayAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, APIServer_GetPendingPrivilegedGatewayAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ReviewPrivilegedGatewayAccess(ctx context.Context, in *ReviewPrivilegedGatewayAccessRequest, opts ...grpc.CallOption) (*ReviewPrivilegedGatewayAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPrivilegedGatewayAccessResponse)
+	err := c.cc.Invoke(ctx, APIServer_ReviewPrivilegedGatewayAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) ListGatewayJitaGrants(ctx context.Context, in *ListGatewayJitaGrantsRequest, opts ...grpc.CallOption) (*ListGatewayJitaGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGatewayJitaGrantsResponse)
+	err := c.cc.Invoke(ctx, APIServer_ListGatewayJitaGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServerClient) RevokeGatewayJitaGrant(ctx context.Context, in *RevokeGatewayJitaGrantRequest, opts ...grpc.CallOption) (*RevokeGatewayJitaGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeGatewayJitaGrantResponse)
+	err := c.cc.Invoke(ctx, APIServer_RevokeGatewayJitaGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServerServer is the server API for APIServer service.
 // All implementations must embed UnimplementedAPIServerServer
 // for forward compatibility.
 type APIServerServer interface {
 	// Exchange an access token for a session
 	Login(context.Context, *APIServerLoginRequest) (*APIServerLoginResponse, error)
+	// Exchange a fresh access token for a new session replacing the current one, before it expires
+	RenewSession(context.Context, *RenewSessionRequest) (*RenewSessionResponse, error)
 	// Set up a client->server request for continuous streaming of new configuration
 	GetDeviceConfiguration(*GetDeviceConfigurationRequest, grpc.ServerStreamingServer[GetDeviceConfigurationResponse]) error
 	// Set up continuous streaming of new gateway configuration
@@ -999,8 +1257,34 @@ type APIServerServer interface {
 	EnrollGateway(context.Context, *ModifyGatewayRequest) (*ModifyGatewayResponse, error)
 	// Admin endpoint for adding gateway credentials to the database
 	UpdateGateway(context.Context, *ModifyGatewayRequest) (*ModifyGatewayResponse, error)
+	// Admin endpoint for removing a gateway, its routes, access groups and JITA grants from the database
+	DeleteGateway(context.Context, *ModifyGatewayRequest) (*DeleteGatewayResponse, error)
+	// Admin endpoint for reading the WireGuard networks that gateway routes must not overlap
+	GetWireGuardPrefixes(context.Context, *GetWireGuardPrefixesRequest) (*GetWireGuardPrefixesResponse, error)
+	// Admin endpoint for listing devices, optionally filtered by username, platform and health
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// Admin endpoint for retrieving a single device
+	GetDevice(context.Context, *GetDeviceRequest) (*Device, error)
+	// Admin endpoint for removing a device and its sessions from the database
+	DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error)
+	// Admin endpoint for transferring a device to another user
+	ReassignDevice(context.Context, *ReassignDeviceRequest) (*ReassignDeviceResponse, error)
 	// Admin endpoint for reading sessions from the cache
 	GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error)
+	// Admin endpoint for revoking sessions by session key, device or user, ending their configuration streams
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	// Admin endpoint for reading the audit log, newest events first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Admin endpoint for streaming a consistent point-in-time copy of the database
+	SnapshotDatabase(*SnapshotDatabaseRequest, grpc.ServerStreamingServer[SnapshotDatabaseResponse]) error
+	// Admin endpoint for reading the effective device posture policy, and how it rates a Kolide check
+	GetPosturePolicy(context.Context, *GetPosturePolicyRequest) (*GetPosturePolicyResponse, error)
+	// Admin endpoint for exempting a device, a user or a check from device issues until the exemption expires
+	CreateIssueExemption(context.Context, *CreateIssueExemptionRequest) (*IssueExemption, error)
+	// Admin endpoint for listing issue exemptions, newest first
+	ListIssueExemptions(context.Context, *ListIssueExemptionsRequest) (*ListIssueExemptionsResponse, error)
+	// Admin endpoint for revoking an issue exemption before it expires
+	RevokeIssueExemption(context.Context, *RevokeIssueExemptionRequest) (*IssueExemption, error)
 	// Admin endpoint for reading kolide cache
 	GetKolideCache(context.Context, *GetKolideCacheRequest) (*GetKolideCacheResponse, error)
 	GetAcceptableUseAcceptedAt(context.Context, *GetAcceptableUseAcceptedAtRequest) (*GetAcceptableUseAcceptedAtResponse, error)
@@ -1009,6 +1293,16 @@ type APIServerServer interface {
 	UserHasAccessToPrivilegedGateway(context.Context, *UserHasAccessToPrivilegedGatewayRequest) (*UserHasAccessToPrivilegedGatewayResponse, error)
 	GrantPrivilegedGatewayAccess(context.Context, *GrantPrivilegedGatewayAccessRequest) (*GrantPrivilegedGatewayAccessResponse, error)
 	RevokePrivilegedGatewayAccess(context.Context, *RevokePrivilegedGatewayAccessRequest) (*RevokePrivilegedGatewayAccessResponse, error)
+	// Get the JITA policy for a privileged gateway, so that clients can offer valid choices
+	GetPrivilegedGatewayAccessPolicy(context.Context, *GetPrivilegedGatewayAccessPolicyRequest) (*GetPrivilegedGatewayAccessPolicyResponse, error)
+	// List JITA grants from other users awaiting approval, only available to approvers
+	GetPendingPrivilegedGatewayAccessRequests(context.Context, *GetPendingPrivilegedGatewayAccessRequestsRequest) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error)
+	// Approve or deny a pending JITA grant, only available to approvers
+	ReviewPrivilegedGatewayAccess(context.Context, *ReviewPrivilegedGatewayAccessRequest) (*ReviewPrivilegedGatewayAccessResponse, error)
+	// Admin endpoint for listing active and historical JITA grants across all users
+	ListGatewayJitaGrants(context.Context, *ListGatewayJitaGrantsRequest) (*ListGatewayJitaGrantsResponse, error)
+	// Admin endpoint for revoking any JITA grant by id
+	RevokeGatewayJitaGrant(context.Context, *RevokeGatewayJitaGrantRequest) (*RevokeGatewayJitaGrantResponse, error)
 	mustEmbedUnimplementedAPIServerServer()
 }
 
@@ -1022,6 +1316,9 @@ type UnimplementedAPIServerServer struct{}
 func (UnimplementedAPIServerServer) Login(context.Context, *APIServerLoginRequest) (*APIServerLoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedAPIServerServer) RenewSession(context.Context, *RenewSessionRequest) (*RenewSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenewSession not implemented")
+}
 func (UnimplementedAPIServerServer) GetDeviceConfiguration(*GetDeviceConfigurationRequest, grpc.ServerStreamingServer[GetDeviceConfigurationResponse]) error {
 	return status.Error(codes.Unimplemented, "method GetDeviceConfiguration not implemented")
 }
@@ -1040,9 +1337,48 @@ func (UnimplementedAPIServerServer) EnrollGateway(context.Context, *ModifyGatewa
 func (UnimplementedAPIServerServer) UpdateGateway(context.Context, *ModifyGatewayRequest) (*ModifyGatewayResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateGateway not implemented")
 }
+func (UnimplementedAPIServerServer) DeleteGateway(context.Context, *ModifyGatewayRequest) (*DeleteGatewayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGateway not implemented")
+}
+func (UnimplementedAPIServerServer) GetWireGuardPrefixes(context.Context, *GetWireGuardPrefixesRequest) (*GetWireGuardPrefixesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWireGuardPrefixes not implemented")
+}
+func (UnimplementedAPIServerServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedAPIServerServer) GetDevice(context.Context, *GetDeviceRequest) (*Device, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedAPIServerServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*DeleteDeviceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDevice not implemented")
+}
+func (UnimplementedAPIServerServer) ReassignDevice(context.Context, *ReassignDeviceRequest) (*ReassignDeviceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignDevice not implemented")
+}
 func (UnimplementedAPIServerServer) GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSessions not implemented")
 }
+func (UnimplementedAPIServerServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (UnimplementedAPIServerServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAPIServerServer) SnapshotDatabase(*SnapshotDatabaseRequest, grpc.ServerStreamingServer[SnapshotDatabaseResponse]) error {
+	return status.Error(codes.Unimplemented, "method SnapshotDatabase not implemented")
+}
+func (UnimplementedAPIServerServer) GetPosturePolicy(context.Context, *GetPosturePolicyRequest) (*GetPosturePolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPosturePolicy not implemented")
+}
+func (UnimplementedAPIServerServer) CreateIssueExemption(context.Context, *CreateIssueExemptionRequest) (*IssueExemption, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIssueExemption not implemented")
+}
+func (UnimplementedAPIServerServer) ListIssueExemptions(context.Context, *ListIssueExemptionsRequest) (*ListIssueExemptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIssueExemptions not implemented")
+}
+func (UnimplementedAPIServerServer) RevokeIssueExemption(context.Context, *RevokeIssueExemptionRequest) (*IssueExemption, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeIssueExemption not implemented")
+}
 func (UnimplementedAPIServerServer) GetKolideCache(context.Context, *GetKolideCacheRequest) (*GetKolideCacheResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetKolideCache not implemented")
 }
@@ -1064,6 +1400,21 @@ func (UnimplementedAPIServerServer) GrantPrivilegedGatewayAccess(context.Context
 func (UnimplementedAPIServerServer) RevokePrivilegedGatewayAccess(context.Context, *RevokePrivilegedGatewayAccessRequest) (*RevokePrivilegedGatewayAccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokePrivilegedGatewayAccess not implemented")
 }
+func (UnimplementedAPIServerServer) GetPrivilegedGatewayAccessPolicy(context.Context, *GetPrivilegedGatewayAccessPolicyRequest) (*GetPrivilegedGatewayAccessPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrivilegedGatewayAccessPolicy not implemented")
+}
+func (UnimplementedAPIServerServer) GetPendingPrivilegedGatewayAccessRequests(context.Context, *GetPendingPrivilegedGatewayAccessRequestsRequest) (*GetPendingPrivilegedGatewayAccessRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingPrivilegedGatewayAccessRequests not implemented")
+}
+func (UnimplementedAPIServerServer) ReviewPrivilegedGatewayAccess(context.Context, *ReviewPrivilegedGatewayAccessRequest) (*ReviewPrivilegedGatewayAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewPrivilegedGatewayAccess not implemented")
+}
+func (UnimplementedAPIServerServer) ListGatewayJitaGrants(context.Context, *ListGatewayJitaGrantsRequest) (*ListGatewayJitaGrantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGatewayJitaGrants not implemented")
+}
+func (UnimplementedAPIServerServer) RevokeGatewayJitaGrant(context.Context, *RevokeGatewayJitaGrantRequest) (*RevokeGatewayJitaGrantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeGatewayJitaGrant not implemented")
+}
 func (UnimplementedAPIServerServer) mustEmbedUnimplementedAPIServerServer() {}
 func (UnimplementedAPIServerServer) testEmbeddedByValue()                   {}
 
@@ -1103,6 +1454,24 @@ func _APIServer_Login_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIServer_RenewSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).RenewSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_RenewSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).RenewSession(ctx, req.(*RenewSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIServer_GetDeviceConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetDeviceConfigurationRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1190,6 +1559,114 @@ func _APIServer_UpdateGateway_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIServer_DeleteGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).DeleteGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_DeleteGateway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).DeleteGateway(ctx, req.(*ModifyGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_GetWireGuardPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWireGuardPrefixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).GetWireGuardPrefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_GetWireGuardPrefixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).GetWireGuardPrefixes(ctx, req.(*GetWireGuardPrefixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_GetDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).GetDevice(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_DeleteDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ReassignDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ReassignDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ReassignDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ReassignDevice(ctx, req.(*ReassignDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIServer_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSessionsRequest)
 	if err := dec(in); err != nil {
@@ -1208,6 +1685,125 @@ func _APIServer_GetSessions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIServer_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_RevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_SnapshotDatabase_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SnapshotDatabaseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServerServer).SnapshotDatabase(m, &grpc.GenericServerStream[SnapshotDatabaseRequest, SnapshotDatabaseResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type APIServer_SnapshotDatabaseServer = grpc.ServerStreamingServer[SnapshotDatabaseResponse]
+
+func _APIServer_GetPosturePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPosturePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).GetPosturePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_GetPosturePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).GetPosturePolicy(ctx, req.(*GetPosturePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_CreateIssueExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).CreateIssueExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_CreateIssueExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).CreateIssueExemption(ctx, req.(*CreateIssueExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ListIssueExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ListIssueExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ListIssueExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ListIssueExemptions(ctx, req.(*ListIssueExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_RevokeIssueExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeIssueExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).RevokeIssueExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_RevokeIssueExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).RevokeIssueExemption(ctx, req.(*RevokeIssueExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIServer_GetKolideCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetKolideCacheRequest)
 	if err := dec(in); err != nil {
@@ -1334,6 +1930,96 @@ func _APIServer_RevokePrivilegedGatewayAccess_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIServer_GetPrivilegedGatewayAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivilegedGatewayAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).GetPrivilegedGatewayAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_GetPrivilegedGatewayAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).GetPrivilegedGatewayAccessPolicy(ctx, req.(*GetPrivilegedGatewayAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_GetPendingPrivilegedGatewayAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingPrivilegedGatewayAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).GetPendingPrivilegedGatewayAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_GetPendingPrivilegedGatewayAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).GetPendingPrivilegedGatewayAccessRequests(ctx, req.(*GetPendingPrivilegedGatewayAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ReviewPrivilegedGatewayAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPrivilegedGatewayAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ReviewPrivilegedGatewayAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ReviewPrivilegedGatewayAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ReviewPrivilegedGatewayAccess(ctx, req.(*ReviewPrivilegedGatewayAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_ListGatewayJitaGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayJitaGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).ListGatewayJitaGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_ListGatewayJitaGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).ListGatewayJitaGrants(ctx, req.(*ListGatewayJitaGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIServer_RevokeGatewayJitaGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGatewayJitaGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServerServer).RevokeGatewayJitaGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIServer_RevokeGatewayJitaGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServerServer).RevokeGatewayJitaGrant(ctx, req.(*RevokeGatewayJitaGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // APIServer_ServiceDesc is the grpc.ServiceDesc for APIServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1345,6 +2031,10 @@ var APIServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _APIServer_Login_Handler,
 		},
+		{
+			MethodName: "RenewSession",
+			Handler:    _APIServer_RenewSession_Handler,
+		},
 		{
 			MethodName: "GetGateway",
 			Handler:    _APIServer_GetGateway_Handler,
@@ -1357,10 +2047,58 @@ var APIServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGateway",
 			Handler:    _APIServer_UpdateGateway_Handler,
 		},
+		{
+			MethodName: "DeleteGateway",
+			Handler:    _APIServer_DeleteGateway_Handler,
+		},
+		{
+			MethodName: "GetWireGuardPrefixes",
+			Handler:    _APIServer_GetWireGuardPrefixes_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _APIServer_ListDevices_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _APIServer_GetDevice_Handler,
+		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _APIServer_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "ReassignDevice",
+			Handler:    _APIServer_ReassignDevice_Handler,
+		},
 		{
 			MethodName: "GetSessions",
 			Handler:    _APIServer_GetSessions_Handler,
 		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _APIServer_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _APIServer_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetPosturePolicy",
+			Handler:    _APIServer_GetPosturePolicy_Handler,
+		},
+		{
+			MethodName: "CreateIssueExemption",
+			Handler:    _APIServer_CreateIssueExemption_Handler,
+		},
+		{
+			MethodName: "ListIssueExemptions",
+			Handler:    _APIServer_ListIssueExemptions_Handler,
+		},
+		{
+			MethodName: "RevokeIssueExemption",
+			Handler:    _APIServer_RevokeIssueExemption_Handler,
+		},
 		{
 			MethodName: "GetKolideCache",
 			Handler:    _APIServer_GetKolideCache_Handler,
@@ -1389,6 +2127,26 @@ var APIServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokePrivilegedGatewayAccess",
 			Handler:    _APIServer_RevokePrivilegedGatewayAccess_Handler,
 		},
+		{
+			MethodName: "GetPrivilegedGatewayAccessPolicy",
+			Handler:    _APIServer_GetPrivilegedGatewayAccessPolicy_Handler,
+		},
+		{
+			MethodName: "GetPendingPrivilegedGatewayAccessRequests",
+			Handler:    _APIServer_GetPendingPrivilegedGatewayAccessRequests_Handler,
+		},
+		{
+			MethodName: "ReviewPrivilegedGatewayAccess",
+			Handler:    _APIServer_ReviewPrivilegedGatewayAccess_Handler,
+		},
+		{
+			MethodName: "ListGatewayJitaGrants",
+			Handler:    _APIServer_ListGatewayJitaGrants_Handler,
+		},
+		{
+			MethodName: "RevokeGatewayJitaGrant",
+			Handler:    _APIServer_RevokeGatewayJitaGrant_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1406,6 +2164,11 @@ var APIServer_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _APIServer_ListGateways_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SnapshotDatabase",
+			Handler:       _APIServer_SnapshotDatabase_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/pb/protobuf-api.proto",
 }
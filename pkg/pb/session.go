@@ -1,6 +1,14 @@
 package pb
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataSessionKey is the request metadata carrying the session key of a device.
+const MetadataSessionKey = "session-key"
 
 func (x *Session) Expired() bool {
 	if x == nil {
@@ -9,3 +17,8 @@ func (x *Session) Expired() bool {
 
 	return x.Expiry.AsTime().Before(time.Now())
 }
+
+// WithSessionKey authenticates outgoing apiserver requests made with ctx as the session with the given key.
+func WithSessionKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataSessionKey, key)
+}